@@ -0,0 +1,59 @@
+# Incident response: disable every enabled policy that carries a given application label.
+#
+# 1. Discover the affected policies. The label filter is applied client-side to the returned page,
+#    so use a limit large enough to cover all policies.
+data "unifiedpolicy_lifecycle_policies" "incident" {
+  enabled            = true
+  scope_type         = "application"
+  application_labels = { team = "payments" }
+  limit              = 250
+}
+
+locals {
+  incident_policies = {
+    for p in data.unifiedpolicy_lifecycle_policies.incident.policies : p.id => p
+  }
+}
+
+# 2. Bring the policies under management (Terraform 1.7+). Skip this for policies that are already managed.
+import {
+  for_each = toset(data.unifiedpolicy_lifecycle_policies.incident.policy_ids)
+  to       = unifiedpolicy_lifecycle_policy.incident[each.key]
+  id       = each.key
+}
+
+# 3. Flip enabled while keeping the rest of each policy as it is.
+resource "unifiedpolicy_lifecycle_policy" "incident" {
+  for_each = local.incident_policies
+
+  name        = each.value.name
+  description = each.value.description
+  enabled     = false
+  mode        = each.value.mode
+  rule_ids    = each.value.rule_ids
+
+  action {
+    type = each.value.action.type
+    stage {
+      key  = each.value.action.stage.key
+      gate = each.value.action.stage.gate
+    }
+  }
+
+  scope {
+    type             = each.value.scope.type
+    application_keys = each.value.scope.application_keys
+
+    dynamic "application_labels" {
+      for_each = coalesce(each.value.scope.application_labels, [])
+      content {
+        key   = application_labels.value.key
+        value = application_labels.value.value
+      }
+    }
+  }
+}
+
+output "incident_policy_ids" {
+  value = data.unifiedpolicy_lifecycle_policies.incident.policy_ids
+}
@@ -19,6 +19,7 @@ require (
 	github.com/jfrog/terraform-provider-shared v1.30.7
 	github.com/open-policy-agent/opa v1.13.2
 	github.com/samber/lo v1.52.0
+	github.com/xeipuuv/gojsonschema v1.2.0
 )
 
 require (
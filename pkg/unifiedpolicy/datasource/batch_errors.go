@@ -0,0 +1,85 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BatchItemError is a problem with a single item of a batch data source. Batch data sources report these in their
+// `errors` attribute and still return the other items, instead of failing the whole read.
+type BatchItemError struct {
+	ID      string `tfsdk:"id"`
+	Message string `tfsdk:"message"`
+}
+
+var batchItemErrorAttrTypes = map[string]attr.Type{
+	"id":      types.StringType,
+	"message": types.StringType,
+}
+
+// withBatchErrorsAttributes adds the `errors` and `error_count` attributes of a batch data source to its attributes.
+// itemID describes what the `id` of an error identifies.
+func withBatchErrorsAttributes(attributes map[string]schema.Attribute, itemID string) map[string]schema.Attribute {
+	attributes["errors"] = schema.ListNestedAttribute{
+		Description: "Items that could not be processed, in processing order. The other items are returned as usual.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: itemID,
+					Computed:    true,
+				},
+				"message": schema.StringAttribute{
+					Description: "What went wrong with the item.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+	attributes["error_count"] = schema.Int64Attribute{
+		Description: "Number of entries in `errors`.",
+		Computed:    true,
+	}
+	return attributes
+}
+
+// batchErrorsValues returns the values of the `errors` and `error_count` attributes.
+func batchErrorsValues(ctx context.Context, itemErrors []BatchItemError) (types.List, types.Int64, diag.Diagnostics) {
+	if itemErrors == nil {
+		itemErrors = []BatchItemError{}
+	}
+	errorsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: batchItemErrorAttrTypes}, itemErrors)
+	return errorsList, types.Int64Value(int64(len(itemErrors))), diags
+}
+
+// diagnosticsMessage joins the summaries and details of the errors in diags into a single message for a BatchItemError.
+func diagnosticsMessage(diags diag.Diagnostics) string {
+	messages := make([]string, 0, diags.ErrorsCount())
+	for _, d := range diags.Errors() {
+		message := d.Summary()
+		if d.Detail() != "" {
+			message += ": " + d.Detail()
+		}
+		messages = append(messages, message)
+	}
+	return strings.Join(messages, "; ")
+}
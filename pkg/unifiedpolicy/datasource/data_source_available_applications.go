@@ -0,0 +1,85 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+)
+
+var _ datasource.DataSource = &AvailableApplicationsDataSource{}
+
+func NewAvailableApplicationsDataSource() datasource.DataSource {
+	return &AvailableApplicationsDataSource{}
+}
+
+type AvailableApplicationsDataSource struct {
+	ProviderData unifiedpolicy.ProviderMetadata
+}
+
+type AvailableApplicationsDataSourceModel struct {
+	Keys types.List `tfsdk:"keys"`
+}
+
+func (d *AvailableApplicationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_available_applications"
+}
+
+func (d *AvailableApplicationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the keys of the applications on the platform, the valid `application_keys` of `unifiedpolicy_lifecycle_policy` scopes. " +
+			"Reads the JFrog AppTrust API; reading fails with a clear error on platforms without AppTrust. " +
+			"Set `validate_scope_keys` on the provider to check lifecycle policy scopes against these keys at plan time.",
+		Attributes: map[string]schema.Attribute{
+			"keys": schema.ListAttribute{
+				Description: "Keys of the applications on the platform, sorted.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *AvailableApplicationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+func (d *AvailableApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AvailableApplicationsDataSourceModel
+
+	tflog.Info(ctx, "Reading available applications datasource")
+
+	keys, diags := unifiedpolicy.ReadAvailableApplicationKeys(ctx, d.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Keys, diags = types.ListValueFrom(ctx, types.StringType, keys)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+)
+
+func TestAvailableApplicationsRead(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		status       int
+		body         string
+		expectedKeys []string
+		wantError    string
+	}{
+		{name: "keys sorted", status: http.StatusOK, body: `{"applications":[{"application_key":"web"},{"application_key":"api"}]}`, expectedKeys: []string{"api", "web"}},
+		{name: "not available", status: http.StatusNotFound, body: `{"errors":[{"message":"not found"}]}`, wantError: "Applications List Not Available"},
+		{name: "forbidden", status: http.StatusForbidden, body: `{"errors":[{"message":"denied"}]}`, wantError: "Permission Denied"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/apptrust/api/v1/applications" {
+					return
+				}
+				requests++
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			d := &unifiedpolicydatasource.AvailableApplicationsDataSource{
+				ProviderData: unifiedpolicy.ProviderMetadata{
+					ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+				},
+			}
+			schemaResp := &datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+			dsSchema := schemaResp.Schema
+
+			raw := tftypes.NewValue(dsSchema.Type().TerraformType(ctx), nil)
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: dsSchema, Raw: raw}}
+			d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: dsSchema, Raw: raw}}, resp)
+
+			if requests != 1 {
+				t.Errorf("expected one GET /apptrust/api/v1/applications request, got %d", requests)
+			}
+			if tt.wantError != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tt.wantError {
+					t.Fatalf("expected error %q, got %v", tt.wantError, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var data unifiedpolicydatasource.AvailableApplicationsDataSourceModel
+			if diags := resp.State.Get(ctx, &data); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			var keys []string
+			if diags := data.Keys.ElementsAs(ctx, &keys, false); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(keys, tt.expectedKeys) {
+				t.Errorf("expected keys %v, got %v", tt.expectedKeys, keys)
+			}
+		})
+	}
+}
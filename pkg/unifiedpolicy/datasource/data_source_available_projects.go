@@ -0,0 +1,85 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+)
+
+var _ datasource.DataSource = &AvailableProjectsDataSource{}
+
+func NewAvailableProjectsDataSource() datasource.DataSource {
+	return &AvailableProjectsDataSource{}
+}
+
+type AvailableProjectsDataSource struct {
+	ProviderData unifiedpolicy.ProviderMetadata
+}
+
+type AvailableProjectsDataSourceModel struct {
+	Keys types.List `tfsdk:"keys"`
+}
+
+func (d *AvailableProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_available_projects"
+}
+
+func (d *AvailableProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the keys of the projects on the platform, the valid `project_keys` of `unifiedpolicy_lifecycle_policy` scopes. " +
+			"Reads the JFrog Access API. " +
+			"Set `validate_scope_keys` on the provider to check lifecycle policy scopes against these keys at plan time.",
+		Attributes: map[string]schema.Attribute{
+			"keys": schema.ListAttribute{
+				Description: "Keys of the projects on the platform, sorted.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *AvailableProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+func (d *AvailableProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AvailableProjectsDataSourceModel
+
+	tflog.Info(ctx, "Reading available projects datasource")
+
+	keys, diags := unifiedpolicy.ReadAvailableProjectKeys(ctx, d.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Keys, diags = types.ListValueFrom(ctx, types.StringType, keys)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
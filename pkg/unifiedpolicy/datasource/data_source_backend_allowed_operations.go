@@ -0,0 +1,204 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+// BackendAllowedOperationsEndpoint returns the Rego built-in operations the backend allows in templates.
+// Not available on all backend versions.
+const BackendAllowedOperationsEndpoint = resource.TemplatesEndpoint + "/allowed_operations"
+
+var _ datasource.DataSource = &BackendAllowedOperationsDataSource{}
+
+func NewBackendAllowedOperationsDataSource() datasource.DataSource {
+	return &BackendAllowedOperationsDataSource{}
+}
+
+type BackendAllowedOperationsDataSource struct {
+	ProviderData unifiedpolicy.ProviderMetadata
+}
+
+type BackendAllowedOperationsDataSourceModel struct {
+	Operations         types.List `tfsdk:"operations"`
+	ProviderOperations types.List `tfsdk:"provider_operations"`
+	OnlyBackend        types.List `tfsdk:"only_backend"`
+	OnlyProvider       types.List `tfsdk:"only_provider"`
+}
+
+// BackendAllowedOperationsAPIModel is the response shape for GET unifiedpolicy/api/v1/templates/allowed_operations.
+type BackendAllowedOperationsAPIModel struct {
+	Operations []string `json:"operations"`
+}
+
+func (d *BackendAllowedOperationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backend_allowed_operations"
+}
+
+func (d *BackendAllowedOperationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the Rego built-in operations the Unified Policy backend allows in templates, next to the allowlist the provider " +
+			"validates `unifiedpolicy_template` Rego code against at plan time. Use the differences to explain a template that passes " +
+			"provider validation but is rejected by the backend, or the other way around. " +
+			"Requires a backend version that exposes its allowlist; reading fails with a clear error otherwise.",
+		Attributes: map[string]schema.Attribute{
+			"operations": schema.ListAttribute{
+				Description: "Operations the backend allows, sorted.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"provider_operations": schema.ListAttribute{
+				Description: "Operations the provider allows during plan-time validation, including the provider `extra_allowed_rego_operations`, sorted.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"only_backend": schema.ListAttribute{
+				Description: "Operations the backend allows but the provider rejects, sorted.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"only_provider": schema.ListAttribute{
+				Description: "Operations the provider allows but the backend does not, sorted.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *BackendAllowedOperationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+func (d *BackendAllowedOperationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BackendAllowedOperationsDataSourceModel
+
+	tflog.Info(ctx, "Reading backend allowed operations datasource")
+
+	var result BackendAllowedOperationsAPIModel
+	response, err := d.ProviderData.Client.R().
+		SetContext(ctx).
+		SetResult(&result).
+		Get(d.ProviderData.Endpoint(BackendAllowedOperationsEndpoint))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while fetching the data source. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	if response.IsError() {
+		switch response.StatusCode() {
+		case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+			resp.Diagnostics.AddError(
+				"Backend Allowed Operations Not Available",
+				fmt.Sprintf("The backend did not return its allowed Rego operations (HTTP %d). "+
+					"This Unified Policy version does not expose its allowlist.", response.StatusCode()),
+			)
+			return
+		}
+		diags := unifiedpolicy.HandleAPIError(response, "read")
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	providerOperations := make([]string, 0)
+	for op := range resource.AllowedRegoOperations(d.ProviderData.ExtraAllowedRegoOperations) {
+		providerOperations = append(providerOperations, op)
+	}
+
+	resp.Diagnostics.Append(data.FromAPIModel(ctx, result.Operations, providerOperations)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// CompareAllowedOperations returns the sorted, de-duplicated backend and provider operations, and the
+// operations only one of them allows.
+// This function is exported for testing purposes.
+func CompareAllowedOperations(backend, provider []string) (backendSorted, providerSorted, onlyBackend, onlyProvider []string) {
+	backendSet := map[string]bool{}
+	for _, op := range backend {
+		backendSet[op] = true
+	}
+	providerSet := map[string]bool{}
+	for _, op := range provider {
+		providerSet[op] = true
+	}
+
+	backendSorted, providerSorted, onlyBackend, onlyProvider = []string{}, []string{}, []string{}, []string{}
+	for op := range backendSet {
+		backendSorted = append(backendSorted, op)
+		if !providerSet[op] {
+			onlyBackend = append(onlyBackend, op)
+		}
+	}
+	for op := range providerSet {
+		providerSorted = append(providerSorted, op)
+		if !backendSet[op] {
+			onlyProvider = append(onlyProvider, op)
+		}
+	}
+
+	sort.Strings(backendSorted)
+	sort.Strings(providerSorted)
+	sort.Strings(onlyBackend)
+	sort.Strings(onlyProvider)
+	return backendSorted, providerSorted, onlyBackend, onlyProvider
+}
+
+// FromAPIModel sets the operation lists from the backend and provider allowlists.
+func (m *BackendAllowedOperationsDataSourceModel) FromAPIModel(ctx context.Context, backend, provider []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	backendSorted, providerSorted, onlyBackend, onlyProvider := CompareAllowedOperations(backend, provider)
+
+	operations, d := types.ListValueFrom(ctx, types.StringType, backendSorted)
+	diags.Append(d...)
+	providerOperations, d := types.ListValueFrom(ctx, types.StringType, providerSorted)
+	diags.Append(d...)
+	onlyBackendList, d := types.ListValueFrom(ctx, types.StringType, onlyBackend)
+	diags.Append(d...)
+	onlyProviderList, d := types.ListValueFrom(ctx, types.StringType, onlyProvider)
+	diags.Append(d...)
+
+	m.Operations = operations
+	m.ProviderOperations = providerOperations
+	m.OnlyBackend = onlyBackendList
+	m.OnlyProvider = onlyProviderList
+
+	return diags
+}
@@ -0,0 +1,53 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource_test
+
+import (
+	"reflect"
+	"testing"
+
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+)
+
+func TestCompareAllowedOperations(t *testing.T) {
+	backend, provider, onlyBackend, onlyProvider := unifiedpolicydatasource.CompareAllowedOperations(
+		[]string{"upper", "eq", "regex.match", "eq"},
+		[]string{"eq", "upper", "lower"},
+	)
+
+	if want := []string{"eq", "regex.match", "upper"}; !reflect.DeepEqual(backend, want) {
+		t.Errorf("backend: expected %v, got %v", want, backend)
+	}
+	if want := []string{"eq", "lower", "upper"}; !reflect.DeepEqual(provider, want) {
+		t.Errorf("provider: expected %v, got %v", want, provider)
+	}
+	if want := []string{"regex.match"}; !reflect.DeepEqual(onlyBackend, want) {
+		t.Errorf("only backend: expected %v, got %v", want, onlyBackend)
+	}
+	if want := []string{"lower"}; !reflect.DeepEqual(onlyProvider, want) {
+		t.Errorf("only provider: expected %v, got %v", want, onlyProvider)
+	}
+}
+
+func TestCompareAllowedOperations_empty(t *testing.T) {
+	backend, _, onlyBackend, onlyProvider := unifiedpolicydatasource.CompareAllowedOperations(nil, []string{"eq"})
+
+	if len(backend) != 0 || len(onlyBackend) != 0 {
+		t.Errorf("expected no backend operations, got %v and %v", backend, onlyBackend)
+	}
+	if want := []string{"eq"}; !reflect.DeepEqual(onlyProvider, want) {
+		t.Errorf("only provider: expected %v, got %v", want, onlyProvider)
+	}
+}
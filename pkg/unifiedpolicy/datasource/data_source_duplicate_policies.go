@@ -0,0 +1,240 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+var _ datasource.DataSource = &DuplicatePoliciesDataSource{}
+
+func NewDuplicatePoliciesDataSource() datasource.DataSource {
+	return &DuplicatePoliciesDataSource{}
+}
+
+type DuplicatePoliciesDataSource struct {
+	ProviderData unifiedpolicy.ProviderMetadata
+}
+
+type DuplicatePoliciesDataSourceModel struct {
+	IncludeDisabled types.Bool `tfsdk:"include_disabled"`
+	Groups          types.List `tfsdk:"groups"`
+}
+
+var duplicatePolicyGroupAttrTypes = map[string]attr.Type{
+	"policy_ids":   types.ListType{ElemType: types.StringType},
+	"policy_names": types.ListType{ElemType: types.StringType},
+}
+
+func (d *DuplicatePoliciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_duplicate_policies"
+}
+
+func (d *DuplicatePoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Finds redundant Unified Policy lifecycle policies: groups of policies with the same `scope`, `rule_ids` and `action`, " +
+			"which enforce the same rule in the same place and may double-enforce. All policies are listed and grouped client-side. " +
+			"Project keys, application keys, application labels and rule IDs are compared regardless of order.",
+		Attributes: map[string]schema.Attribute{
+			"include_disabled": schema.BoolAttribute{
+				Description: "When true, disabled policies are grouped as well. When false (default), only enabled policies are considered.",
+				Optional:    true,
+			},
+			"groups": schema.ListNestedAttribute{
+				Description: "Groups of two or more duplicate policies, in the order their first policy is listed by the API. Empty when there are no duplicates.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"policy_ids": schema.ListAttribute{
+							Description: "IDs of the policies in the group, in list order.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"policy_names": schema.ListAttribute{
+							Description: "Names of the policies in the group, in the same order as `policy_ids`.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DuplicatePoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+func (d *DuplicatePoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DuplicatePoliciesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, diags := listAllPolicies(ctx, d.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies := make([]resource.LifecyclePolicyAPIModel, len(entries))
+	for i, entry := range entries {
+		policies[i] = entry.LifecyclePolicyAPIModel
+		// List items may carry their rules in expanded form
+		for _, rule := range entry.Rules {
+			policies[i].RuleIDs = append(policies[i].RuleIDs, rule.ID)
+		}
+	}
+
+	groups := GroupDuplicatePolicies(policies, data.IncludeDisabled.ValueBool())
+
+	tflog.Info(ctx, "Read duplicate policies datasource", map[string]interface{}{
+		"policy_count": len(policies),
+		"group_count":  len(groups),
+	})
+
+	resp.Diagnostics.Append(data.FromGroups(ctx, groups)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// duplicatePolicyKey is the part of a policy that makes two policies duplicates, with the order-insensitive
+// lists sorted.
+type duplicatePolicyKey struct {
+	Action            *resource.LifecycleAction   `json:"action"`
+	ScopeType         string                      `json:"scope_type"`
+	ProjectKeys       []string                    `json:"project_keys"`
+	ApplicationKeys   []string                    `json:"application_keys"`
+	ApplicationLabels []resource.ApplicationLabel `json:"application_labels"`
+	RuleIDs           []string                    `json:"rule_ids"`
+}
+
+func newDuplicatePolicyKey(policy resource.LifecyclePolicyAPIModel) string {
+	key := duplicatePolicyKey{
+		Action:  policy.Action,
+		RuleIDs: sortedUnique(policy.RuleIDs),
+	}
+	if policy.Scope != nil {
+		key.ScopeType = policy.Scope.Type
+		key.ProjectKeys = sortedUnique(policy.Scope.ProjectKeys)
+		key.ApplicationKeys = sortedUnique(policy.Scope.ApplicationKeys)
+		key.ApplicationLabels = append([]resource.ApplicationLabel{}, policy.Scope.ApplicationLabels...)
+		sort.Slice(key.ApplicationLabels, func(i, j int) bool {
+			if key.ApplicationLabels[i].Key != key.ApplicationLabels[j].Key {
+				return key.ApplicationLabels[i].Key < key.ApplicationLabels[j].Key
+			}
+			return key.ApplicationLabels[i].Value < key.ApplicationLabels[j].Value
+		})
+	}
+
+	// Marshalling plain strings and structs cannot fail
+	b, _ := json.Marshal(key)
+	return string(b)
+}
+
+func sortedUnique(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// GroupDuplicatePolicies groups the policies with identical scope, rule IDs and action. Only groups of two or more
+// policies are returned, in the order their first policy appears in policies; policies keep their order within a
+// group. Disabled policies are skipped unless includeDisabled is true.
+// This function is exported for testing purposes.
+func GroupDuplicatePolicies(policies []resource.LifecyclePolicyAPIModel, includeDisabled bool) [][]resource.LifecyclePolicyAPIModel {
+	var keys []string
+	byKey := map[string][]resource.LifecyclePolicyAPIModel{}
+	for _, policy := range policies {
+		if !policy.Enabled && !includeDisabled {
+			continue
+		}
+		key := newDuplicatePolicyKey(policy)
+		if _, ok := byKey[key]; !ok {
+			keys = append(keys, key)
+		}
+		byKey[key] = append(byKey[key], policy)
+	}
+
+	groups := [][]resource.LifecyclePolicyAPIModel{}
+	for _, key := range keys {
+		if len(byKey[key]) > 1 {
+			groups = append(groups, byKey[key])
+		}
+	}
+	return groups
+}
+
+// FromGroups converts the duplicate policy groups to the Terraform datasource model.
+func (m *DuplicatePoliciesDataSourceModel) FromGroups(ctx context.Context, groups [][]resource.LifecyclePolicyAPIModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groupValues := make([]attr.Value, 0, len(groups))
+	for _, group := range groups {
+		ids := make([]string, len(group))
+		names := make([]string, len(group))
+		for i, policy := range group {
+			ids[i] = policy.ID
+			names[i] = policy.Name
+		}
+
+		idList, d := types.ListValueFrom(ctx, types.StringType, ids)
+		diags.Append(d...)
+		nameList, d := types.ListValueFrom(ctx, types.StringType, names)
+		diags.Append(d...)
+
+		groupObj, d := types.ObjectValue(duplicatePolicyGroupAttrTypes, map[string]attr.Value{
+			"policy_ids":   idList,
+			"policy_names": nameList,
+		})
+		diags.Append(d...)
+		groupValues = append(groupValues, groupObj)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	groupList, d := types.ListValue(types.ObjectType{AttrTypes: duplicatePolicyGroupAttrTypes}, groupValues)
+	diags.Append(d...)
+	m.Groups = groupList
+	return diags
+}
@@ -0,0 +1,148 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+func TestAccDuplicatePoliciesDataSource_basic(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "unifiedpolicy_duplicate_policies" "test" {}
+				`,
+				Check: resource.TestCheckResourceAttrSet("data.unifiedpolicy_duplicate_policies.test", "groups.#"),
+			},
+		},
+	})
+}
+
+func TestGroupDuplicatePolicies(t *testing.T) {
+	action := &unifiedpolicyresource.LifecycleAction{
+		Type:  "certify_to_gate",
+		Stage: &unifiedpolicyresource.LifecycleStage{Key: "production", Gate: "release"},
+	}
+	policy := func(id string, enabled bool, scope unifiedpolicyresource.LifecycleScope, ruleIDs ...string) unifiedpolicyresource.LifecyclePolicyAPIModel {
+		return unifiedpolicyresource.LifecyclePolicyAPIModel{
+			ID:      id,
+			Name:    "policy-" + id,
+			Enabled: enabled,
+			Action:  action,
+			Scope:   &scope,
+			RuleIDs: ruleIDs,
+		}
+	}
+	projectScope := unifiedpolicyresource.LifecycleScope{Type: "project", ProjectKeys: []string{"proj"}}
+	labelScope := func(labels ...unifiedpolicyresource.ApplicationLabel) unifiedpolicyresource.LifecycleScope {
+		return unifiedpolicyresource.LifecycleScope{Type: "application", ApplicationKeys: []string{"app-1", "app-2"}, ApplicationLabels: labels}
+	}
+	env := unifiedpolicyresource.ApplicationLabel{Key: "env", Value: "prod"}
+	team := unifiedpolicyresource.ApplicationLabel{Key: "team", Value: "a"}
+
+	ids := func(groups [][]unifiedpolicyresource.LifecyclePolicyAPIModel) [][]string {
+		result := [][]string{}
+		for _, group := range groups {
+			groupIDs := []string{}
+			for _, p := range group {
+				groupIDs = append(groupIDs, p.ID)
+			}
+			result = append(result, groupIDs)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name            string
+		policies        []unifiedpolicyresource.LifecyclePolicyAPIModel
+		includeDisabled bool
+		want            [][]string
+	}{
+		{
+			name: "identical policies",
+			policies: []unifiedpolicyresource.LifecyclePolicyAPIModel{
+				policy("1", true, projectScope, "r1"),
+				policy("2", true, projectScope, "r2"),
+				policy("3", true, projectScope, "r1"),
+			},
+			want: [][]string{{"1", "3"}},
+		},
+		{
+			name: "list order does not matter",
+			policies: []unifiedpolicyresource.LifecyclePolicyAPIModel{
+				policy("1", true, labelScope(env, team), "r1"),
+				policy("2", true, unifiedpolicyresource.LifecycleScope{Type: "application", ApplicationKeys: []string{"app-2", "app-1"}, ApplicationLabels: []unifiedpolicyresource.ApplicationLabel{team, env}}, "r1"),
+			},
+			want: [][]string{{"1", "2"}},
+		},
+		{
+			name: "different scopes",
+			policies: []unifiedpolicyresource.LifecyclePolicyAPIModel{
+				policy("1", true, labelScope(env), "r1"),
+				policy("2", true, labelScope(team), "r1"),
+			},
+			want: [][]string{},
+		},
+		{
+			name: "disabled policies skipped",
+			policies: []unifiedpolicyresource.LifecyclePolicyAPIModel{
+				policy("1", true, projectScope, "r1"),
+				policy("2", false, projectScope, "r1"),
+			},
+			want: [][]string{},
+		},
+		{
+			name: "disabled policies included",
+			policies: []unifiedpolicyresource.LifecyclePolicyAPIModel{
+				policy("1", true, projectScope, "r1"),
+				policy("2", false, projectScope, "r1"),
+			},
+			includeDisabled: true,
+			want:            [][]string{{"1", "2"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ids(unifiedpolicydatasource.GroupDuplicatePolicies(tt.policies, tt.includeDisabled))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected groups %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDuplicatePoliciesFromGroups_empty(t *testing.T) {
+	var model unifiedpolicydatasource.DuplicatePoliciesDataSourceModel
+	if diags := model.FromGroups(context.Background(), unifiedpolicydatasource.GroupDuplicatePolicies(nil, false)); diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+	if model.Groups.IsNull() || len(model.Groups.Elements()) != 0 {
+		t.Errorf("expected an empty list of groups, got %s", model.Groups)
+	}
+}
@@ -16,19 +16,22 @@ package datasource
 
 import (
 	"context"
+	"encoding/json"
 	"net/url"
 	"strconv"
+	"strings"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
 )
@@ -40,7 +43,7 @@ func NewLifecyclePoliciesDataSource() datasource.DataSource {
 }
 
 type LifecyclePoliciesDataSource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData unifiedpolicy.ProviderMetadata
 }
 
 type LifecyclePoliciesDataSourceModel struct {
@@ -60,11 +63,17 @@ type LifecyclePoliciesDataSourceModel struct {
 	Expand            types.String `tfsdk:"expand"`
 	Page              types.Int64  `tfsdk:"page"`
 	Limit             types.Int64  `tfsdk:"limit"`
+	FetchAll          types.Bool   `tfsdk:"fetch_all"`
 	SortBy            types.String `tfsdk:"sort_by"`
+	SortByFields      types.List   `tfsdk:"sort_by_fields"`
 	SortOrder         types.String `tfsdk:"sort_order"`
 	Policies          types.List   `tfsdk:"policies"`
+	EffectiveQuery    types.String `tfsdk:"effective_query"`
+	PolicyIDs         types.List   `tfsdk:"policy_ids"`
 	Offset            types.Int64  `tfsdk:"offset"`
 	PageSize          types.Int64  `tfsdk:"page_size"`
+	HasMore           types.Bool   `tfsdk:"has_more"`
+	ResultID          types.String `tfsdk:"result_id"`
 }
 
 // lifecyclePolicyRuleItem is used when list API is called with expand=rules (API returns rules array per item).
@@ -79,6 +88,22 @@ type lifecyclePolicyListEntry struct {
 	Rules []lifecyclePolicyRuleItem `json:"rules,omitempty"`
 }
 
+// UnmarshalJSON decodes the embedded API model and the list-only rules; without it the embedded model's
+// UnmarshalJSON would be promoted and rules would be dropped.
+func (e *lifecyclePolicyListEntry) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &e.LifecyclePolicyAPIModel); err != nil {
+		return err
+	}
+	var listFields struct {
+		Rules []lifecyclePolicyRuleItem `json:"rules,omitempty"`
+	}
+	if err := json.Unmarshal(data, &listFields); err != nil {
+		return err
+	}
+	e.Rules = listFields.Rules
+	return nil
+}
+
 // PoliciesListAPIModel represents the API response for listing policies.
 // The API returns items, limit, offset, and page_size (no total_count).
 type PoliciesListAPIModel struct {
@@ -148,14 +173,10 @@ func (d *LifecyclePoliciesDataSource) Schema(ctx context.Context, req datasource
 				},
 			},
 			"stage_gates": schema.ListAttribute{
-				Description: "Filter by lifecycle gates. Allowed values: 'entry', 'exit', 'release'.",
+				Description: "Filter by lifecycle gates. Must be gates the backend supports; when the backend does not enumerate its gates, " +
+					"one of: '" + strings.Join(unifiedpolicy.LifecycleGates, "', '") + "'.",
 				ElementType: types.StringType,
 				Optional:    true,
-				Validators: []validator.List{
-					listvalidator.ValueStringsAre(
-						stringvalidator.OneOf("entry", "exit", "release"),
-					),
-				},
 			},
 			"project_key": schema.StringAttribute{
 				Description: "Filter by project key (for project scope).",
@@ -172,7 +193,8 @@ func (d *LifecyclePoliciesDataSource) Schema(ctx context.Context, req datasource
 				},
 			},
 			"application_labels": schema.MapAttribute{
-				Description: "Filter by application labels. Each key-value pair represents a label filter.",
+				Description: "Filter by application labels. Each key-value pair represents a label filter; a policy matches when its scope has every given label. " +
+					"Applied client-side to the returned page, so combine it with `limit` or `fetch_all` to cover all policies.",
 				ElementType: types.StringType,
 				Optional:    true,
 			},
@@ -191,10 +213,27 @@ func (d *LifecyclePoliciesDataSource) Schema(ctx context.Context, req datasource
 				Description: "Items per page (1-250, default: 100).",
 				Optional:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "When true, reads every page from `page` on, `limit` items at a time, and returns all matching policies in `policies`. " +
+					"`has_more` is then false and `page_size` is the number of policies returned by the API. The read fails if the API does not advance the page offset.",
+				Optional: true,
+			},
 			"sort_by": schema.StringAttribute{
-				Description: "Sort field (e.g., 'name', 'created_at').",
+				Description: "Sort field (e.g., 'name', 'created_at', 'priority').",
 				Optional:    true,
 			},
+			"sort_by_fields": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Sort by multiple fields, in priority order (e.g. ['name', 'created_at']); use instead of `sort_by` for deterministic ordering on ties. " +
+					"Sent as repeated `sort_by` query parameters (e.g. ?sort_by=name&sort_by=created_at); the backend applies them in order. Allowed fields: 'name', 'created_at', 'priority'.",
+				Optional: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("name", "created_at", "priority")),
+					listvalidator.ConflictsWith(path.MatchRoot("sort_by")),
+				},
+			},
 			"sort_order": schema.StringAttribute{
 				Description: "Sort order. Must be either 'asc' or 'desc'.",
 				Optional:    true,
@@ -287,11 +326,20 @@ func (d *LifecyclePoliciesDataSource) Schema(ctx context.Context, req datasource
 								},
 							},
 						},
+						"scope_description": schema.StringAttribute{
+							Description: "The scope in plain English for reports and notifications, e.g. `project PROD-1` or " +
+								"`applications labeled environment=production`. Derived from `scope`; null when the policy has no scope.",
+							Computed: true,
+						},
 						"rule_ids": schema.ListAttribute{
 							Description: "IDs of rules enforced by this policy.",
 							ElementType: types.StringType,
 							Computed:    true,
 						},
+						"priority": schema.Int64Attribute{
+							Description: "Evaluation order of the policy among policies that apply to the same scope; lower values are evaluated first. Null when the backend does not return one.",
+							Computed:    true,
+						},
 						"created_at": schema.StringAttribute{
 							Description: "Timestamp when the policy was created.",
 							Computed:    true,
@@ -311,6 +359,17 @@ func (d *LifecyclePoliciesDataSource) Schema(ctx context.Context, req datasource
 					},
 				},
 			},
+			"policy_ids": schema.ListAttribute{
+				Description: "IDs of the returned policies, in the same order as `policies`. Convenient for `for_each`, e.g. to disable all policies matching a label during an incident.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"effective_query": schema.StringAttribute{
+				Description: "The query string sent to the API for this read, URL-encoded with keys in alphabetical order and multi-value " +
+					"parameters repeated (e.g. `id=1001&id=1002&limit=10`). For debugging which filters reached the API. " +
+					"`application_labels` is applied client-side and is therefore not part of it.",
+				Computed: true,
+			},
 			"offset": schema.Int64Attribute{
 				Description: "Current page offset.",
 				Computed:    true,
@@ -319,6 +378,17 @@ func (d *LifecyclePoliciesDataSource) Schema(ctx context.Context, req datasource
 				Description: "Number of items in the current page.",
 				Computed:    true,
 			},
+			"has_more": schema.BoolAttribute{
+				Description: "Whether another page likely exists, derived as the number of returned items being equal to the `limit` " +
+					"returned by the API. Use it to drive manual pagination loops. When the last page is exactly full this is " +
+					"`true` and the following page comes back empty.",
+				Computed: true,
+			},
+			"result_id": schema.StringAttribute{
+				Description: "Stable identity of this read: a SHA-256 hash of `effective_query`, `application_labels` and the sorted IDs of the returned policies. " +
+					"It changes whenever the filters or the results change. `id` is not used for this, since it is the single ID filter.",
+				Computed: true,
+			},
 		},
 	}
 }
@@ -327,7 +397,7 @@ func (d *LifecyclePoliciesDataSource) Configure(ctx context.Context, req datasou
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
 }
 
 func (d *LifecyclePoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -377,10 +447,16 @@ func (d *LifecyclePoliciesDataSource) Read(ctx context.Context, req datasource.R
 		diags := data.StageGates.ElementsAs(ctx, &stageGates, false)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
-			for _, gate := range stageGates {
+			for i, gate := range stageGates {
+				if message := unifiedpolicy.ValidateLifecycleGate(d.ProviderData.ValidLifecycleGates(), gate); message != "" {
+					resp.Diagnostics.AddAttributeError(path.Root("stage_gates").AtListIndex(i), "Invalid Lifecycle Gate", message)
+				}
 				queryValues.Add("stage_gate", gate)
 			}
 		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 	// application_key (array form, explode)
 	if !data.ApplicationKeys.IsNull() {
@@ -414,17 +490,12 @@ func (d *LifecyclePoliciesDataSource) Read(ctx context.Context, req datasource.R
 		request.SetQueryParam("project_key", data.ProjectKey.ValueString())
 	}
 
-	// Application labels - API expects object with key-value pairs
-	// Note: The API documentation shows application_labels as an object, but we'll send as query params
-	// This may need adjustment based on actual API behavior
+	// Application labels have no query parameter form; they are matched client-side after the list call
+	var applicationLabels map[string]string
 	if !data.ApplicationLabels.IsNull() {
-		var labels map[string]string
-		diags := data.ApplicationLabels.ElementsAs(ctx, &labels, false)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			// The API expects application_labels as an object, but query params might need special handling
-			// For now, we'll log a warning that this might not work as expected
-			tflog.Warn(ctx, "Application labels filtering may not work correctly via query parameters. Check API documentation.")
+		resp.Diagnostics.Append(data.ApplicationLabels.ElementsAs(ctx, &applicationLabels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
@@ -445,36 +516,129 @@ func (d *LifecyclePoliciesDataSource) Read(ctx context.Context, req datasource.R
 		request.SetQueryParam("sort_by", data.SortBy.ValueString())
 	}
 
+	// sort_by (array form, explode) for multiple sort keys
+	if !data.SortByFields.IsNull() {
+		var sortFields []string
+		resp.Diagnostics.Append(data.SortByFields.ElementsAs(ctx, &sortFields, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		request.SetQueryParamsFromValues(url.Values{"sort_by": sortFields})
+	}
+
 	if !data.SortOrder.IsNull() {
 		request.SetQueryParam("sort_order", data.SortOrder.ValueString())
 	}
 
+	data.EffectiveQuery = types.StringValue(request.QueryParam.Encode())
+
 	var result PoliciesListAPIModel
-	response, err := request.SetResult(&result).Get(resource.PoliciesEndpoint)
+	if data.FetchAll.ValueBool() {
+		// Every page is accumulated into result, which then describes the first page's offset and all the items
+		first := true
+		resp.Diagnostics.Append(FetchListPages(request.QueryParam, func(query url.Values) (int, int, int, diag.Diagnostics) {
+			var page PoliciesListAPIModel
+			diags := d.readPoliciesPage(ctx, d.ProviderData.Client.R().SetContext(ctx).SetQueryParamsFromValues(query), &page)
+			if first {
+				result.Offset, result.Limit = page.Offset, page.Limit
+				first = false
+			}
+			result.Items = append(result.Items, page.Items...)
+			return page.Offset, len(page.Items), page.Limit, diags
+		})...)
+		result.PageSize = len(result.Items)
+	} else {
+		resp.Diagnostics.Append(d.readPoliciesPage(ctx, request, &result)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pageItems := len(result.Items)
+	if len(applicationLabels) > 0 {
+		matched := make([]lifecyclePolicyListEntry, 0, len(result.Items))
+		for _, item := range result.Items {
+			if PolicyMatchesApplicationLabels(item.Scope, applicationLabels) {
+				matched = append(matched, item)
+			}
+		}
+		tflog.Debug(ctx, "Filtered policies by application labels", map[string]interface{}{
+			"returned": len(result.Items),
+			"matched":  len(matched),
+		})
+		result.Items = matched
+	}
+
+	diags := data.FromAPIModel(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// has_more describes the page returned by the API, not the policies left after application_labels filtering
+	data.HasMore = types.BoolValue(!data.FetchAll.ValueBool() && hasMorePages(pageItems, result.Limit))
+
+	// application_labels is not part of the effective query, so it is added to the filters here
+	filters := data.EffectiveQuery.ValueString()
+	if len(applicationLabels) > 0 {
+		labelValues := url.Values{}
+		for key, value := range applicationLabels {
+			labelValues.Set(key, value)
+		}
+		filters += "#" + labelValues.Encode()
+	}
+	var policyIDs []string
+	resp.Diagnostics.Append(data.PolicyIDs.ElementsAs(ctx, &policyIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ResultID = types.StringValue(ListResultID(filters, policyIDs))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readPoliciesPage sends a prepared lifecycle policies list request and decodes the page into result.
+func (d *LifecyclePoliciesDataSource) readPoliciesPage(ctx context.Context, request *resty.Request, result *PoliciesListAPIModel) diag.Diagnostics {
+	var diags diag.Diagnostics
 
+	response, err := request.SetResult(result).Get(d.ProviderData.Endpoint(resource.PoliciesEndpoint))
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Unable to Read Data Source",
 			"An unexpected error occurred while fetching the data source. "+
 				"Please report this issue to the provider developers.\n\n"+
 				"Error: "+err.Error(),
 		)
-		return
+		return diags
 	}
 
 	if response.IsError() {
-		diags := unifiedpolicy.HandleAPIError(response, "read")
-		resp.Diagnostics.Append(diags...)
-		return
+		diags.Append(unifiedpolicy.HandleAPIError(response, "read")...)
 	}
+	return diags
+}
 
-	diags := data.FromAPIModel(ctx, result)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+// PolicyMatchesApplicationLabels reports whether the policy scope has every label in labels (same key and value).
+// This function is exported for testing purposes.
+func PolicyMatchesApplicationLabels(scope *resource.LifecycleScope, labels map[string]string) bool {
+	if len(labels) == 0 {
+		return true
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if scope == nil {
+		return false
+	}
+	for key, value := range labels {
+		found := false
+		for _, label := range scope.ApplicationLabels {
+			if label.Key == key && label.Value == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
 }
 
 func (m *LifecyclePoliciesDataSourceModel) FromAPIModel(ctx context.Context, apiModel PoliciesListAPIModel) diag.Diagnostics {
@@ -482,6 +646,7 @@ func (m *LifecyclePoliciesDataSourceModel) FromAPIModel(ctx context.Context, api
 
 	// Convert policies list
 	policies := make([]types.Object, len(apiModel.Items))
+	policyIDs := make([]string, len(apiModel.Items))
 	policyAttrTypes := map[string]attr.Type{
 		"id":          types.StringType,
 		"name":        types.StringType,
@@ -504,14 +669,17 @@ func (m *LifecyclePoliciesDataSourceModel) FromAPIModel(ctx context.Context, api
 				"value": types.StringType,
 			}}},
 		}},
-		"rule_ids":   types.ListType{ElemType: types.StringType},
-		"created_at": types.StringType,
-		"created_by": types.StringType,
-		"updated_at": types.StringType,
-		"updated_by": types.StringType,
+		"scope_description": types.StringType,
+		"rule_ids":          types.ListType{ElemType: types.StringType},
+		"priority":          types.Int64Type,
+		"created_at":        types.StringType,
+		"created_by":        types.StringType,
+		"updated_at":        types.StringType,
+		"updated_by":        types.StringType,
 	}
 
 	for i, policy := range apiModel.Items {
+		policyIDs[i] = policy.ID
 		policyAttrs := map[string]attr.Value{
 			"id":   types.StringValue(policy.ID),
 			"name": types.StringValue(policy.Name),
@@ -653,7 +821,9 @@ func (m *LifecyclePoliciesDataSourceModel) FromAPIModel(ctx context.Context, api
 			if !diags.HasError() {
 				policyAttrs["scope"] = scopeObj
 			}
+			policyAttrs["scope_description"] = types.StringValue(resource.ScopeDescription(*policy.Scope))
 		} else {
+			policyAttrs["scope_description"] = types.StringNull()
 			policyAttrs["scope"] = types.ObjectNull(map[string]attr.Type{
 				"type":             types.StringType,
 				"project_keys":     types.ListType{ElemType: types.StringType},
@@ -665,12 +835,20 @@ func (m *LifecyclePoliciesDataSourceModel) FromAPIModel(ctx context.Context, api
 			})
 		}
 
-		// Rule IDs: use rule_ids from API; when list is called with expand=rules, API may return rules array instead, so fallback to rules[].id
-		ruleIDs := policy.RuleIDs
-		if len(ruleIDs) == 0 && len(policy.Rules) > 0 {
-			ruleIDs = make([]string, len(policy.Rules))
-			for j, r := range policy.Rules {
-				ruleIDs[j] = r.ID
+		// Rule IDs: use rule_ids from API; when list is called with expand=rules, API may return rules array instead (or as well),
+		// so add rules[].id, skipping IDs already listed so a rule present in both is not listed twice
+		ruleIDs := make([]string, 0, len(policy.RuleIDs)+len(policy.Rules))
+		seenRuleIDs := make(map[string]bool, len(policy.RuleIDs)+len(policy.Rules))
+		for _, ruleID := range policy.RuleIDs {
+			if !seenRuleIDs[ruleID] {
+				seenRuleIDs[ruleID] = true
+				ruleIDs = append(ruleIDs, ruleID)
+			}
+		}
+		for _, r := range policy.Rules {
+			if !seenRuleIDs[r.ID] {
+				seenRuleIDs[r.ID] = true
+				ruleIDs = append(ruleIDs, r.ID)
 			}
 		}
 		if len(ruleIDs) > 0 {
@@ -687,6 +865,8 @@ func (m *LifecyclePoliciesDataSourceModel) FromAPIModel(ctx context.Context, api
 			policyAttrs["rule_ids"] = types.ListNull(types.StringType)
 		}
 
+		policyAttrs["priority"] = types.Int64PointerValue(policy.Priority)
+
 		// Timestamps
 		if policy.CreatedAt != "" {
 			policyAttrs["created_at"] = types.StringValue(policy.CreatedAt)
@@ -727,8 +907,49 @@ func (m *LifecyclePoliciesDataSourceModel) FromAPIModel(ctx context.Context, api
 		m.Policies = types.ListNull(types.ObjectType{AttrTypes: policyAttrTypes})
 	}
 
+	policyIDsList, idDiags := types.ListValueFrom(ctx, types.StringType, policyIDs)
+	diags.Append(idDiags...)
+	m.PolicyIDs = policyIDsList
+
 	m.Offset = types.Int64Value(int64(apiModel.Offset))
 	m.PageSize = types.Int64Value(int64(apiModel.PageSize))
+	m.HasMore = types.BoolValue(hasMorePages(len(apiModel.Items), apiModel.Limit))
 
 	return diags
 }
+
+// listAllPolicies reads every page of the lifecycle policies list. Shared by the datasources that resolve across all policies.
+func listAllPolicies(ctx context.Context, providerData unifiedpolicy.ProviderMetadata) ([]lifecyclePolicyListEntry, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var policies []lifecyclePolicyListEntry
+
+	for page := 0; ; page++ {
+		var result PoliciesListAPIModel
+		response, err := providerData.Client.R().
+			SetContext(ctx).
+			SetQueryParam("offset", strconv.Itoa(page)).
+			SetQueryParam("limit", strconv.Itoa(listPageLimit)).
+			SetResult(&result).
+			Get(providerData.Endpoint(resource.PoliciesEndpoint))
+
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Data Source",
+				"An unexpected error occurred while fetching the data source. "+
+					"Please report this issue to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return nil, diags
+		}
+
+		if response.IsError() {
+			diags.Append(unifiedpolicy.HandleAPIError(response, "read")...)
+			return nil, diags
+		}
+
+		policies = append(policies, result.Items...)
+		if len(result.Items) < listPageLimit {
+			return policies, diags
+		}
+	}
+}
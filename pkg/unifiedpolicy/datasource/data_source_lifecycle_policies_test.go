@@ -15,12 +15,19 @@
 package datasource_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
 )
 
 func lifecyclePolicyListConfig(t *testing.T, name string) string {
@@ -135,6 +142,8 @@ func TestAccLifecyclePoliciesDataSource_filterByID(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceFqrn, "policies.#", "1"),
 					resource.TestCheckResourceAttrPair(dataSourceFqrn, "policies.0.id", resourceName, "id"),
 					resource.TestCheckResourceAttr(dataSourceFqrn, "policies.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "policy_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceFqrn, "policy_ids.0", resourceName, "id"),
 				),
 			},
 		},
@@ -517,6 +526,8 @@ func TestAccLifecyclePoliciesDataSource_multiFilter(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceFqrn, "policies.0.id", resourceName, "id"),
 					resource.TestCheckResourceAttr(dataSourceFqrn, "policies.0.enabled", "true"),
 					resource.TestCheckResourceAttr(dataSourceFqrn, "policies.0.mode", "block"),
+					resource.TestMatchResourceAttr(dataSourceFqrn, "effective_query",
+						regexp.MustCompile(`^enabled=true&id=\d+&limit=10&mode=block&scope_type=project$`)),
 				),
 			},
 		},
@@ -549,3 +560,215 @@ func TestAccLifecyclePoliciesDataSource_filterByIDNonexistent(t *testing.T) {
 		},
 	})
 }
+
+func TestPolicyMatchesApplicationLabels(t *testing.T) {
+	scope := &unifiedpolicyresource.LifecycleScope{
+		Type: "application",
+		ApplicationLabels: []unifiedpolicyresource.ApplicationLabel{
+			{Key: "team", Value: "payments"},
+			{Key: "env", Value: "prod"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		scope    *unifiedpolicyresource.LifecycleScope
+		labels   map[string]string
+		expected bool
+	}{
+		{
+			name:     "no label filter matches everything",
+			scope:    nil,
+			labels:   nil,
+			expected: true,
+		},
+		{
+			name:     "all labels present",
+			scope:    scope,
+			labels:   map[string]string{"team": "payments", "env": "prod"},
+			expected: true,
+		},
+		{
+			name:     "subset of labels",
+			scope:    scope,
+			labels:   map[string]string{"env": "prod"},
+			expected: true,
+		},
+		{
+			name:     "same key with different value",
+			scope:    scope,
+			labels:   map[string]string{"env": "dev"},
+			expected: false,
+		},
+		{
+			name:     "one label missing",
+			scope:    scope,
+			labels:   map[string]string{"team": "payments", "tier": "1"},
+			expected: false,
+		},
+		{
+			name:     "project scope without labels",
+			scope:    &unifiedpolicyresource.LifecycleScope{Type: "project", ProjectKeys: []string{"proj"}},
+			labels:   map[string]string{"team": "payments"},
+			expected: false,
+		},
+		{
+			name:     "missing scope",
+			scope:    nil,
+			labels:   map[string]string{"team": "payments"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := unifiedpolicydatasource.PolicyMatchesApplicationLabels(tt.scope, tt.labels)
+			if result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestLifecyclePoliciesFromAPIModel_dedupesExpandedRules(t *testing.T) {
+	response := `{
+		"items": [{
+			"id": "1001",
+			"name": "policy",
+			"enabled": true,
+			"mode": "block",
+			"rule_ids": ["r1", "r2"],
+			"rules": [{"id": "r2"}, {"id": "r3"}]
+		}],
+		"offset": 0,
+		"limit": 100,
+		"page_size": 1
+	}`
+
+	var apiModel unifiedpolicydatasource.PoliciesListAPIModel
+	if err := json.Unmarshal([]byte(response), &apiModel); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var model unifiedpolicydatasource.LifecyclePoliciesDataSourceModel
+	diags := model.FromAPIModel(context.Background(), apiModel)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if len(model.Policies.Elements()) != 1 {
+		t.Fatalf("Expected 1 policy, got %d", len(model.Policies.Elements()))
+	}
+	policy := model.Policies.Elements()[0].(types.Object)
+	var ruleIDs []string
+	diags = policy.Attributes()["rule_ids"].(types.List).ElementsAs(context.Background(), &ruleIDs, false)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expected := []string{"r1", "r2", "r3"}
+	if !reflect.DeepEqual(ruleIDs, expected) {
+		t.Errorf("Expected rule_ids %v, got %v", expected, ruleIDs)
+	}
+}
+
+func TestLifecyclePoliciesFromAPIModel_priority(t *testing.T) {
+	response := `{
+		"items": [
+			{"id": "1001", "name": "first", "enabled": true, "mode": "block", "priority": 10},
+			{"id": "1002", "name": "second", "enabled": true, "mode": "block"}
+		],
+		"offset": 0,
+		"limit": 100,
+		"page_size": 2
+	}`
+
+	var apiModel unifiedpolicydatasource.PoliciesListAPIModel
+	if err := json.Unmarshal([]byte(response), &apiModel); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var model unifiedpolicydatasource.LifecyclePoliciesDataSourceModel
+	diags := model.FromAPIModel(context.Background(), apiModel)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	policies := model.Policies.Elements()
+	if got := policies[0].(types.Object).Attributes()["priority"].(types.Int64); got.ValueInt64() != 10 {
+		t.Errorf("Expected priority 10, got %v", got)
+	}
+	if got := policies[1].(types.Object).Attributes()["priority"].(types.Int64); !got.IsNull() {
+		t.Errorf("Expected null priority when the API returns none, got %v", got)
+	}
+}
+
+func TestLifecyclePoliciesFromAPIModel_scopeDescription(t *testing.T) {
+	response := `{
+		"items": [
+			{"id": "1001", "name": "first", "enabled": true, "mode": "block", "scope": {"type": "project", "project_keys": ["PROD-1"]}},
+			{"id": "1002", "name": "second", "enabled": true, "mode": "block",
+				"scope": {"type": "application", "application_labels": [{"key": "environment", "value": "production"}]}},
+			{"id": "1003", "name": "third", "enabled": true, "mode": "block"}
+		],
+		"offset": 0,
+		"limit": 100,
+		"page_size": 3
+	}`
+
+	var apiModel unifiedpolicydatasource.PoliciesListAPIModel
+	if err := json.Unmarshal([]byte(response), &apiModel); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var model unifiedpolicydatasource.LifecyclePoliciesDataSourceModel
+	diags := model.FromAPIModel(context.Background(), apiModel)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	policies := model.Policies.Elements()
+	for i, expected := range []string{"project PROD-1", "applications labeled environment=production"} {
+		if got := policies[i].(types.Object).Attributes()["scope_description"].(types.String); got.ValueString() != expected {
+			t.Errorf("Expected scope_description %q, got %v", expected, got)
+		}
+	}
+	if got := policies[2].(types.Object).Attributes()["scope_description"].(types.String); !got.IsNull() {
+		t.Errorf("Expected null scope_description without a scope, got %v", got)
+	}
+}
+
+func TestLifecyclePoliciesFromAPIModel_hasMore(t *testing.T) {
+	response := `{
+		"items": [
+			{"id": "1001", "name": "first", "enabled": true, "mode": "block"},
+			{"id": "1002", "name": "second", "enabled": true, "mode": "block"}
+		],
+		"offset": 0,
+		"limit": 2,
+		"page_size": 2
+	}`
+
+	var apiModel unifiedpolicydatasource.PoliciesListAPIModel
+	if err := json.Unmarshal([]byte(response), &apiModel); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var model unifiedpolicydatasource.LifecyclePoliciesDataSourceModel
+	diags := model.FromAPIModel(context.Background(), apiModel)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if !model.HasMore.ValueBool() {
+		t.Errorf("Expected has_more for a full page")
+	}
+
+	apiModel.Limit = 100
+	diags = model.FromAPIModel(context.Background(), apiModel)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if model.HasMore.ValueBool() {
+		t.Errorf("Expected no has_more for a partial page")
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -25,11 +26,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
 )
 
+// PolicyResolvedScopeEndpoint resolves the scope of a policy to the applications it currently affects.
+// Not available on all backend versions.
+const PolicyResolvedScopeEndpoint = resource.PolicyEndpoint + "/resolved_scope"
+
+// PolicyResolvedScopeAPIModel is the response of PolicyResolvedScopeEndpoint.
+type PolicyResolvedScopeAPIModel struct {
+	ApplicationKeys []string `json:"application_keys"`
+}
+
 var _ datasource.DataSource = &LifecyclePolicyDataSource{}
 
 func NewLifecyclePolicyDataSource() datasource.DataSource {
@@ -37,22 +46,26 @@ func NewLifecyclePolicyDataSource() datasource.DataSource {
 }
 
 type LifecyclePolicyDataSource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData unifiedpolicy.ProviderMetadata
 }
 
 type LifecyclePolicyDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Enabled     types.Bool   `tfsdk:"enabled"`
-	Mode        types.String `tfsdk:"mode"`
-	Action      types.Object `tfsdk:"action"`
-	Scope       types.Object `tfsdk:"scope"`
-	RuleIDs     types.List   `tfsdk:"rule_ids"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	CreatedBy   types.String `tfsdk:"created_by"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
-	UpdatedBy   types.String `tfsdk:"updated_by"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	Mode             types.String `tfsdk:"mode"`
+	Action           types.Object `tfsdk:"action"`
+	Scope            types.Object `tfsdk:"scope"`
+	ScopeDescription types.String `tfsdk:"scope_description"`
+	RuleIDs          types.List   `tfsdk:"rule_ids"`
+	Priority         types.Int64  `tfsdk:"priority"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	CreatedBy        types.String `tfsdk:"created_by"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+	UpdatedBy        types.String `tfsdk:"updated_by"`
+
+	ResolvedApplicationKeys types.List `tfsdk:"resolved_application_keys"`
 }
 
 func (d *LifecyclePolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -101,7 +114,7 @@ func (d *LifecyclePolicyDataSource) Schema(ctx context.Context, req datasource.S
 								Computed:    true,
 							},
 							"gate": schema.StringAttribute{
-								Description: "Lifecycle gate. One of: 'entry', 'exit', 'release'.",
+								Description: "Lifecycle gate, e.g. '" + strings.Join(unifiedpolicy.LifecycleGates, "', '") + "'.",
 								Computed:    true,
 							},
 						},
@@ -149,6 +162,15 @@ func (d *LifecyclePolicyDataSource) Schema(ctx context.Context, req datasource.S
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"scope_description": schema.StringAttribute{
+				Description: "The scope in plain English for reports and notifications, e.g. `project PROD-1` or " +
+					"`applications labeled environment=production`. Derived from `scope`; null when the policy has no scope.",
+				Computed: true,
+			},
+			"priority": schema.Int64Attribute{
+				Description: "Evaluation order of the policy among policies that apply to the same scope; lower values are evaluated first. Null when the backend does not return one.",
+				Computed:    true,
+			},
 			"created_at": schema.StringAttribute{
 				Description: "Timestamp when the policy was created.",
 				Computed:    true,
@@ -165,6 +187,13 @@ func (d *LifecyclePolicyDataSource) Schema(ctx context.Context, req datasource.S
 				Description: "User who last updated the policy.",
 				Computed:    true,
 			},
+			"resolved_application_keys": schema.ListAttribute{
+				Description: "Applications currently matching the `scope.application_labels` filter, as resolved by the backend, " +
+					"i.e. the applications a label-based policy actually affects right now. " +
+					"Null for scopes without labels and when the backend does not support scope resolution.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -173,7 +202,7 @@ func (d *LifecyclePolicyDataSource) Configure(ctx context.Context, req datasourc
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
 }
 
 func (d *LifecyclePolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -193,7 +222,7 @@ func (d *LifecyclePolicyDataSource) Read(ctx context.Context, req datasource.Rea
 		SetContext(ctx).
 		SetPathParam("policyId", data.ID.ValueString()).
 		SetResult(&result).
-		Get(resource.PolicyEndpoint)
+		Get(d.ProviderData.Endpoint(resource.PolicyEndpoint))
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -224,9 +253,64 @@ func (d *LifecyclePolicyDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
+	// Only label-based scopes need resolving; explicit keys are already in scope.application_keys
+	if result.Scope != nil && result.Scope.Type == "application" && len(result.Scope.ApplicationLabels) > 0 {
+		applicationKeys, ok, diags := ResolveApplicationKeys(ctx, d.ProviderData, result.ID)
+		resp.Diagnostics.Append(diags...)
+		if ok {
+			data.ResolvedApplicationKeys, diags = types.ListValueFrom(ctx, types.StringType, applicationKeys)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ResolveApplicationKeys returns the keys of the applications currently matching the scope of a policy. ok is false
+// when the backend does not support scope resolution; other failures are reported as warnings, since the policy
+// itself was read successfully.
+func ResolveApplicationKeys(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, policyID string) (applicationKeys []string, ok bool, diags diag.Diagnostics) {
+	var result PolicyResolvedScopeAPIModel
+	response, err := providerData.Client.R().
+		SetContext(ctx).
+		SetPathParam("policyId", policyID).
+		SetResult(&result).
+		Get(providerData.Endpoint(PolicyResolvedScopeEndpoint))
+
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Resolve Policy Scope",
+			fmt.Sprintf("resolved_application_keys is left null for policy '%s': %s", policyID, err.Error()),
+		)
+		return nil, false, diags
+	}
+
+	if response.IsError() {
+		switch response.StatusCode() {
+		case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+			tflog.Debug(ctx, "Policy scope resolution not available", map[string]interface{}{
+				"policy_id":   policyID,
+				"status_code": response.StatusCode(),
+			})
+		default:
+			diags.AddWarning(
+				"Unable to Resolve Policy Scope",
+				fmt.Sprintf("resolved_application_keys is left null for policy '%s' (HTTP %d): %s",
+					policyID, response.StatusCode(), string(response.Body())),
+			)
+		}
+		return nil, false, diags
+	}
+
+	if result.ApplicationKeys == nil {
+		result.ApplicationKeys = []string{}
+	}
+	return result.ApplicationKeys, true, diags
+}
+
 // FromAPIModel converts the API response model to the Terraform datasource model.
 func (m *LifecyclePolicyDataSourceModel) FromAPIModel(ctx context.Context, apiModel resource.LifecyclePolicyAPIModel) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -370,7 +454,9 @@ func (m *LifecyclePolicyDataSourceModel) FromAPIModel(ctx context.Context, apiMo
 		if !diags.HasError() {
 			m.Scope = scopeObj
 		}
+		m.ScopeDescription = types.StringValue(resource.ScopeDescription(*apiModel.Scope))
 	} else {
+		m.ScopeDescription = types.StringNull()
 		m.Scope = types.ObjectNull(map[string]attr.Type{
 			"type":             types.StringType,
 			"project_keys":     types.ListType{ElemType: types.StringType},
@@ -397,6 +483,8 @@ func (m *LifecyclePolicyDataSourceModel) FromAPIModel(ctx context.Context, apiMo
 		m.RuleIDs = types.ListNull(types.StringType)
 	}
 
+	m.Priority = types.Int64PointerValue(apiModel.Priority)
+
 	// Timestamps
 	if apiModel.CreatedAt != "" {
 		m.CreatedAt = types.StringValue(apiModel.CreatedAt)
@@ -422,5 +510,8 @@ func (m *LifecyclePolicyDataSourceModel) FromAPIModel(ctx context.Context, apiMo
 		m.UpdatedBy = types.StringNull()
 	}
 
+	// Set by Read when the backend resolves the scope
+	m.ResolvedApplicationKeys = types.ListNull(types.StringType)
+
 	return diags
 }
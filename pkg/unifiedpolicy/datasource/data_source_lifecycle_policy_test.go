@@ -15,14 +15,22 @@
 package datasource_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"regexp"
 	"testing"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
 )
 
 // checkLifecyclePolicyRuleAndTemplateDestroy verifies policy, rule, and template from the test are destroyed.
@@ -121,6 +129,7 @@ func TestAccLifecyclePolicyDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceFqrn, "scope.project_keys.#", "1"),
 					resource.TestCheckResourceAttr(dataSourceFqrn, "scope.project_keys.0", acctest.LifecyclePolicyProjectKey1),
 					resource.TestCheckResourceAttr(dataSourceFqrn, "rule_ids.#", "1"),
+					resource.TestCheckNoResourceAttr(dataSourceFqrn, "resolved_application_keys.#"),
 					resource.TestCheckResourceAttrSet(dataSourceFqrn, "id"),
 				),
 			},
@@ -150,3 +159,51 @@ func TestAccLifecyclePolicyDataSource_notFound(t *testing.T) {
 		},
 	})
 }
+
+func TestResolveApplicationKeys(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		wantKeys  []string
+		wantOK    bool
+		wantWarns int
+	}{
+		{"resolved", http.StatusOK, `{"application_keys":["app-1","app-2"]}`, []string{"app-1", "app-2"}, true, 0},
+		{"no matches", http.StatusOK, `{}`, []string{}, true, 0},
+		{"not supported", http.StatusNotFound, `{}`, nil, false, 0},
+		{"not implemented", http.StatusNotImplemented, `{}`, nil, false, 0},
+		{"server error", http.StatusInternalServerError, `{"errors":[{"message":"boom"}]}`, nil, false, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/unifiedpolicy/api/v1/policies/1001/resolved_scope" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			providerData := unifiedpolicy.ProviderMetadata{
+				ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+			}
+			keys, ok, diags := unifiedpolicydatasource.ResolveApplicationKeys(context.Background(), providerData, "1001")
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+			if ok != tt.wantOK {
+				t.Errorf("expected ok %v, got %v", tt.wantOK, ok)
+			}
+			if !reflect.DeepEqual(keys, tt.wantKeys) {
+				t.Errorf("expected keys %v, got %v", tt.wantKeys, keys)
+			}
+			if got := diags.WarningsCount(); got != tt.wantWarns {
+				t.Errorf("expected %d warnings, got %d", tt.wantWarns, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,385 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+// Object types that can be listed in the manifest (attribute `include`).
+const (
+	manifestTemplates = "templates"
+	manifestRules     = "rules"
+	manifestPolicies  = "policies"
+)
+
+var _ datasource.DataSource = &ManifestDataSource{}
+
+func NewManifestDataSource() datasource.DataSource {
+	return &ManifestDataSource{}
+}
+
+type ManifestDataSource struct {
+	ProviderData unifiedpolicy.ProviderMetadata
+}
+
+type ManifestDataSourceModel struct {
+	Include                types.List   `tfsdk:"include"`
+	NamePrefix             types.String `tfsdk:"name_prefix"`
+	IncludeSystemTemplates types.Bool   `tfsdk:"include_system_templates"`
+	Templates              types.Map    `tfsdk:"templates"`
+	Rules                  types.Map    `tfsdk:"rules"`
+	Policies               types.Map    `tfsdk:"policies"`
+	ManifestJSON           types.String `tfsdk:"manifest_json"`
+	Errors                 types.List   `tfsdk:"errors"`
+	ErrorCount             types.Int64  `tfsdk:"error_count"`
+}
+
+// Manifest maps object names to their IDs and versions, per object type. A nil map means the object type was not included.
+// Errors lists the objects left out of the manifest because they are malformed; it is not part of the JSON.
+type Manifest struct {
+	Templates map[string]ManifestTemplate `json:"templates"`
+	Rules     map[string]ManifestRule     `json:"rules"`
+	Policies  map[string]ManifestPolicy   `json:"policies"`
+	Errors    []BatchItemError            `json:"-"`
+}
+
+type ManifestTemplate struct {
+	ID      string `json:"id" tfsdk:"id"`
+	Version string `json:"version" tfsdk:"version"`
+}
+
+type ManifestRule struct {
+	ID         string `json:"id" tfsdk:"id"`
+	TemplateID string `json:"template_id" tfsdk:"template_id"`
+}
+
+type ManifestPolicy struct {
+	ID      string `json:"id" tfsdk:"id"`
+	Enabled bool   `json:"enabled" tfsdk:"enabled"`
+}
+
+var manifestTemplateAttrTypes = map[string]attr.Type{
+	"id":      types.StringType,
+	"version": types.StringType,
+}
+
+var manifestRuleAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"template_id": types.StringType,
+}
+
+var manifestPolicyAttrTypes = map[string]attr.Type{
+	"id":      types.StringType,
+	"enabled": types.BoolType,
+}
+
+func (d *ManifestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_manifest"
+}
+
+func (d *ManifestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read-only manifest of Unified Policy templates, rules and lifecycle policies, mapping each object name to its ID " +
+			"(and template version, rule template ID or policy enabled state). All pages of the list endpoints are read. " +
+			"The manifest is returned as maps and as `manifest_json` for GitOps reconciliation and external diff or audit tooling; " +
+			"write it to a file with e.g. the `local_file` resource. Object names are unique per object type. " +
+			"Objects without an ID or name, or with the name of an object listed before them, are reported in `errors` and left out, with a warning.",
+		Attributes: withBatchErrorsAttributes(map[string]schema.Attribute{
+			"include": schema.ListAttribute{
+				Description: "Object types to list: 'templates', 'rules', 'policies'. Defaults to all three.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf(manifestTemplates, manifestRules, manifestPolicies),
+					),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Only list objects whose name starts with this prefix.",
+				Optional:    true,
+			},
+			"include_system_templates": schema.BoolAttribute{
+				Description: "When true, system (`is_custom = false`) templates are listed as well. Defaults to false, since they are not managed with this provider.",
+				Optional:    true,
+			},
+			"templates": schema.MapNestedAttribute{
+				Description: "Templates keyed by name. Null when templates are not included.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the template.",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "The template version.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"rules": schema.MapNestedAttribute{
+				Description: "Rules keyed by name. Null when rules are not included.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the rule.",
+							Computed:    true,
+						},
+						"template_id": schema.StringAttribute{
+							Description: "The ID of the template the rule is based on.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"policies": schema.MapNestedAttribute{
+				Description: "Lifecycle policies keyed by name. Null when policies are not included.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the lifecycle policy.",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the policy is active.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"manifest_json": schema.StringAttribute{
+				Description: "The manifest as a JSON object with `templates`, `rules` and `policies` keys (null for object types that are not included), " +
+					"each mapping names to the same fields as the attributes above. Keys are sorted, so the output is stable across reads.",
+				Computed: true,
+			},
+		}, "The object type and ID, e.g. `rules/3001`, or the object type and list position, e.g. `rules[4]`, for an object without ID."),
+	}
+}
+
+func (d *ManifestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+func (d *ManifestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ManifestDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	include := []string{manifestTemplates, manifestRules, manifestPolicies}
+	if !data.Include.IsNull() {
+		resp.Diagnostics.Append(data.Include.ElementsAs(ctx, &include, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Reading manifest datasource", map[string]interface{}{
+		"include":     include,
+		"name_prefix": data.NamePrefix.ValueString(),
+	})
+
+	// nil slices mark object types that are not included
+	var templates []resource.TemplateAPIModel
+	var rules []resource.RuleAPIModel
+	var policies []resource.LifecyclePolicyAPIModel
+	for _, objectType := range include {
+		var diags diag.Diagnostics
+		switch objectType {
+		case manifestTemplates:
+			templates, diags = listAllTemplates(ctx, d.ProviderData)
+			if templates == nil {
+				templates = []resource.TemplateAPIModel{}
+			}
+		case manifestRules:
+			rules, diags = listAllRules(ctx, d.ProviderData)
+			if rules == nil {
+				rules = []resource.RuleAPIModel{}
+			}
+		case manifestPolicies:
+			var entries []lifecyclePolicyListEntry
+			entries, diags = listAllPolicies(ctx, d.ProviderData)
+			policies = make([]resource.LifecyclePolicyAPIModel, len(entries))
+			for i, entry := range entries {
+				policies[i] = entry.LifecyclePolicyAPIModel
+			}
+		}
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	manifest := BuildManifest(templates, rules, policies, data.NamePrefix.ValueString(), data.IncludeSystemTemplates.ValueBool())
+
+	resp.Diagnostics.Append(data.FromManifest(ctx, manifest)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(manifest.Errors) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Some Objects Were Left Out of the Manifest",
+			fmt.Sprintf("%d objects are malformed and missing from the manifest; see errors for details.", len(manifest.Errors)),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// BuildManifest maps object names to IDs for the listed templates, rules and policies. A nil slice leaves the
+// corresponding manifest map nil (object type not included). Objects whose name does not start with namePrefix
+// are skipped, as are system templates unless includeSystemTemplates is true. Objects without an ID or name, or
+// with a name already in the manifest, are added to Errors instead.
+// This function is exported for testing purposes.
+func BuildManifest(templates []resource.TemplateAPIModel, rules []resource.RuleAPIModel, policies []resource.LifecyclePolicyAPIModel, namePrefix string, includeSystemTemplates bool) Manifest {
+	var manifest Manifest
+
+	if templates != nil {
+		manifest.Templates = map[string]ManifestTemplate{}
+		seen := map[string]string{}
+		for i, template := range templates {
+			if !strings.HasPrefix(template.Name, namePrefix) || (!template.IsCustom && !includeSystemTemplates) {
+				continue
+			}
+			if itemErr, ok := manifestItemError(manifestTemplates, i, template.ID, template.Name, seen); !ok {
+				manifest.Errors = append(manifest.Errors, itemErr)
+				continue
+			}
+			manifest.Templates[template.Name] = ManifestTemplate{ID: template.ID, Version: template.Version}
+		}
+	}
+
+	if rules != nil {
+		manifest.Rules = map[string]ManifestRule{}
+		seen := map[string]string{}
+		for i, rule := range rules {
+			if !strings.HasPrefix(rule.Name, namePrefix) {
+				continue
+			}
+			if itemErr, ok := manifestItemError(manifestRules, i, rule.ID, rule.Name, seen); !ok {
+				manifest.Errors = append(manifest.Errors, itemErr)
+				continue
+			}
+			manifest.Rules[rule.Name] = ManifestRule{ID: rule.ID, TemplateID: rule.TemplateID}
+		}
+	}
+
+	if policies != nil {
+		manifest.Policies = map[string]ManifestPolicy{}
+		seen := map[string]string{}
+		for i, policy := range policies {
+			if !strings.HasPrefix(policy.Name, namePrefix) {
+				continue
+			}
+			if itemErr, ok := manifestItemError(manifestPolicies, i, policy.ID, policy.Name, seen); !ok {
+				manifest.Errors = append(manifest.Errors, itemErr)
+				continue
+			}
+			manifest.Policies[policy.Name] = ManifestPolicy{ID: policy.ID, Enabled: policy.Enabled}
+		}
+	}
+
+	return manifest
+}
+
+// manifestItemError checks a listed object before it is added to the manifest. It returns false with the error when
+// the object has no ID or name, or when seen (names to IDs of the objects of the same type already added) has its name.
+func manifestItemError(objectType string, index int, id, name string, seen map[string]string) (BatchItemError, bool) {
+	itemID := objectType + "/" + id
+	if id == "" {
+		itemID = fmt.Sprintf("%s[%d]", objectType, index)
+	}
+
+	var message string
+	switch {
+	case id == "":
+		message = fmt.Sprintf("The object '%s' has no ID.", name)
+	case name == "":
+		message = "The object has no name."
+	case seen[name] != "":
+		message = fmt.Sprintf("The name '%s' is also used by '%s', which is listed in the manifest.", name, seen[name])
+	default:
+		seen[name] = id
+		return BatchItemError{}, true
+	}
+	return BatchItemError{ID: itemID, Message: message}, false
+}
+
+// FromManifest sets the manifest maps, manifest_json and errors.
+func (m *ManifestDataSourceModel) FromManifest(ctx context.Context, manifest Manifest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Templates = types.MapNull(types.ObjectType{AttrTypes: manifestTemplateAttrTypes})
+	if manifest.Templates != nil {
+		templates, d := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: manifestTemplateAttrTypes}, manifest.Templates)
+		diags.Append(d...)
+		m.Templates = templates
+	}
+
+	m.Rules = types.MapNull(types.ObjectType{AttrTypes: manifestRuleAttrTypes})
+	if manifest.Rules != nil {
+		rules, d := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: manifestRuleAttrTypes}, manifest.Rules)
+		diags.Append(d...)
+		m.Rules = rules
+	}
+
+	m.Policies = types.MapNull(types.ObjectType{AttrTypes: manifestPolicyAttrTypes})
+	if manifest.Policies != nil {
+		policies, d := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: manifestPolicyAttrTypes}, manifest.Policies)
+		diags.Append(d...)
+		m.Policies = policies
+	}
+
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		diags.AddError("Unable to Serialize Manifest", err.Error())
+		return diags
+	}
+	m.ManifestJSON = types.StringValue(string(manifestJSON))
+
+	errorsList, errorCount, d := batchErrorsValues(ctx, manifest.Errors)
+	diags.Append(d...)
+	m.Errors = errorsList
+	m.ErrorCount = errorCount
+
+	return diags
+}
@@ -0,0 +1,176 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+func TestBuildManifest(t *testing.T) {
+	templates := []unifiedpolicyresource.TemplateAPIModel{
+		{ID: "t1", Name: "team-a-template", Version: "1.0.0", IsCustom: true},
+		{ID: "t2", Name: "team-b-template", Version: "2.0.0", IsCustom: true},
+		{ID: "t3", Name: "team-a-system", Version: "1.0.0", IsCustom: false},
+	}
+	rules := []unifiedpolicyresource.RuleAPIModel{
+		{ID: "r1", Name: "team-a-rule", TemplateID: "t1"},
+		{ID: "r2", Name: "team-b-rule", TemplateID: "t2"},
+	}
+	policies := []unifiedpolicyresource.LifecyclePolicyAPIModel{
+		{ID: "p1", Name: "team-a-policy", Enabled: true},
+	}
+
+	t.Run("filters by prefix and skips system templates", func(t *testing.T) {
+		manifest := unifiedpolicydatasource.BuildManifest(templates, rules, policies, "team-a-", false)
+		expected := unifiedpolicydatasource.Manifest{
+			Templates: map[string]unifiedpolicydatasource.ManifestTemplate{
+				"team-a-template": {ID: "t1", Version: "1.0.0"},
+			},
+			Rules: map[string]unifiedpolicydatasource.ManifestRule{
+				"team-a-rule": {ID: "r1", TemplateID: "t1"},
+			},
+			Policies: map[string]unifiedpolicydatasource.ManifestPolicy{
+				"team-a-policy": {ID: "p1", Enabled: true},
+			},
+		}
+		if !reflect.DeepEqual(manifest, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, manifest)
+		}
+	})
+
+	t.Run("includes system templates when requested", func(t *testing.T) {
+		manifest := unifiedpolicydatasource.BuildManifest(templates, nil, nil, "team-a-", true)
+		if len(manifest.Templates) != 2 {
+			t.Errorf("Expected 2 templates, got %+v", manifest.Templates)
+		}
+	})
+
+	t.Run("reports malformed objects and keeps the others", func(t *testing.T) {
+		mixed := []unifiedpolicyresource.RuleAPIModel{
+			{ID: "r1", Name: "rule", TemplateID: "t1"},
+			{ID: "", Name: "no-id", TemplateID: "t1"},
+			{ID: "r3", Name: "", TemplateID: "t1"},
+			{ID: "r4", Name: "rule", TemplateID: "t2"},
+			{ID: "r5", Name: "other-rule", TemplateID: "t2"},
+		}
+		manifest := unifiedpolicydatasource.BuildManifest(nil, mixed, nil, "", false)
+		expectedRules := map[string]unifiedpolicydatasource.ManifestRule{
+			"rule":       {ID: "r1", TemplateID: "t1"},
+			"other-rule": {ID: "r5", TemplateID: "t2"},
+		}
+		if !reflect.DeepEqual(manifest.Rules, expectedRules) {
+			t.Errorf("Expected rules %+v, got %+v", expectedRules, manifest.Rules)
+		}
+		var ids []string
+		for _, itemErr := range manifest.Errors {
+			ids = append(ids, itemErr.ID)
+		}
+		if expected := []string{"rules[1]", "rules/r3", "rules/r4"}; !reflect.DeepEqual(ids, expected) {
+			t.Errorf("Expected errors for %v, got %+v", expected, manifest.Errors)
+		}
+
+		var model unifiedpolicydatasource.ManifestDataSourceModel
+		if diags := model.FromManifest(context.Background(), manifest); diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+		if model.ErrorCount.ValueInt64() != 3 || len(model.Errors.Elements()) != 3 {
+			t.Errorf("Expected 3 errors, got error_count=%v errors=%v", model.ErrorCount, model.Errors)
+		}
+		if strings.Contains(model.ManifestJSON.ValueString(), "errors") {
+			t.Errorf("Expected errors to be left out of manifest_json, got %s", model.ManifestJSON.ValueString())
+		}
+	})
+
+	t.Run("object types not included stay null in JSON", func(t *testing.T) {
+		manifest := unifiedpolicydatasource.BuildManifest(nil, []unifiedpolicyresource.RuleAPIModel{}, nil, "", false)
+
+		var model unifiedpolicydatasource.ManifestDataSourceModel
+		diags := model.FromManifest(context.Background(), manifest)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+		expected := `{"templates":null,"rules":{},"policies":null}`
+		if model.ManifestJSON.ValueString() != expected {
+			t.Errorf("Expected %s, got %s", expected, model.ManifestJSON.ValueString())
+		}
+		if !model.Templates.IsNull() || model.Rules.IsNull() || !model.Policies.IsNull() {
+			t.Errorf("Expected only rules to be set, got templates=%v rules=%v policies=%v", model.Templates, model.Rules, model.Policies)
+		}
+	})
+}
+
+func TestAccManifestDataSource_basic(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, _, name := testutil.MkNames("test-manifest-", "unifiedpolicy_rule")
+	dataSourceFqrn := "data.unifiedpolicy_manifest.test"
+	resourceName := fmt.Sprintf("unifiedpolicy_rule.%s", name)
+	regoPath := acctest.RegoFixturePath(t, "basic_policy.rego")
+
+	config := fmt.Sprintf(`
+		resource "unifiedpolicy_template" "test" {
+			name             = "%s-template"
+			version          = "1.0.0"
+			category         = "security"
+			data_source_type = "evidence"
+			rego             = %q
+			parameters       = []
+		}
+
+		resource "unifiedpolicy_rule" "%s" {
+			name        = "%s"
+			template_id = unifiedpolicy_template.test.id
+			parameters  = []
+		}
+
+		data "unifiedpolicy_manifest" "test" {
+			include     = ["templates", "rules"]
+			name_prefix = "%s"
+
+			depends_on = [%s]
+		}
+	`, name, regoPath, name, name, name, resourceName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkRuleAndTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFqrn, "templates.%", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceFqrn, "templates."+name+"-template.id", "unifiedpolicy_template.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "templates."+name+"-template.version", "1.0.0"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "rules.%", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceFqrn, "rules."+name+".id", resourceName, "id"),
+					resource.TestCheckNoResourceAttr(dataSourceFqrn, "policies.%"),
+					resource.TestCheckResourceAttrSet(dataSourceFqrn, "manifest_json"),
+				),
+			},
+		},
+	})
+}
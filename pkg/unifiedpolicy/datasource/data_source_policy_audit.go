@@ -0,0 +1,272 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+// PolicyAuditEndpoint returns the change history of a single policy. Not available on all backend versions.
+const PolicyAuditEndpoint = resource.PolicyEndpoint + "/audit"
+
+var _ datasource.DataSource = &PolicyAuditDataSource{}
+
+func NewPolicyAuditDataSource() datasource.DataSource {
+	return &PolicyAuditDataSource{}
+}
+
+type PolicyAuditDataSource struct {
+	ProviderData unifiedpolicy.ProviderMetadata
+}
+
+type PolicyAuditDataSourceModel struct {
+	PolicyID types.String `tfsdk:"policy_id"`
+	Events   types.List   `tfsdk:"events"`
+}
+
+// PolicyAuditListAPIModel is the response shape for GET unifiedpolicy/api/v1/policies/{policyId}/audit.
+type PolicyAuditListAPIModel struct {
+	Items    []PolicyAuditEventAPIModel `json:"items"`
+	Offset   int                        `json:"offset"`
+	Limit    int                        `json:"limit"`
+	PageSize int                        `json:"page_size"`
+}
+
+type PolicyAuditEventAPIModel struct {
+	Timestamp string                      `json:"timestamp"`
+	User      string                      `json:"user"`
+	Action    string                      `json:"action"`
+	Changes   []PolicyAuditChangeAPIModel `json:"changes,omitempty"`
+}
+
+type PolicyAuditChangeAPIModel struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value,omitempty"`
+	NewValue string `json:"new_value,omitempty"`
+}
+
+var policyAuditChangeAttrTypes = map[string]attr.Type{
+	"field":     types.StringType,
+	"old_value": types.StringType,
+	"new_value": types.StringType,
+}
+
+var policyAuditEventAttrTypes = map[string]attr.Type{
+	"timestamp": types.StringType,
+	"user":      types.StringType,
+	"action":    types.StringType,
+	"changes":   types.ListType{ElemType: types.ObjectType{AttrTypes: policyAuditChangeAttrTypes}},
+}
+
+func (d *PolicyAuditDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_audit"
+}
+
+func (d *PolicyAuditDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the change history of a Unified Policy lifecycle policy: who changed it, when, and what changed. " +
+			"All pages of the audit log are read. " +
+			"Requires a backend version that exposes policy auditing; reading fails with a clear error otherwise.",
+		Attributes: map[string]schema.Attribute{
+			"policy_id": schema.StringAttribute{
+				Description: "The ID of the lifecycle policy to query.",
+				Required:    true,
+			},
+			"events": schema.ListNestedAttribute{
+				Description: "Change events in the order returned by the backend.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							Description: "Timestamp of the change.",
+							Computed:    true,
+						},
+						"user": schema.StringAttribute{
+							Description: "User who made the change.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Type of change, e.g. `created`, `updated` or `deleted`.",
+							Computed:    true,
+						},
+						"changes": schema.ListNestedAttribute{
+							Description: "Fields changed by the event. Null if the backend does not report field changes for it.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"field": schema.StringAttribute{
+										Description: "Name of the changed field.",
+										Computed:    true,
+									},
+									"old_value": schema.StringAttribute{
+										Description: "Value before the change. Null if the field was not set.",
+										Computed:    true,
+									},
+									"new_value": schema.StringAttribute{
+										Description: "Value after the change. Null if the field was removed.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PolicyAuditDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+func (d *PolicyAuditDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PolicyAuditDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading policy audit datasource", map[string]interface{}{
+		"policy_id": data.PolicyID.ValueString(),
+	})
+
+	events, diags := ListPolicyAuditEvents(ctx, d.ProviderData, data.PolicyID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPIModel(events)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ListPolicyAuditEvents reads every page of a policy's audit log.
+// This function is exported for testing purposes.
+func ListPolicyAuditEvents(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, policyID string) ([]PolicyAuditEventAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	events := []PolicyAuditEventAPIModel{}
+
+	for page := 0; ; page++ {
+		var result PolicyAuditListAPIModel
+		response, err := providerData.Client.R().
+			SetContext(ctx).
+			SetPathParam("policyId", policyID).
+			SetQueryParam("offset", strconv.Itoa(page)).
+			SetQueryParam("limit", strconv.Itoa(listPageLimit)).
+			SetResult(&result).
+			Get(providerData.Endpoint(PolicyAuditEndpoint))
+
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Data Source",
+				"An unexpected error occurred while fetching the data source. "+
+					"Please report this issue to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return nil, diags
+		}
+
+		if response.IsError() {
+			switch response.StatusCode() {
+			case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+				diags.AddError(
+					"Policy Audit Not Available",
+					fmt.Sprintf("No audit history was returned for policy '%s' (HTTP %d). "+
+						"Either the policy does not exist or this Unified Policy version does not expose policy auditing.",
+						policyID, response.StatusCode()),
+				)
+				return nil, diags
+			}
+			diags.Append(unifiedpolicy.HandleAPIError(response, "read")...)
+			return nil, diags
+		}
+
+		events = append(events, result.Items...)
+		if len(result.Items) < listPageLimit {
+			return events, diags
+		}
+	}
+}
+
+// FromAPIModel converts the API audit events to the Terraform datasource model.
+func (m *PolicyAuditDataSourceModel) FromAPIModel(events []PolicyAuditEventAPIModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	eventValues := make([]attr.Value, 0, len(events))
+	for _, event := range events {
+		changes := types.ListNull(types.ObjectType{AttrTypes: policyAuditChangeAttrTypes})
+		if len(event.Changes) > 0 {
+			changeValues := make([]attr.Value, 0, len(event.Changes))
+			for _, change := range event.Changes {
+				oldValue := types.StringNull()
+				if change.OldValue != "" {
+					oldValue = types.StringValue(change.OldValue)
+				}
+				newValue := types.StringNull()
+				if change.NewValue != "" {
+					newValue = types.StringValue(change.NewValue)
+				}
+				changeObj, d := types.ObjectValue(policyAuditChangeAttrTypes, map[string]attr.Value{
+					"field":     types.StringValue(change.Field),
+					"old_value": oldValue,
+					"new_value": newValue,
+				})
+				diags.Append(d...)
+				changeValues = append(changeValues, changeObj)
+			}
+			var d diag.Diagnostics
+			changes, d = types.ListValue(types.ObjectType{AttrTypes: policyAuditChangeAttrTypes}, changeValues)
+			diags.Append(d...)
+		}
+
+		eventObj, d := types.ObjectValue(policyAuditEventAttrTypes, map[string]attr.Value{
+			"timestamp": types.StringValue(event.Timestamp),
+			"user":      types.StringValue(event.User),
+			"action":    types.StringValue(event.Action),
+			"changes":   changes,
+		})
+		diags.Append(d...)
+		eventValues = append(eventValues, eventObj)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	eventList, d := types.ListValue(types.ObjectType{AttrTypes: policyAuditEventAttrTypes}, eventValues)
+	diags.Append(d...)
+	m.Events = eventList
+	return diags
+}
@@ -0,0 +1,168 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+)
+
+func TestAccPolicyAuditDataSource_notFound(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "unifiedpolicy_policy_audit" "test" {
+						policy_id = "non-existent-policy-id"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Policy Audit Not Available`),
+			},
+		},
+	})
+}
+
+func TestListPolicyAuditEvents(t *testing.T) {
+	const total = 260
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/unifiedpolicy/api/v1/policies/1001/audit" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		offset := r.URL.Query().Get("offset")
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offsets = append(offsets, offset)
+		page, _ := strconv.Atoi(offset)
+
+		result := unifiedpolicydatasource.PolicyAuditListAPIModel{Offset: page, Limit: limit}
+		for i := page * limit; i < total && i < (page+1)*limit; i++ {
+			result.Items = append(result.Items, unifiedpolicydatasource.PolicyAuditEventAPIModel{
+				Timestamp: fmt.Sprintf("2025-01-01T00:00:%03dZ", i),
+				User:      "admin",
+				Action:    "updated",
+			})
+		}
+		result.PageSize = len(result.Items)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	providerData := unifiedpolicy.ProviderMetadata{
+		ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+	}
+	events, diags := unifiedpolicydatasource.ListPolicyAuditEvents(context.Background(), providerData, "1001")
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+	if len(events) != total {
+		t.Errorf("expected %d events, got %d", total, len(events))
+	}
+	if len(offsets) != 2 || offsets[0] != "0" || offsets[1] != "1" {
+		t.Errorf("expected pages 0 and 1 to be read, got %v", offsets)
+	}
+}
+
+func TestListPolicyAuditEvents_notAvailable(t *testing.T) {
+	tests := []struct {
+		status    int
+		wantError string
+	}{
+		{http.StatusNotFound, "Policy Audit Not Available"},
+		{http.StatusMethodNotAllowed, "Policy Audit Not Available"},
+		{http.StatusNotImplemented, "Policy Audit Not Available"},
+		{http.StatusForbidden, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"errors":[{"message":"denied"}]}`))
+			}))
+			defer server.Close()
+
+			providerData := unifiedpolicy.ProviderMetadata{
+				ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+			}
+			_, diags := unifiedpolicydatasource.ListPolicyAuditEvents(context.Background(), providerData, "1001")
+			if !diags.HasError() {
+				t.Fatal("expected error diagnostics")
+			}
+			summary := diags.Errors()[0].Summary()
+			if tt.wantError != "" && summary != tt.wantError {
+				t.Errorf("expected summary %q, got %q", tt.wantError, summary)
+			}
+			if tt.wantError == "" && summary == "Policy Audit Not Available" {
+				t.Errorf("expected HTTP %d to be reported as an API error, got %q", tt.status, summary)
+			}
+		})
+	}
+}
+
+func TestPolicyAuditFromAPIModel(t *testing.T) {
+	var model unifiedpolicydatasource.PolicyAuditDataSourceModel
+	diags := model.FromAPIModel([]unifiedpolicydatasource.PolicyAuditEventAPIModel{
+		{Timestamp: "2025-01-01T00:00:00Z", User: "admin", Action: "created"},
+		{
+			Timestamp: "2025-01-02T00:00:00Z",
+			User:      "alice",
+			Action:    "updated",
+			Changes: []unifiedpolicydatasource.PolicyAuditChangeAPIModel{
+				{Field: "enabled", OldValue: "false", NewValue: "true"},
+				{Field: "description", OldValue: "old"},
+			},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	events := model.Events.Elements()
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+	if changes := events[0].(types.Object).Attributes()["changes"]; !changes.IsNull() {
+		t.Errorf("expected changes to be null for an event without field changes, got %s", changes)
+	}
+	changes := events[1].(types.Object).Attributes()["changes"].(types.List).Elements()
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %d", len(changes))
+	}
+	removed := changes[1].(types.Object).Attributes()
+	if removed["old_value"].(types.String).ValueString() != "old" || !removed["new_value"].IsNull() {
+		t.Errorf("expected old_value \"old\" and a null new_value for a removed field, got %v", removed)
+	}
+}
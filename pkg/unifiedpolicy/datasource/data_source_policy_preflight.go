@@ -0,0 +1,504 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+// Names of the preflight checks, reported in the check attribute of each issue.
+const (
+	PreflightCheckRego       = "rego"
+	PreflightCheckTemplateID = "template_id"
+	PreflightCheckParameters = "parameters"
+	PreflightCheckScope      = "scope"
+	PreflightCheckRuleIDs    = "rule_ids"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &PolicyPreflightDataSource{}
+
+func NewPolicyPreflightDataSource() datasource.DataSource {
+	return &PolicyPreflightDataSource{}
+}
+
+// PolicyPreflightDataSource checks a proposed template, rule and lifecycle policy combination without creating anything.
+type PolicyPreflightDataSource struct {
+	ProviderData unifiedpolicy.ProviderMetadata
+}
+
+type PolicyPreflightDataSourceModel struct {
+	Template      types.Object `tfsdk:"template"`
+	TemplateID    types.String `tfsdk:"template_id"`
+	Rule          types.Object `tfsdk:"rule"`
+	Scope         types.Object `tfsdk:"scope"`
+	RuleIDs       types.List   `tfsdk:"rule_ids"`
+	SkipAPIChecks types.Bool   `tfsdk:"skip_api_checks"`
+	Valid         types.Bool   `tfsdk:"valid"`
+	Issues        types.List   `tfsdk:"issues"`
+}
+
+type PolicyPreflightTemplateModel struct {
+	Rego        types.String `tfsdk:"rego"`
+	RegoContent types.String `tfsdk:"rego_content"`
+	Strict      types.Bool   `tfsdk:"strict"`
+	RegoVersion types.String `tfsdk:"rego_version"`
+	Parameters  types.List   `tfsdk:"parameters"`
+}
+
+type PolicyPreflightTemplateParameterModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+type PolicyPreflightRuleModel struct {
+	Parameters types.Map `tfsdk:"parameters"`
+}
+
+type PolicyPreflightScopeModel struct {
+	Type              types.String `tfsdk:"type"`
+	ProjectKeys       types.List   `tfsdk:"project_keys"`
+	ApplicationKeys   types.List   `tfsdk:"application_keys"`
+	ApplicationLabels types.Map    `tfsdk:"application_labels"`
+}
+
+// PreflightIssue is a single problem found by the preflight checks.
+type PreflightIssue struct {
+	Check   string
+	Message string
+}
+
+var preflightIssueAttrTypes = map[string]attr.Type{
+	"check":   types.StringType,
+	"message": types.StringType,
+}
+
+func (d *PolicyPreflightDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_preflight"
+}
+
+func (d *PolicyPreflightDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks that a proposed template, rule and lifecycle policy combination is consistent before anything is created, " +
+			"reusing the validation the resources apply. Problems are reported in `issues` instead of failing the read, which makes it suitable as a CI gate.\n\n" +
+			"Checks that run locally: Rego of `template` (length, syntax, allowed operations, optionally strict mode), `rule.parameters` against the " +
+			"template parameters, and `scope`. Checks that call the API, skipped when `skip_api_checks` is true: resolving `template_id` " +
+			"(its parameters are then used for the parameter check) and resolving each of `rule_ids`.",
+		Attributes: map[string]schema.Attribute{
+			"template": schema.SingleNestedAttribute{
+				Description: "Proposed template. Conflicts with `template_id`.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"rego": schema.StringAttribute{
+						Description: "Path to the .rego file, resolved as in the `unifiedpolicy_template` resource. Exactly one of `rego` and `rego_content` must be set.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("rego_content")),
+						},
+					},
+					"rego_content": schema.StringAttribute{
+						Description: "Inline Rego code.",
+						Optional:    true,
+					},
+					"strict": schema.BoolAttribute{
+						Description: "When true, the Rego code is also compiled with OPA strict mode. Defaults to false.",
+						Optional:    true,
+					},
+					"rego_version": schema.StringAttribute{
+						Description: "Rego language version the code is parsed as, `v0` or `v1`, as in the `unifiedpolicy_template` resource. Defaults to `v0`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(resource.RegoVersionV0, resource.RegoVersionV1),
+						},
+					},
+					"parameters": schema.ListNestedAttribute{
+						Description: "Template parameters.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: "Parameter name.",
+									Required:    true,
+								},
+								"type": schema.StringAttribute{
+									Description: "Parameter type. Must be one of: string, bool, int, float, object.",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("string", "bool", "int", "float", "object"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"template_id": schema.StringAttribute{
+				Description: "ID of an existing template the proposed rule is based on. Requires an API call. Conflicts with `template`.",
+				Optional:    true,
+			},
+			"rule": schema.SingleNestedAttribute{
+				Description: "Proposed rule. Its parameters are checked against the parameters of `template` or `template_id`.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"parameters": schema.MapAttribute{
+						Description: "Rule parameter values by name.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"scope": schema.SingleNestedAttribute{
+				Description: "Proposed lifecycle policy scope.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Scope type. Must be either 'project' or 'application'.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("project", "application"),
+						},
+					},
+					"project_keys": schema.ListAttribute{
+						Description: "Projects to include (project scope).",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"application_keys": schema.ListAttribute{
+						Description: "Applications to include (application scope).",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"application_labels": schema.MapAttribute{
+						Description: "Application label filters (application scope).",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"rule_ids": schema.ListAttribute{
+				Description: "IDs of existing rules the proposed policy references. The API allows exactly one rule per policy. Resolving them requires API calls.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"skip_api_checks": schema.BoolAttribute{
+				Description: "When true, only the local checks run: `template_id` and `rule_ids` are not resolved. Defaults to false.",
+				Optional:    true,
+			},
+			"valid": schema.BoolAttribute{
+				Description: "True when no issues were found.",
+				Computed:    true,
+			},
+			"issues": schema.ListNestedAttribute{
+				Description: "Problems found, in check order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"check": schema.StringAttribute{
+							Description: "The check that found the problem: `rego`, `template_id`, `parameters`, `scope` or `rule_ids`.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "Description of the problem.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PolicyPreflightDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+func (d *PolicyPreflightDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("template"),
+			path.MatchRoot("template_id"),
+		),
+	}
+}
+
+func (d *PolicyPreflightDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PolicyPreflightDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiChecks := !data.SkipAPIChecks.ValueBool()
+	tflog.Info(ctx, "Reading policy preflight datasource", map[string]interface{}{
+		"api_checks": apiChecks,
+	})
+
+	var issues []PreflightIssue
+
+	// Template: Rego checks are local; resolving template_id requires an API call
+	var templateParams []resource.TemplateParameterAPIModel
+	haveTemplate := false
+	if !data.Template.IsNull() {
+		var template PolicyPreflightTemplateModel
+		resp.Diagnostics.Append(data.Template.As(ctx, &template, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		issues = append(issues, d.regoIssues(template)...)
+
+		var params []PolicyPreflightTemplateParameterModel
+		if !template.Parameters.IsNull() {
+			resp.Diagnostics.Append(template.Parameters.ElementsAs(ctx, &params, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		for _, p := range params {
+			templateParams = append(templateParams, resource.TemplateParameterAPIModel{Name: p.Name.ValueString(), Type: p.Type.ValueString()})
+		}
+		haveTemplate = true
+	} else if !data.TemplateID.IsNull() && apiChecks {
+		var template resource.TemplateAPIModel
+		found, diags := d.get(ctx, resource.TemplateEndpoint, "templateId", data.TemplateID.ValueString(), &template, "template")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if found {
+			templateParams = template.Parameters
+			haveTemplate = true
+		} else {
+			issues = append(issues, PreflightIssue{PreflightCheckTemplateID, fmt.Sprintf("Template with ID '%s' was not found.", data.TemplateID.ValueString())})
+		}
+	}
+
+	// Rule parameters against the template parameters (local once the template is known)
+	if !data.Rule.IsNull() && haveTemplate {
+		var rule PolicyPreflightRuleModel
+		resp.Diagnostics.Append(data.Rule.As(ctx, &rule, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		values := map[string]string{}
+		if !rule.Parameters.IsNull() {
+			resp.Diagnostics.Append(rule.Parameters.ElementsAs(ctx, &values, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		issues = append(issues, ParameterIssues(templateParams, values)...)
+	}
+
+	// Scope (local)
+	if !data.Scope.IsNull() {
+		var scope PolicyPreflightScopeModel
+		resp.Diagnostics.Append(data.Scope.As(ctx, &scope, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		apiScope, diags := scope.toAPIModel(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if msg := resource.ValidateLifecycleScope(apiScope); msg != "" {
+			issues = append(issues, PreflightIssue{PreflightCheckScope, msg})
+		}
+	}
+
+	// rule_ids: the count is checked locally, resolving each ID requires an API call
+	if !data.RuleIDs.IsNull() {
+		var ruleIDs []string
+		resp.Diagnostics.Append(data.RuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(ruleIDs) != 1 {
+			issues = append(issues, PreflightIssue{PreflightCheckRuleIDs, fmt.Sprintf("The API allows exactly one rule per policy, got %d.", len(ruleIDs))})
+		}
+		if apiChecks {
+			for _, ruleID := range ruleIDs {
+				var rule resource.RuleAPIModel
+				found, diags := d.get(ctx, resource.RuleEndpoint, "rule_id", ruleID, &rule, "rule")
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				if !found {
+					issues = append(issues, PreflightIssue{PreflightCheckRuleIDs, fmt.Sprintf("Rule with ID '%s' was not found.", ruleID)})
+				}
+			}
+		}
+	}
+
+	tflog.Debug(ctx, "Policy preflight completed", map[string]interface{}{
+		"issues": len(issues),
+	})
+
+	resp.Diagnostics.Append(data.FromIssues(issues)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// regoIssues validates the template Rego with the same checks as the template resource.
+func (d *PolicyPreflightDataSource) regoIssues(template PolicyPreflightTemplateModel) []PreflightIssue {
+	var result resource.RegoValidationResult
+	if !template.Rego.IsNull() {
+		result = resource.ValidateRegoFile(template.Rego.ValueString(), d.ProviderData.ExpandRegoPath, template.Strict.ValueBool(), d.ProviderData.MaxRegoChars, d.ProviderData.RegoBaseDir, template.RegoVersion.ValueString(), d.ProviderData.ExtraAllowedRegoOperations)
+	} else {
+		result = resource.ValidateRegoCode(template.RegoContent.ValueString(), template.Strict.ValueBool(), d.ProviderData.MaxRegoChars, template.RegoVersion.ValueString(), d.ProviderData.ExtraAllowedRegoOperations)
+	}
+	return RegoIssues(result)
+}
+
+// get fetches a single object by ID into result. It returns false without diagnostics when the object does not exist.
+func (d *PolicyPreflightDataSource) get(ctx context.Context, endpoint, pathParam, id string, result interface{}, resourceType string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	response, err := d.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParam(pathParam, id).
+		SetResult(result).
+		Get(d.ProviderData.Endpoint(endpoint))
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while fetching the data source. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return false, diags
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		return false, diags
+	}
+	if response.IsError() {
+		diags.Append(unifiedpolicy.HandleAPIErrorWithType(response, "read", resourceType)...)
+		return false, diags
+	}
+
+	return true, diags
+}
+
+// RegoIssues converts a Rego validation result to preflight issues.
+// This function is exported for testing purposes.
+func RegoIssues(result resource.RegoValidationResult) []PreflightIssue {
+	var issues []PreflightIssue
+	if result.Error != "" {
+		issues = append(issues, PreflightIssue{PreflightCheckRego, result.Error})
+	}
+	for _, op := range result.DisallowedOperations {
+		issues = append(issues, PreflightIssue{PreflightCheckRego, fmt.Sprintf("Operation '%s' is not allowed.", op)})
+	}
+	for _, strictErr := range result.StrictErrors {
+		issues = append(issues, PreflightIssue{PreflightCheckRego, "Strict mode: " + strictErr})
+	}
+	return issues
+}
+
+// ParameterIssues checks rule parameter values, by name, against the template parameters.
+// Parameters are checked in name order so the issues are stable.
+// This function is exported for testing purposes.
+func ParameterIssues(templateParams []resource.TemplateParameterAPIModel, values map[string]string) []PreflightIssue {
+	ruleParams := make([]resource.RuleParameterAPIModel, 0, len(values))
+	for name, value := range values {
+		ruleParams = append(ruleParams, resource.RuleParameterAPIModel{Name: name, Value: value})
+	}
+	sort.Slice(ruleParams, func(i, j int) bool { return ruleParams[i].Name < ruleParams[j].Name })
+
+	var issues []PreflightIssue
+	for _, msg := range resource.ValidateRuleParameters(templateParams, ruleParams) {
+		issues = append(issues, PreflightIssue{PreflightCheckParameters, msg})
+	}
+	return issues
+}
+
+// toAPIModel converts the proposed scope to the lifecycle policy API model.
+func (m PolicyPreflightScopeModel) toAPIModel(ctx context.Context) (resource.LifecycleScope, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	scope := resource.LifecycleScope{Type: m.Type.ValueString()}
+	if !m.ProjectKeys.IsNull() {
+		diags.Append(m.ProjectKeys.ElementsAs(ctx, &scope.ProjectKeys, false)...)
+	}
+	if !m.ApplicationKeys.IsNull() {
+		diags.Append(m.ApplicationKeys.ElementsAs(ctx, &scope.ApplicationKeys, false)...)
+	}
+	if !m.ApplicationLabels.IsNull() {
+		labels := map[string]string{}
+		diags.Append(m.ApplicationLabels.ElementsAs(ctx, &labels, false)...)
+		keys := make([]string, 0, len(labels))
+		for key := range labels {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			scope.ApplicationLabels = append(scope.ApplicationLabels, resource.ApplicationLabel{Key: key, Value: labels[key]})
+		}
+	}
+
+	return scope, diags
+}
+
+// FromIssues sets valid and issues from the problems found.
+func (m *PolicyPreflightDataSourceModel) FromIssues(issues []PreflightIssue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	issueObjs := make([]attr.Value, 0, len(issues))
+	for _, issue := range issues {
+		issueObj, d := types.ObjectValue(preflightIssueAttrTypes, map[string]attr.Value{
+			"check":   types.StringValue(issue.Check),
+			"message": types.StringValue(issue.Message),
+		})
+		diags.Append(d...)
+		issueObjs = append(issueObjs, issueObj)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	issuesList, d := types.ListValue(types.ObjectType{AttrTypes: preflightIssueAttrTypes}, issueObjs)
+	diags.Append(d...)
+
+	m.Valid = types.BoolValue(len(issues) == 0)
+	m.Issues = issuesList
+
+	return diags
+}
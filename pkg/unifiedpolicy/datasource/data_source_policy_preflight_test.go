@@ -0,0 +1,113 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+func TestParameterIssues(t *testing.T) {
+	templateParams := []unifiedpolicyresource.TemplateParameterAPIModel{
+		{Name: "severity", Type: "string"},
+		{Name: "enabled", Type: "bool"},
+	}
+
+	issues := unifiedpolicydatasource.ParameterIssues(templateParams, map[string]string{
+		"severity": "high",
+		"enabled":  "maybe",
+		"extra":    "1",
+	})
+
+	expected := []unifiedpolicydatasource.PreflightIssue{
+		{Check: "parameters", Message: "Parameter 'enabled' value 'maybe' is not a valid bool."},
+		{Check: "parameters", Message: "Parameter 'extra' is not defined by the template."},
+	}
+	if !reflect.DeepEqual(issues, expected) {
+		t.Errorf("expected %v, got %v", expected, issues)
+	}
+}
+
+func TestRegoIssues(t *testing.T) {
+	result := unifiedpolicyresource.ValidateRegoCode(`package unifiedpolicy
+allow {
+    http.send({"method": "get", "url": "https://example.com"})
+}`, false, 0, unifiedpolicyresource.RegoVersionV0, nil)
+
+	issues := unifiedpolicydatasource.RegoIssues(result)
+	if len(issues) != 1 || issues[0].Check != "rego" || issues[0].Message != "Operation 'http.send' is not allowed." {
+		t.Errorf("expected a single disallowed operation issue, got %v", issues)
+	}
+
+	if issues := unifiedpolicydatasource.RegoIssues(unifiedpolicyresource.RegoValidationResult{}); len(issues) != 0 {
+		t.Errorf("expected no issues for a valid result, got %v", issues)
+	}
+}
+
+func TestAccPolicyPreflightDataSource_localChecks(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	regoPath := acctest.RegoFixturePath(t, "params_severity_policy.rego")
+
+	config := fmt.Sprintf(`
+		data "unifiedpolicy_policy_preflight" "test" {
+			skip_api_checks = true
+
+			template = {
+				rego = %q
+				parameters = [
+					{
+						name = "severity_threshold"
+						type = "string"
+					}
+				]
+			}
+
+			rule = {
+				parameters = {
+					severity_threshold = "high"
+					unknown_param      = "1"
+				}
+			}
+
+			scope = {
+				type = "project"
+			}
+		}
+	`, regoPath)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unifiedpolicy_policy_preflight.test", "valid", "false"),
+					resource.TestCheckResourceAttr("data.unifiedpolicy_policy_preflight.test", "issues.#", "2"),
+					resource.TestCheckResourceAttr("data.unifiedpolicy_policy_preflight.test", "issues.0.check", "parameters"),
+					resource.TestCheckResourceAttr("data.unifiedpolicy_policy_preflight.test", "issues.1.check", "scope"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,154 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+// PolicyStatsEndpoint returns enforcement statistics for a single policy. Not available on all backend versions.
+const PolicyStatsEndpoint = resource.PolicyEndpoint + "/stats"
+
+var _ datasource.DataSource = &PolicyStatsDataSource{}
+
+func NewPolicyStatsDataSource() datasource.DataSource {
+	return &PolicyStatsDataSource{}
+}
+
+type PolicyStatsDataSource struct {
+	ProviderData unifiedpolicy.ProviderMetadata
+}
+
+type PolicyStatsDataSourceModel struct {
+	PolicyID      types.String `tfsdk:"policy_id"`
+	Blocks        types.Int64  `tfsdk:"blocks"`
+	Warnings      types.Int64  `tfsdk:"warnings"`
+	LastTriggered types.String `tfsdk:"last_triggered"`
+}
+
+// PolicyStatsAPIModel is the response shape for GET unifiedpolicy/api/v1/policies/{policyId}/stats.
+type PolicyStatsAPIModel struct {
+	PolicyID      string `json:"policy_id"`
+	Blocks        int64  `json:"blocks"`
+	Warnings      int64  `json:"warnings"`
+	LastTriggered string `json:"last_triggered,omitempty"`
+}
+
+func (d *PolicyStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_stats"
+}
+
+func (d *PolicyStatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns enforcement statistics for a Unified Policy lifecycle policy: how often it blocked or warned, and when it was last triggered. " +
+			"Requires a backend version that exposes policy statistics; reading fails with a clear error otherwise.",
+		Attributes: map[string]schema.Attribute{
+			"policy_id": schema.StringAttribute{
+				Description: "The ID of the lifecycle policy to query.",
+				Required:    true,
+			},
+			"blocks": schema.Int64Attribute{
+				Description: "Number of times the policy blocked a promotion.",
+				Computed:    true,
+			},
+			"warnings": schema.Int64Attribute{
+				Description: "Number of times the policy reported a warning.",
+				Computed:    true,
+			},
+			"last_triggered": schema.StringAttribute{
+				Description: "Timestamp when the policy was last triggered. Null if it was never triggered.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *PolicyStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+func (d *PolicyStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PolicyStatsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading policy stats datasource", map[string]interface{}{
+		"policy_id": data.PolicyID.ValueString(),
+	})
+
+	var result PolicyStatsAPIModel
+	response, err := d.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParam("policyId", data.PolicyID.ValueString()).
+		SetResult(&result).
+		Get(d.ProviderData.Endpoint(PolicyStatsEndpoint))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while fetching the data source. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	if response.IsError() {
+		switch response.StatusCode() {
+		case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+			resp.Diagnostics.AddError(
+				"Policy Statistics Not Available",
+				fmt.Sprintf("No enforcement statistics were returned for policy '%s' (HTTP %d). "+
+					"Either the policy does not exist or this Unified Policy version does not expose policy statistics.",
+					data.PolicyID.ValueString(), response.StatusCode()),
+			)
+			return
+		}
+		diags := unifiedpolicy.HandleAPIError(response, "read")
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	data.FromAPIModel(result)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// FromAPIModel converts the API response model to the Terraform datasource model.
+func (m *PolicyStatsDataSourceModel) FromAPIModel(apiModel PolicyStatsAPIModel) {
+	m.Blocks = types.Int64Value(apiModel.Blocks)
+	m.Warnings = types.Int64Value(apiModel.Warnings)
+
+	if apiModel.LastTriggered != "" {
+		m.LastTriggered = types.StringValue(apiModel.LastTriggered)
+	} else {
+		m.LastTriggered = types.StringNull()
+	}
+}
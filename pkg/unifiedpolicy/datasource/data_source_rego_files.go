@@ -0,0 +1,261 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+var _ datasource.DataSource = &RegoFilesDataSource{}
+
+func NewRegoFilesDataSource() datasource.DataSource {
+	return &RegoFilesDataSource{}
+}
+
+// RegoFilesDataSource lists and validates the .rego files matching a glob pattern locally, without calling the API.
+type RegoFilesDataSource struct {
+	ProviderData unifiedpolicy.ProviderMetadata
+}
+
+type RegoFilesDataSourceModel struct {
+	Pattern     types.String `tfsdk:"pattern"`
+	Parallelism types.Int64  `tfsdk:"parallelism"`
+	Files       types.List   `tfsdk:"files"`
+}
+
+var regoFileAttrTypes = map[string]attr.Type{
+	"path":                  types.StringType,
+	"package":               types.StringType,
+	"valid":                 types.BoolType,
+	"disallowed_operations": types.ListType{ElemType: types.StringType},
+}
+
+func (d *RegoFilesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rego_files"
+}
+
+func (d *RegoFilesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the .rego files matching a glob pattern, e.g. a policy library directory, and validates each of them with the checks " +
+			"the `unifiedpolicy_template` resource applies (length against the provider's `max_rego_chars`, syntax and allowed operations). " +
+			"Use `files` to drive `for_each` over `unifiedpolicy_template` resources, e.g. keyed by `package`.",
+		Attributes: map[string]schema.Attribute{
+			"pattern": schema.StringAttribute{
+				Description: "Glob pattern matching the .rego files, e.g. `/opt/policies/*.rego`, using the syntax of Go's `filepath.Match` " +
+					"(`**` is not supported). Must be an absolute (full) path; environment variables and a leading `~` are expanded when " +
+					"the provider attribute `expand_rego_path` is true. Matches that are directories or do not end with .rego are ignored.",
+				Required: true,
+			},
+			"parallelism": schema.Int64Attribute{
+				Description: "Number of files validated concurrently, to speed up large policy libraries. The order of `files` does not " +
+					"depend on it. Optional; defaults to the number of CPUs available to the provider.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"files": schema.ListNestedAttribute{
+				Description: "The matching .rego files, sorted by path. Empty when nothing matches.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Description: "Full (absolute) path of the file, usable as `rego` of a `unifiedpolicy_template`.",
+							Computed:    true,
+						},
+						"package": schema.StringAttribute{
+							Description: "Package of the module without the `data.` prefix (e.g. `curation.policies`). Null when the file cannot be read or parsed.",
+							Computed:    true,
+						},
+						"valid": schema.BoolAttribute{
+							Description: "Whether the file passed all checks.",
+							Computed:    true,
+						},
+						"disallowed_operations": schema.ListAttribute{
+							Description: "Built-in operations used by the module that are not allowed.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RegoFilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+func (d *RegoFilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RegoFilesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	paths, err := RegoFilePaths(data.Pattern.ValueString(), d.ProviderData.ExpandRegoPath)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pattern"),
+			"Invalid Rego Files Pattern",
+			"Unable to list .rego files: "+err.Error(),
+		)
+		return
+	}
+
+	workers := runtime.GOMAXPROCS(0)
+	if !data.Parallelism.IsNull() {
+		workers = int(data.Parallelism.ValueInt64())
+	}
+
+	tflog.Info(ctx, "Validating Rego files", map[string]interface{}{
+		"pattern": data.Pattern.ValueString(),
+		"files":   len(paths),
+		"workers": workers,
+	})
+
+	// The paths are glob matches of the already expanded pattern, so they are not expanded again
+	results := ValidateRegoFiles(paths, workers, func(regoPath string) resource.RegoValidationResult {
+		return resource.ValidateRegoFile(regoPath, false, false, d.ProviderData.MaxRegoChars, d.ProviderData.RegoBaseDir, resource.RegoVersionV0, d.ProviderData.ExtraAllowedRegoOperations)
+	})
+
+	resp.Diagnostics.Append(data.FromValidationResults(ctx, paths, results)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ValidateRegoFiles validates the files with up to workers goroutines and returns the results in the order of paths,
+// whatever order the files finish in. Every call of validate parses its file with a parser of its own, so the OPA
+// parser is not shared between goroutines.
+// This function is exported for testing purposes.
+func ValidateRegoFiles(paths []string, workers int, validate func(regoPath string) resource.RegoValidationResult) []resource.RegoValidationResult {
+	results := make([]resource.RegoValidationResult, len(paths))
+	workers = max(1, min(workers, len(paths)))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = validate(paths[i])
+			}
+		}()
+	}
+	for i := range paths {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+// RegoFilePaths returns the .rego files matching an absolute glob pattern, sorted by path. Directories and files
+// without the .rego extension are left out. When expand is true, environment variables and a leading ~ are
+// expanded first (provider attribute `expand_rego_path`).
+// This function is exported for testing purposes.
+func RegoFilePaths(pattern string, expand bool) ([]string, error) {
+	pattern = strings.TrimSpace(pattern)
+	if expand {
+		expanded, err := resource.ExpandRegoPath(pattern)
+		if err != nil {
+			return nil, err
+		}
+		pattern = expanded
+	}
+	if !filepath.IsAbs(pattern) {
+		return nil, errors.New("pattern must be an absolute (full) path: " + pattern)
+	}
+
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(matches))
+	for _, match := range matches {
+		if !strings.HasSuffix(match, ".rego") {
+			continue
+		}
+		if info, err := os.Stat(match); err != nil || info.IsDir() {
+			continue
+		}
+		paths = append(paths, match)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// FromValidationResults sets files from the validation result of each file.
+func (m *RegoFilesDataSourceModel) FromValidationResults(ctx context.Context, paths []string, results []resource.RegoValidationResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	files := make([]attr.Value, 0, len(results))
+	for i, result := range results {
+		packageValue := types.StringNull()
+		if result.Package != "" {
+			packageValue = types.StringValue(result.Package)
+		}
+		disallowedOps, d := types.ListValueFrom(ctx, types.StringType, nonNilStrings(result.DisallowedOperations))
+		diags.Append(d...)
+
+		obj, d := types.ObjectValue(regoFileAttrTypes, map[string]attr.Value{
+			"path":                  types.StringValue(paths[i]),
+			"package":               packageValue,
+			"valid":                 types.BoolValue(result.Valid()),
+			"disallowed_operations": disallowedOps,
+		})
+		diags.Append(d...)
+		files = append(files, obj)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	filesList, d := types.ListValue(types.ObjectType{AttrTypes: regoFileAttrTypes}, files)
+	diags.Append(d...)
+	m.Files = filesList
+
+	return diags
+}
@@ -0,0 +1,222 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+func TestAccRegoFilesDataSource_fixtures(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	dataSourceFqrn := "data.unifiedpolicy_rego_files.test"
+	config := fmt.Sprintf(`
+		data "unifiedpolicy_rego_files" "test" {
+			pattern = %q
+		}
+	`, acctest.RegoFixturePath(t, "invalid_*.rego"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFqrn, "files.#", "6"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "files.0.path", acctest.RegoFixturePath(t, "invalid_http_send.rego")),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "files.0.package", "unifiedpolicy"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "files.0.valid", "false"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "files.0.disallowed_operations.0", "http.send"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "files.5.path", acctest.RegoFixturePath(t, "invalid_syntax.rego")),
+					resource.TestCheckNoResourceAttr(dataSourceFqrn, "files.5.package"),
+				),
+			},
+		},
+	})
+}
+
+func TestRegoFilePaths(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"b.rego", "a.rego", "notes.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("package unifiedpolicy\n"), 0o600); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "nested.rego"), 0o700); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	t.Setenv("UNIFIEDPOLICY_TEST_POLICY_DIR", dir)
+
+	tests := []struct {
+		name        string
+		pattern     string
+		expand      bool
+		expected    []string
+		expectError bool
+	}{
+		{
+			name:     "rego files only",
+			pattern:  filepath.Join(dir, "*"),
+			expected: []string{filepath.Join(dir, "a.rego"), filepath.Join(dir, "b.rego")},
+		},
+		{
+			name:     "expanded pattern",
+			pattern:  "$UNIFIEDPOLICY_TEST_POLICY_DIR/a*.rego",
+			expand:   true,
+			expected: []string{filepath.Join(dir, "a.rego")},
+		},
+		{
+			name:     "no match",
+			pattern:  filepath.Join(dir, "*.json"),
+			expected: []string{},
+		},
+		{
+			name:        "relative pattern",
+			pattern:     "policies/*.rego",
+			expectError: true,
+		},
+		{
+			name:        "unexpanded pattern",
+			pattern:     "$UNIFIEDPOLICY_TEST_POLICY_DIR/*.rego",
+			expectError: true,
+		},
+		{
+			name:        "malformed pattern",
+			pattern:     filepath.Join(dir, "[.rego"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paths, err := unifiedpolicydatasource.RegoFilePaths(tt.pattern, tt.expand)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("Expected error %v, got %v", tt.expectError, err)
+			}
+			if !tt.expectError && !reflect.DeepEqual(paths, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, paths)
+			}
+		})
+	}
+}
+
+func TestRegoFilesFromValidationResults(t *testing.T) {
+	var model unifiedpolicydatasource.RegoFilesDataSourceModel
+	diags := model.FromValidationResults(context.Background(),
+		[]string{"/policies/a.rego", "/policies/b.rego"},
+		[]unifiedpolicyresource.RegoValidationResult{
+			{Package: "curation.policies"},
+			{Error: "1 error occurred: policy.rego:2: rego_parse_error"},
+		},
+	)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	var files []struct {
+		Path                 types.String `tfsdk:"path"`
+		Package              types.String `tfsdk:"package"`
+		Valid                types.Bool   `tfsdk:"valid"`
+		DisallowedOperations []string     `tfsdk:"disallowed_operations"`
+	}
+	if diags := model.Files.ElementsAs(context.Background(), &files, false); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+	if len(files) != 2 {
+		t.Fatalf("Expected 2 files, got %d", len(files))
+	}
+	if files[0].Package.ValueString() != "curation.policies" || !files[0].Valid.ValueBool() {
+		t.Errorf("Unexpected first file: %+v", files[0])
+	}
+	if !files[1].Package.IsNull() || files[1].Valid.ValueBool() || len(files[1].DisallowedOperations) != 0 {
+		t.Errorf("Unexpected second file: %+v", files[1])
+	}
+}
+
+// writeRegoFiles writes n policies to dir, every third of them using a disallowed operation, and returns their
+// paths sorted like RegoFilePaths.
+func writeRegoFiles(tb testing.TB, dir string, n int) []string {
+	tb.Helper()
+	paths := make([]string, n)
+	for i := range paths {
+		body := fmt.Sprintf("input.evidence.severity != \"critical-%d\"", i)
+		if i%3 == 0 {
+			body = `http.send({"method": "GET", "url": "https://example.com"})`
+		}
+		paths[i] = filepath.Join(dir, fmt.Sprintf("policy_%03d.rego", i))
+		content := fmt.Sprintf("package policies.p%d\n\ndefault allow = false\n\nallow {\n  %s\n}\n", i, body)
+		if err := os.WriteFile(paths[i], []byte(content), 0o600); err != nil {
+			tb.Fatalf("Failed to write %s: %v", paths[i], err)
+		}
+	}
+	return paths
+}
+
+func validateRegoFile(regoPath string) unifiedpolicyresource.RegoValidationResult {
+	return unifiedpolicyresource.ValidateRegoFile(regoPath, false, false, 0, "", unifiedpolicyresource.RegoVersionV0, nil)
+}
+
+func TestValidateRegoFiles(t *testing.T) {
+	paths := writeRegoFiles(t, t.TempDir(), 40)
+
+	expected := unifiedpolicydatasource.ValidateRegoFiles(paths, 1, validateRegoFile)
+	for i, result := range expected {
+		if result.Package != fmt.Sprintf("policies.p%d", i) {
+			t.Fatalf("Expected result %d for %s, got package %q", i, paths[i], result.Package)
+		}
+		if disallowed := len(result.DisallowedOperations) > 0; disallowed != (i%3 == 0) {
+			t.Errorf("Unexpected disallowed operations for %s: %v", paths[i], result.DisallowedOperations)
+		}
+	}
+
+	for _, workers := range []int{2, 8, 100} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			for range 5 {
+				if results := unifiedpolicydatasource.ValidateRegoFiles(paths, workers, validateRegoFile); !reflect.DeepEqual(results, expected) {
+					t.Fatalf("Results differ from the sequential validation:\n%+v\n%+v", results, expected)
+				}
+			}
+		})
+	}
+
+	if results := unifiedpolicydatasource.ValidateRegoFiles(nil, 4, validateRegoFile); len(results) != 0 {
+		t.Errorf("Expected no results without files, got %v", results)
+	}
+}
+
+func BenchmarkValidateRegoFiles(b *testing.B) {
+	paths := writeRegoFiles(b, b.TempDir(), 200)
+
+	for _, workers := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("%d workers", workers), func(b *testing.B) {
+			for b.Loop() {
+				unifiedpolicydatasource.ValidateRegoFiles(paths, workers, validateRegoFile)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &RegoValidationDataSource{}
+
+func NewRegoValidationDataSource() datasource.DataSource {
+	return &RegoValidationDataSource{}
+}
+
+// RegoValidationDataSource validates Rego modules locally, without calling the API.
+type RegoValidationDataSource struct {
+	ProviderData unifiedpolicy.ProviderMetadata
+}
+
+type RegoValidationDataSourceModel struct {
+	RegoPaths    types.List   `tfsdk:"rego_paths"`
+	RegoContents types.List   `tfsdk:"rego_contents"`
+	Strict       types.Bool   `tfsdk:"strict"`
+	RegoVersion  types.String `tfsdk:"rego_version"`
+	Valid        types.Bool   `tfsdk:"valid"`
+	Results      types.List   `tfsdk:"results"`
+	Errors       types.List   `tfsdk:"errors"`
+	ErrorCount   types.Int64  `tfsdk:"error_count"`
+}
+
+var regoValidationResultAttrTypes = map[string]attr.Type{
+	"source":                types.StringType,
+	"valid":                 types.BoolType,
+	"error":                 types.StringType,
+	"disallowed_operations": types.ListType{ElemType: types.StringType},
+	"strict_errors":         types.ListType{ElemType: types.StringType},
+}
+
+func (d *RegoValidationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rego_validation"
+}
+
+func (d *RegoValidationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Validates one or more Rego modules with the same checks the `unifiedpolicy_template` resource applies " +
+			"(length against the provider's `max_rego_chars`, syntax and allowed operations, optionally OPA strict mode) without creating anything. " +
+			"Every module is validated and reported in `results`; validation does not stop at the first failure, " +
+			"which makes it suitable for linting a whole policy library in a single plan. Modules that fail validation are also listed in `errors`.",
+		Attributes: withBatchErrorsAttributes(map[string]schema.Attribute{
+			"rego_paths": schema.ListAttribute{
+				Description: "Paths to .rego files to validate. Relative paths are resolved as the `rego` of a `unifiedpolicy_template`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"rego_contents": schema.ListAttribute{
+				Description: "Inline Rego modules to validate.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"strict": schema.BoolAttribute{
+				Description: "When true, modules are also compiled with OPA strict mode. Defaults to false.",
+				Optional:    true,
+			},
+			"rego_version": schema.StringAttribute{
+				Description: "Rego language version the modules are parsed as, `v0` or `v1`, as `rego_version` of the `unifiedpolicy_template` resource. Defaults to `v0`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(resource.RegoVersionV0, resource.RegoVersionV1),
+				},
+			},
+			"valid": schema.BoolAttribute{
+				Description: "True when every module passed validation.",
+				Computed:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "Validation result per module: entries for `rego_paths` first, followed by entries for `rego_contents`, in configured order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Description: "The file path, or `rego_contents[<index>]` for inline modules.",
+							Computed:    true,
+						},
+						"valid": schema.BoolAttribute{
+							Description: "Whether the module passed all checks.",
+							Computed:    true,
+						},
+						"error": schema.StringAttribute{
+							Description: "Read, length or syntax error. Null when the module parsed successfully.",
+							Computed:    true,
+						},
+						"disallowed_operations": schema.ListAttribute{
+							Description: "Built-in operations used by the module that are not allowed.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"strict_errors": schema.ListAttribute{
+							Description: "OPA strict mode compilation errors. Empty unless `strict` is true.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		}, "The `source` of the module, as in `results`."),
+	}
+}
+
+func (d *RegoValidationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+func (d *RegoValidationDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("rego_paths"),
+			path.MatchRoot("rego_contents"),
+		),
+	}
+}
+
+func (d *RegoValidationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RegoValidationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var regoPaths, regoContents []string
+	if !data.RegoPaths.IsNull() {
+		resp.Diagnostics.Append(data.RegoPaths.ElementsAs(ctx, &regoPaths, false)...)
+	}
+	if !data.RegoContents.IsNull() {
+		resp.Diagnostics.Append(data.RegoContents.ElementsAs(ctx, &regoContents, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Validating Rego modules", map[string]interface{}{
+		"files":  len(regoPaths),
+		"inline": len(regoContents),
+	})
+
+	strict := data.Strict.ValueBool()
+	maxChars := d.ProviderData.MaxRegoChars
+	sources := make([]string, 0, len(regoPaths)+len(regoContents))
+	results := make([]resource.RegoValidationResult, 0, len(regoPaths)+len(regoContents))
+	for _, regoPath := range regoPaths {
+		sources = append(sources, regoPath)
+		results = append(results, resource.ValidateRegoFile(regoPath, d.ProviderData.ExpandRegoPath, strict, maxChars, d.ProviderData.RegoBaseDir, data.RegoVersion.ValueString(), d.ProviderData.ExtraAllowedRegoOperations))
+	}
+	for i, regoCode := range regoContents {
+		sources = append(sources, fmt.Sprintf("rego_contents[%d]", i))
+		results = append(results, resource.ValidateRegoCode(regoCode, strict, maxChars, data.RegoVersion.ValueString(), d.ProviderData.ExtraAllowedRegoOperations))
+	}
+
+	resp.Diagnostics.Append(data.FromValidationResults(ctx, sources, results)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// FromValidationResults sets valid, results and errors from the per-module validation results.
+func (m *RegoValidationDataSourceModel) FromValidationResults(ctx context.Context, sources []string, results []resource.RegoValidationResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	allValid := true
+	resultObjs := make([]attr.Value, 0, len(results))
+	var itemErrors []BatchItemError
+	for i, result := range results {
+		allValid = allValid && result.Valid()
+		if problems := result.Problems(); len(problems) > 0 {
+			itemErrors = append(itemErrors, BatchItemError{ID: sources[i], Message: strings.Join(problems, "; ")})
+		}
+
+		errorValue := types.StringNull()
+		if result.Error != "" {
+			errorValue = types.StringValue(result.Error)
+		}
+		disallowedOps, d := types.ListValueFrom(ctx, types.StringType, nonNilStrings(result.DisallowedOperations))
+		diags.Append(d...)
+		strictErrors, d := types.ListValueFrom(ctx, types.StringType, nonNilStrings(result.StrictErrors))
+		diags.Append(d...)
+
+		obj, d := types.ObjectValue(regoValidationResultAttrTypes, map[string]attr.Value{
+			"source":                types.StringValue(sources[i]),
+			"valid":                 types.BoolValue(result.Valid()),
+			"error":                 errorValue,
+			"disallowed_operations": disallowedOps,
+			"strict_errors":         strictErrors,
+		})
+		diags.Append(d...)
+		resultObjs = append(resultObjs, obj)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	resultsList, d := types.ListValue(types.ObjectType{AttrTypes: regoValidationResultAttrTypes}, resultObjs)
+	diags.Append(d...)
+	m.Results = resultsList
+	m.Valid = types.BoolValue(allValid)
+	m.Errors, m.ErrorCount, d = batchErrorsValues(ctx, itemErrors)
+	diags.Append(d...)
+
+	return diags
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,125 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+func TestRegoValidationFromValidationResults_itemErrors(t *testing.T) {
+	ctx := context.Background()
+	sources := []string{"valid.rego", "broken.rego", "strict.rego"}
+	results := []unifiedpolicyresource.RegoValidationResult{
+		{Package: "curation.policies"},
+		{Error: "rego_parse_error: unexpected eof token"},
+		{Package: "curation.policies", StrictErrors: []string{"unused import", "unused variable"}},
+	}
+
+	var model unifiedpolicydatasource.RegoValidationDataSourceModel
+	if diags := model.FromValidationResults(ctx, sources, results); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if model.Valid.ValueBool() {
+		t.Error("Expected valid to be false")
+	}
+	if model.ErrorCount.ValueInt64() != 2 {
+		t.Errorf("Expected error_count 2, got %v", model.ErrorCount)
+	}
+	var itemErrors []unifiedpolicydatasource.BatchItemError
+	if diags := model.Errors.ElementsAs(ctx, &itemErrors, false); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	expected := []unifiedpolicydatasource.BatchItemError{
+		{ID: "broken.rego", Message: "rego_parse_error: unexpected eof token"},
+		{ID: "strict.rego", Message: "strict mode: unused import; strict mode: unused variable"},
+	}
+	if len(itemErrors) != len(expected) {
+		t.Fatalf("Expected errors %+v, got %+v", expected, itemErrors)
+	}
+	for i := range expected {
+		if itemErrors[i] != expected[i] {
+			t.Errorf("Expected error %+v, got %+v", expected[i], itemErrors[i])
+		}
+	}
+}
+
+func TestAccRegoValidationDataSource_multipleFiles(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	dataSourceFqrn := "data.unifiedpolicy_rego_validation.test"
+	config := fmt.Sprintf(`
+		data "unifiedpolicy_rego_validation" "test" {
+			rego_paths = [%q, %q, %q]
+			rego_contents = [
+				"package unifiedpolicy\ndefault allow = false\n",
+			]
+		}
+	`,
+		acctest.RegoFixturePath(t, "basic_policy.rego"),
+		acctest.RegoFixturePath(t, "invalid_syntax.rego"),
+		acctest.RegoFixturePath(t, "invalid_http_send.rego"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFqrn, "valid", "false"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "results.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "results.0.valid", "true"),
+					resource.TestCheckNoResourceAttr(dataSourceFqrn, "results.0.error"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "results.1.valid", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceFqrn, "results.1.error"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "results.2.valid", "false"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "results.2.disallowed_operations.0", "http.send"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "results.3.source", "rego_contents[0]"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "results.3.valid", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRegoValidationDataSource_missingInput(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "unifiedpolicy_rego_validation" "test" {
+						strict = true
+					}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)At least one of these attributes must be configured`),
+			},
+		},
+	})
+}
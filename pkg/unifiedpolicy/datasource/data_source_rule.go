@@ -25,7 +25,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
 )
@@ -37,7 +36,7 @@ func NewRuleDataSource() datasource.DataSource {
 }
 
 type RuleDataSource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData unifiedpolicy.ProviderMetadata
 }
 
 type RuleDataSourceModel struct {
@@ -122,7 +121,7 @@ func (d *RuleDataSource) Configure(ctx context.Context, req datasource.Configure
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
 }
 
 func (d *RuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -137,43 +136,55 @@ func (d *RuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		"id": data.ID.ValueString(),
 	})
 
+	result, diags := readRule(ctx, d.ProviderData, data.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = data.FromAPIModel(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readRule fetches a single rule by ID. Shared by the rule datasources.
+func readRule(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, ruleID string) (resource.RuleAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
 	var result resource.RuleAPIModel
-	response, err := d.ProviderData.Client.R().
+	response, err := providerData.Client.R().
 		SetContext(ctx).
-		SetPathParam("rule_id", data.ID.ValueString()).
+		SetPathParam("rule_id", ruleID).
 		SetResult(&result).
-		Get(resource.RuleEndpoint)
+		Get(providerData.Endpoint(resource.RuleEndpoint))
 
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Unable to Read Data Source",
 			"An unexpected error occurred while fetching the data source. "+
 				"Please report this issue to the provider developers.\n\n"+
 				"Error: "+err.Error(),
 		)
-		return
+		return result, diags
 	}
 
 	if response.IsError() {
 		if response.StatusCode() == http.StatusNotFound {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Rule Not Found",
-				fmt.Sprintf("Rule with ID '%s' was not found.", data.ID.ValueString()),
+				fmt.Sprintf("Rule with ID '%s' was not found.", ruleID),
 			)
-			return
+			return result, diags
 		}
-		diags := unifiedpolicy.HandleAPIErrorWithType(response, "read", "rule")
-		resp.Diagnostics.Append(diags...)
-		return
+		diags.Append(unifiedpolicy.HandleAPIErrorWithType(response, "read", "rule")...)
+		return result, diags
 	}
 
-	diags := data.FromAPIModel(ctx, result)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return result, diags
 }
 
 // FromAPIModel converts the API response model to the Terraform datasource model.
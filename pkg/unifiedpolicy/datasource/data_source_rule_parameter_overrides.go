@@ -0,0 +1,265 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+var _ datasource.DataSource = &RuleParameterOverridesDataSource{}
+
+func NewRuleParameterOverridesDataSource() datasource.DataSource {
+	return &RuleParameterOverridesDataSource{}
+}
+
+type RuleParameterOverridesDataSource struct {
+	ProviderData unifiedpolicy.ProviderMetadata
+}
+
+type RuleParameterOverridesDataSourceModel struct {
+	RuleIDs    types.List  `tfsdk:"rule_ids"`
+	Parameters types.Map   `tfsdk:"parameters"`
+	AddMissing types.Bool  `tfsdk:"add_missing"`
+	Rules      types.Map   `tfsdk:"rules"`
+	Errors     types.List  `tfsdk:"errors"`
+	ErrorCount types.Int64 `tfsdk:"error_count"`
+}
+
+var ruleParameterAttrTypes = map[string]attr.Type{
+	"name":  types.StringType,
+	"value": types.StringType,
+}
+
+var ruleParameterOverrideAttrTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"description": types.StringType,
+	"template_id": types.StringType,
+	"parameters":  types.ListType{ElemType: types.ObjectType{AttrTypes: ruleParameterAttrTypes}},
+}
+
+func (d *RuleParameterOverridesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule_parameter_overrides"
+}
+
+func (d *RuleParameterOverridesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the current parameters of a set of Unified Policy rules and overlays the same parameter overrides on each of them. " +
+			"The merged parameter sets are returned per rule ID in the same shape as the `unifiedpolicy_rule` resource, " +
+			"so they can be fed into `unifiedpolicy_rule` with `for_each` to roll out a parameter change across many rules. " +
+			"Rules that cannot be read are reported in `errors` and left out of `rules`, with a warning.",
+		Attributes: withBatchErrorsAttributes(map[string]schema.Attribute{
+			"rule_ids": schema.ListAttribute{
+				Description: "IDs of the rules to read.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"parameters": schema.MapAttribute{
+				Description: "Parameter overrides (name to value) applied to every rule.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"add_missing": schema.BoolAttribute{
+				Description: "When true, overrides for parameters a rule does not have yet are added to it (sorted by name, after the existing parameters). " +
+					"When false (default), only parameters the rule already has are overridden.",
+				Optional: true,
+			},
+			"rules": schema.MapNestedAttribute{
+				Description: "Merged rules keyed by rule ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The rule name.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The rule description.",
+							Computed:    true,
+						},
+						"template_id": schema.StringAttribute{
+							Description: "The ID of the template the rule is based on.",
+							Computed:    true,
+						},
+						"parameters": schema.ListNestedAttribute{
+							Description: "The rule's current parameters with the overrides applied, in the rule's parameter order.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "Parameter name.",
+										Computed:    true,
+									},
+									"value": schema.StringAttribute{
+										Description: "Parameter value.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, "The rule ID."),
+	}
+}
+
+func (d *RuleParameterOverridesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+func (d *RuleParameterOverridesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RuleParameterOverridesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ruleIDs []string
+	resp.Diagnostics.Append(data.RuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+	overrides := map[string]string{}
+	resp.Diagnostics.Append(data.Parameters.ElementsAs(ctx, &overrides, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading rule parameter overrides datasource", map[string]interface{}{
+		"rule_count":     len(ruleIDs),
+		"override_count": len(overrides),
+	})
+
+	// A rule that cannot be read is reported in errors instead of failing the read for all rules
+	rules := make(map[string]attr.Value, len(ruleIDs))
+	var itemErrors []BatchItemError
+	for _, ruleID := range ruleIDs {
+		rule, diags := readRule(ctx, d.ProviderData, ruleID)
+		if diags.HasError() {
+			itemErrors = append(itemErrors, BatchItemError{ID: ruleID, Message: diagnosticsMessage(diags)})
+			continue
+		}
+		resp.Diagnostics.Append(diags...)
+
+		merged := MergeRuleParameters(rule.Parameters, overrides, data.AddMissing.ValueBool())
+		ruleObj, diags := ruleParameterOverrideObject(rule, merged)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		rules[ruleID] = ruleObj
+	}
+
+	rulesMap, diags := types.MapValue(types.ObjectType{AttrTypes: ruleParameterOverrideAttrTypes}, rules)
+	resp.Diagnostics.Append(diags...)
+	data.Rules = rulesMap
+	data.Errors, data.ErrorCount, diags = batchErrorsValues(ctx, itemErrors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(itemErrors) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Some Rules Could Not Be Read",
+			fmt.Sprintf("%d of %d rules could not be read and are missing from rules; see errors for details.", len(itemErrors), len(ruleIDs)),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// MergeRuleParameters overlays overrides on a rule's current parameters, keeping the rule's parameter order.
+// Overrides for parameters the rule does not have are ignored unless addMissing is true, in which case they are
+// appended sorted by name.
+// This function is exported for testing purposes.
+func MergeRuleParameters(current []resource.RuleParameterAPIModel, overrides map[string]string, addMissing bool) []resource.RuleParameterAPIModel {
+	merged := make([]resource.RuleParameterAPIModel, 0, len(current)+len(overrides))
+	existing := make(map[string]bool, len(current))
+	for _, param := range current {
+		existing[param.Name] = true
+		if value, ok := overrides[param.Name]; ok {
+			param.Value = value
+		}
+		merged = append(merged, param)
+	}
+
+	if addMissing {
+		missing := make([]string, 0, len(overrides))
+		for name := range overrides {
+			if !existing[name] {
+				missing = append(missing, name)
+			}
+		}
+		sort.Strings(missing)
+		for _, name := range missing {
+			merged = append(merged, resource.RuleParameterAPIModel{Name: name, Value: overrides[name]})
+		}
+	}
+
+	return merged
+}
+
+func ruleParameterOverrideObject(rule resource.RuleAPIModel, parameters []resource.RuleParameterAPIModel) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	paramValues := make([]attr.Value, 0, len(parameters))
+	for _, param := range parameters {
+		paramObj, d := types.ObjectValue(ruleParameterAttrTypes, map[string]attr.Value{
+			"name":  types.StringValue(param.Name),
+			"value": types.StringValue(param.Value),
+		})
+		diags.Append(d...)
+		paramValues = append(paramValues, paramObj)
+	}
+	if diags.HasError() {
+		return types.ObjectNull(ruleParameterOverrideAttrTypes), diags
+	}
+
+	paramList, d := types.ListValue(types.ObjectType{AttrTypes: ruleParameterAttrTypes}, paramValues)
+	diags.Append(d...)
+
+	description := types.StringNull()
+	if rule.Description != "" {
+		description = types.StringValue(rule.Description)
+	}
+
+	ruleObj, d := types.ObjectValue(ruleParameterOverrideAttrTypes, map[string]attr.Value{
+		"name":        types.StringValue(rule.Name),
+		"description": description,
+		"template_id": types.StringValue(rule.TemplateID),
+		"parameters":  paramList,
+	})
+	diags.Append(d...)
+
+	return ruleObj, diags
+}
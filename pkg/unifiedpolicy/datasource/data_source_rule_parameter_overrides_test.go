@@ -0,0 +1,217 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+func TestMergeRuleParameters(t *testing.T) {
+	current := []unifiedpolicyresource.RuleParameterAPIModel{
+		{Name: "severity_threshold", Value: "high"},
+		{Name: "max_count", Value: "10"},
+	}
+	overrides := map[string]string{
+		"severity_threshold": "critical",
+		"new_param":          "x",
+	}
+
+	tests := []struct {
+		name       string
+		addMissing bool
+		expected   []unifiedpolicyresource.RuleParameterAPIModel
+	}{
+		{
+			name: "override existing only",
+			expected: []unifiedpolicyresource.RuleParameterAPIModel{
+				{Name: "severity_threshold", Value: "critical"},
+				{Name: "max_count", Value: "10"},
+			},
+		},
+		{
+			name:       "add missing",
+			addMissing: true,
+			expected: []unifiedpolicyresource.RuleParameterAPIModel{
+				{Name: "severity_threshold", Value: "critical"},
+				{Name: "max_count", Value: "10"},
+				{Name: "new_param", Value: "x"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := unifiedpolicydatasource.MergeRuleParameters(current, overrides, tt.addMissing)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	if current[0].Value != "high" {
+		t.Errorf("MergeRuleParameters modified its input: %v", current)
+	}
+}
+
+func TestRuleParameterOverridesRead_itemErrors(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/unifiedpolicy/api/v1/rules/3001" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[{"code":"NOT_FOUND","message":"rule not found"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"3001","name":"rule","template_id":"2001","parameters":[{"name":"severity","value":"low"}]}`))
+	}))
+	defer server.Close()
+
+	d := &unifiedpolicydatasource.RuleParameterOverridesDataSource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+		},
+	}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	dsSchema := schemaResp.Schema
+
+	config := tfsdk.State{Schema: dsSchema, Raw: tftypes.NewValue(dsSchema.Type().TerraformType(ctx), nil)}
+	diags := config.Set(ctx, &unifiedpolicydatasource.RuleParameterOverridesDataSourceModel{
+		RuleIDs:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("3001"), types.StringValue("3002")}),
+		Parameters: types.MapValueMust(types.StringType, map[string]attr.Value{"severity": types.StringValue("high")}),
+		AddMissing: types.BoolNull(),
+		Rules:      types.MapNull(dsSchema.Attributes["rules"].GetType().(types.MapType).ElemType),
+		Errors:     types.ListNull(dsSchema.Attributes["errors"].GetType().(types.ListType).ElemType),
+		ErrorCount: types.Int64Null(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: dsSchema, Raw: config.Raw}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: dsSchema, Raw: config.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected the read to succeed with an item error, got %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected a warning about the rule that could not be read, got %v", resp.Diagnostics)
+	}
+
+	var data unifiedpolicydatasource.RuleParameterOverridesDataSourceModel
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if _, ok := data.Rules.Elements()["3001"]; !ok || len(data.Rules.Elements()) != 1 {
+		t.Errorf("expected only rule 3001 in rules, got %v", data.Rules)
+	}
+	if data.ErrorCount.ValueInt64() != 1 {
+		t.Fatalf("expected error_count 1, got %v", data.ErrorCount)
+	}
+	var itemErrors []unifiedpolicydatasource.BatchItemError
+	if diags := data.Errors.ElementsAs(ctx, &itemErrors, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if itemErrors[0].ID != "3002" || itemErrors[0].Message == "" {
+		t.Errorf("expected an error for rule 3002, got %+v", itemErrors)
+	}
+}
+
+func TestAccRuleParameterOverridesDataSource_basic(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, _, name := testutil.MkNames("test-rule-override-", "unifiedpolicy_rule")
+	dataSourceFqrn := "data.unifiedpolicy_rule_parameter_overrides.test"
+	resourceName := fmt.Sprintf("unifiedpolicy_rule.%s", name)
+
+	_, _, templateName := testutil.MkNames("test-template-", "template")
+	regoPath := acctest.RegoFixturePath(t, "params_severity_policy.rego")
+
+	config := fmt.Sprintf(`
+		resource "unifiedpolicy_template" "test" {
+			name             = "%s"
+			version          = "1.0.0"
+			category         = "security"
+			data_source_type = "evidence"
+			rego             = %q
+
+			parameters = [
+				{ name = "severity_threshold", type = "string" },
+				{ name = "max_count", type = "int" }
+			]
+		}
+
+		resource "unifiedpolicy_rule" "%s" {
+			name        = "%s"
+			template_id = unifiedpolicy_template.test.id
+			parameters = [
+				{ name = "severity_threshold", value = "high" },
+				{ name = "max_count", value = "10" }
+			]
+		}
+
+		data "unifiedpolicy_rule_parameter_overrides" "test" {
+			rule_ids   = [%s.id]
+			parameters = { severity_threshold = "critical" }
+		}
+	`, templateName, regoPath, name, name, resourceName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkRuleAndTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFqrn, "rules.%", "1"),
+					func(s *terraform.State) error {
+						ruleID := s.RootModule().Resources[resourceName].Primary.ID
+						prefix := fmt.Sprintf("rules.%s.", ruleID)
+						return resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(dataSourceFqrn, prefix+"name", name),
+							resource.TestCheckResourceAttr(dataSourceFqrn, prefix+"parameters.#", "2"),
+							resource.TestCheckResourceAttr(dataSourceFqrn, prefix+"parameters.0.name", "severity_threshold"),
+							resource.TestCheckResourceAttr(dataSourceFqrn, prefix+"parameters.0.value", "critical"),
+							resource.TestCheckResourceAttr(dataSourceFqrn, prefix+"parameters.1.name", "max_count"),
+							resource.TestCheckResourceAttr(dataSourceFqrn, prefix+"parameters.1.value", "10"),
+						)(s)
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,228 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+var _ datasource.DataSource = &RuleStatsDataSource{}
+
+func NewRuleStatsDataSource() datasource.DataSource {
+	return &RuleStatsDataSource{}
+}
+
+type RuleStatsDataSource struct {
+	ProviderData unifiedpolicy.ProviderMetadata
+}
+
+type RuleStatsDataSourceModel struct {
+	Total            types.Int64 `tfsdk:"total"`
+	ByCategory       types.Map   `tfsdk:"by_category"`
+	ByDataSourceType types.Map   `tfsdk:"by_data_source_type"`
+	Groups           types.List  `tfsdk:"groups"`
+}
+
+var ruleStatsGroupAttrTypes = map[string]attr.Type{
+	"category":         types.StringType,
+	"data_source_type": types.StringType,
+	"count":            types.Int64Type,
+}
+
+// RuleStatsGroup is the number of rules whose template has a category and data source type.
+type RuleStatsGroup struct {
+	Category       string
+	DataSourceType string
+	Count          int64
+}
+
+func (d *RuleStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule_stats"
+}
+
+func (d *RuleStatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the number of Unified Policy rules per template category and data source type, for dashboards and summary metrics. " +
+			"All rules are listed with `expand=template` and grouped client-side; the read fails when the backend does not expand the template.",
+		Attributes: map[string]schema.Attribute{
+			"total": schema.Int64Attribute{
+				Description: "Total number of rules.",
+				Computed:    true,
+			},
+			"by_category": schema.MapAttribute{
+				Description: "Number of rules per template category, e.g. `security`.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"by_data_source_type": schema.MapAttribute{
+				Description: "Number of rules per template data source type, e.g. `evidence`.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"groups": schema.ListNestedAttribute{
+				Description: "Number of rules per combination of template category and data source type, sorted by category, then data source type.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"category": schema.StringAttribute{
+							Description: "Template category.",
+							Computed:    true,
+						},
+						"data_source_type": schema.StringAttribute{
+							Description: "Template data source type.",
+							Computed:    true,
+						},
+						"count": schema.Int64Attribute{
+							Description: "Number of rules whose template has this category and data source type.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RuleStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+func (d *RuleStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RuleStatsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading rule stats datasource")
+
+	rules, diags := listAllExpandedRules(ctx, d.ProviderData, "template")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := RuleStats(rules)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Template Expansion Not Supported",
+			"Rule statistics group rules by the template returned with expand=template, but "+err.Error()+". "+
+				"The backend does not appear to support expanding templates in the rules list.",
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Computed rule stats", map[string]interface{}{
+		"rules":  len(rules),
+		"groups": len(groups),
+	})
+
+	resp.Diagnostics.Append(data.FromAPIModel(ctx, groups)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// RuleStats counts rules per category and data_source_type of the template expanded into each of them, sorted by
+// category, then data source type. It returns an error naming the first rule listed without an expanded template.
+// This function is exported for testing purposes.
+func RuleStats(rules []resource.RuleAPIModel) ([]RuleStatsGroup, error) {
+	counts := map[RuleStatsGroup]int64{}
+	for _, rule := range rules {
+		raw, ok := rule.ExtraFields["template"]
+		if !ok {
+			return nil, fmt.Errorf("rule '%s' (ID '%s') was listed without its template", rule.Name, rule.ID)
+		}
+		var template struct {
+			Category       string `json:"category"`
+			DataSourceType string `json:"data_source_type"`
+		}
+		if err := json.Unmarshal(raw, &template); err != nil {
+			return nil, fmt.Errorf("the template of rule '%s' (ID '%s') could not be parsed: %s", rule.Name, rule.ID, err.Error())
+		}
+		counts[RuleStatsGroup{Category: template.Category, DataSourceType: template.DataSourceType}]++
+	}
+
+	groups := make([]RuleStatsGroup, 0, len(counts))
+	for group, count := range counts {
+		group.Count = count
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Category != groups[j].Category {
+			return groups[i].Category < groups[j].Category
+		}
+		return groups[i].DataSourceType < groups[j].DataSourceType
+	})
+	return groups, nil
+}
+
+// FromAPIModel sets the totals and groups from the counted groups.
+func (m *RuleStatsDataSourceModel) FromAPIModel(ctx context.Context, groups []RuleStatsGroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var total int64
+	byCategory := map[string]int64{}
+	byDataSourceType := map[string]int64{}
+	groupObjs := make([]attr.Value, 0, len(groups))
+	for _, group := range groups {
+		total += group.Count
+		byCategory[group.Category] += group.Count
+		byDataSourceType[group.DataSourceType] += group.Count
+
+		groupObj, d := types.ObjectValue(ruleStatsGroupAttrTypes, map[string]attr.Value{
+			"category":         types.StringValue(group.Category),
+			"data_source_type": types.StringValue(group.DataSourceType),
+			"count":            types.Int64Value(group.Count),
+		})
+		diags.Append(d...)
+		groupObjs = append(groupObjs, groupObj)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	byCategoryMap, d := types.MapValueFrom(ctx, types.Int64Type, byCategory)
+	diags.Append(d...)
+	byDataSourceTypeMap, d := types.MapValueFrom(ctx, types.Int64Type, byDataSourceType)
+	diags.Append(d...)
+	groupsList, d := types.ListValue(types.ObjectType{AttrTypes: ruleStatsGroupAttrTypes}, groupObjs)
+	diags.Append(d...)
+
+	m.Total = types.Int64Value(total)
+	m.ByCategory = byCategoryMap
+	m.ByDataSourceType = byDataSourceTypeMap
+	m.Groups = groupsList
+
+	return diags
+}
@@ -0,0 +1,153 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+func TestRuleStats(t *testing.T) {
+	withTemplate := func(id, template string) unifiedpolicyresource.RuleAPIModel {
+		return unifiedpolicyresource.RuleAPIModel{
+			ID:          id,
+			ExtraFields: unifiedpolicy.ExtraFields{"template": json.RawMessage(template)},
+		}
+	}
+
+	rules := []unifiedpolicyresource.RuleAPIModel{
+		withTemplate("r1", `{"category":"security","data_source_type":"evidence"}`),
+		withTemplate("r2", `{"category":"quality","data_source_type":"noop"}`),
+		withTemplate("r3", `{"category":"security","data_source_type":"evidence"}`),
+		withTemplate("r4", `{"category":"security","data_source_type":"xray"}`),
+	}
+
+	groups, err := unifiedpolicydatasource.RuleStats(rules)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []unifiedpolicydatasource.RuleStatsGroup{
+		{Category: "quality", DataSourceType: "noop", Count: 1},
+		{Category: "security", DataSourceType: "evidence", Count: 2},
+		{Category: "security", DataSourceType: "xray", Count: 1},
+	}
+	if !reflect.DeepEqual(groups, expected) {
+		t.Errorf("Expected groups %v, got %v", expected, groups)
+	}
+
+	rules = append(rules, unifiedpolicyresource.RuleAPIModel{ID: "r5", Name: "not-expanded"})
+	if _, err := unifiedpolicydatasource.RuleStats(rules); err == nil || !regexp.MustCompile(`'not-expanded'`).MatchString(err.Error()) {
+		t.Errorf("Expected an error naming the rule without template, got %v", err)
+	}
+}
+
+func TestRuleStatsFromAPIModel(t *testing.T) {
+	var model unifiedpolicydatasource.RuleStatsDataSourceModel
+	diags := model.FromAPIModel(context.Background(), []unifiedpolicydatasource.RuleStatsGroup{
+		{Category: "quality", DataSourceType: "evidence", Count: 1},
+		{Category: "security", DataSourceType: "evidence", Count: 2},
+		{Category: "security", DataSourceType: "xray", Count: 3},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if model.Total.ValueInt64() != 6 {
+		t.Errorf("Expected total 6, got %d", model.Total.ValueInt64())
+	}
+	expectedByCategory := map[string]int64{"quality": 1, "security": 5}
+	var byCategory map[string]int64
+	model.ByCategory.ElementsAs(context.Background(), &byCategory, false)
+	if !reflect.DeepEqual(byCategory, expectedByCategory) {
+		t.Errorf("Expected by_category %v, got %v", expectedByCategory, byCategory)
+	}
+	expectedByDataSourceType := map[string]int64{"evidence": 3, "xray": 3}
+	var byDataSourceType map[string]int64
+	model.ByDataSourceType.ElementsAs(context.Background(), &byDataSourceType, false)
+	if !reflect.DeepEqual(byDataSourceType, expectedByDataSourceType) {
+		t.Errorf("Expected by_data_source_type %v, got %v", expectedByDataSourceType, byDataSourceType)
+	}
+	if len(model.Groups.Elements()) != 3 {
+		t.Errorf("Expected 3 groups, got %d", len(model.Groups.Elements()))
+	}
+	if count := model.Groups.Elements()[2].(types.Object).Attributes()["count"]; !count.Equal(types.Int64Value(3)) {
+		t.Errorf("Expected count 3 for the last group, got %v", count)
+	}
+}
+
+func TestAccRuleStatsDataSource_basic(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, _, name := testutil.MkNames("test-rule-stats-", "unifiedpolicy_rule")
+	dataSourceFqrn := "data.unifiedpolicy_rule_stats.test"
+	resourceName := fmt.Sprintf("unifiedpolicy_rule.%s", name)
+
+	_, _, templateName := testutil.MkNames("test-template-", "template")
+	regoPath := acctest.RegoFixturePath(t, "basic_policy.rego")
+
+	config := fmt.Sprintf(`
+		resource "unifiedpolicy_template" "test" {
+			name             = "%s"
+			version          = "1.0.0"
+			category         = "workflow"
+			data_source_type = "noop"
+			rego             = %q
+			parameters       = []
+		}
+
+		resource "unifiedpolicy_rule" "%s" {
+			name        = "%s"
+			template_id = unifiedpolicy_template.test.id
+			parameters  = []
+		}
+
+		data "unifiedpolicy_rule_stats" "test" {
+			depends_on = [%s]
+		}
+	`, templateName, regoPath, name, name, resourceName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkRuleAndTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceFqrn, "total"),
+					resource.TestCheckResourceAttrSet(dataSourceFqrn, "by_category.workflow"),
+					resource.TestCheckResourceAttrSet(dataSourceFqrn, "by_data_source_type.noop"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFqrn, "groups.*", map[string]string{
+						"category":         "workflow",
+						"data_source_type": "noop",
+					}),
+				),
+			},
+		},
+	})
+}
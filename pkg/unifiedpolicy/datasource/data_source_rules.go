@@ -16,17 +16,25 @@ package datasource
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
 )
@@ -38,25 +46,31 @@ func NewRulesDataSource() datasource.DataSource {
 }
 
 type RulesDataSource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData unifiedpolicy.ProviderMetadata
 }
 
 type RulesDataSourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	IDs                types.List   `tfsdk:"ids"`
-	Name               types.String `tfsdk:"name"`
-	Names              types.List   `tfsdk:"names"`
-	ScannerTypes       types.List   `tfsdk:"scanner_types"`
-	TemplateDataSource types.String `tfsdk:"template_data_source"`
-	TemplateCategory   types.String `tfsdk:"template_category"`
-	Expand             types.String `tfsdk:"expand"`
-	Page               types.Int64  `tfsdk:"page"`
-	Limit              types.Int64  `tfsdk:"limit"`
-	SortBy             types.String `tfsdk:"sort_by"`
-	SortOrder          types.String `tfsdk:"sort_order"`
-	Rules              types.List   `tfsdk:"rules"`
-	Offset             types.Int64  `tfsdk:"offset"`
-	PageSize           types.Int64  `tfsdk:"page_size"`
+	ID                    types.String `tfsdk:"id"`
+	IDs                   types.List   `tfsdk:"ids"`
+	Name                  types.String `tfsdk:"name"`
+	Names                 types.List   `tfsdk:"names"`
+	ScannerTypes          types.List   `tfsdk:"scanner_types"`
+	TemplateDataSource    types.String `tfsdk:"template_data_source"`
+	TemplateCategory      types.String `tfsdk:"template_category"`
+	Expand                types.String `tfsdk:"expand"`
+	Page                  types.Int64  `tfsdk:"page"`
+	Limit                 types.Int64  `tfsdk:"limit"`
+	SortBy                types.String `tfsdk:"sort_by"`
+	SortByFields          types.List   `tfsdk:"sort_by_fields"`
+	SortOrder             types.String `tfsdk:"sort_order"`
+	IncludeParameterTypes types.Bool   `tfsdk:"include_parameter_types"`
+	FetchAll              types.Bool   `tfsdk:"fetch_all"`
+	Rules                 types.List   `tfsdk:"rules"`
+	EffectiveQuery        types.String `tfsdk:"effective_query"`
+	Offset                types.Int64  `tfsdk:"offset"`
+	PageSize              types.Int64  `tfsdk:"page_size"`
+	HasMore               types.Bool   `tfsdk:"has_more"`
+	ResultID              types.String `tfsdk:"result_id"`
 }
 
 func (d *RulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -103,7 +117,7 @@ func (d *RulesDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				},
 			},
 			"expand": schema.StringAttribute{
-				Description: "Expand related fields, such as 'template'.",
+				Description: "Expand related fields, such as 'template'. With 'template', `is_noop` is set on each rule.",
 				Optional:    true,
 			},
 			"page": schema.Int64Attribute{
@@ -114,6 +128,11 @@ func (d *RulesDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Description: "Items per page (1-1000, default: 100).",
 				Optional:    true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				Description: "When true, reads every page from `page` on, `limit` items at a time, and returns all matching rules in `rules`. " +
+					"`has_more` is then false and `page_size` is the number of rules returned. The read fails if the API does not advance the page offset.",
+				Optional: true,
+			},
 			"sort_by": schema.StringAttribute{
 				Description: "Sort field: 'name', 'created_at'.",
 				Optional:    true,
@@ -121,6 +140,18 @@ func (d *RulesDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 					stringvalidator.OneOf("name", "created_at"),
 				},
 			},
+			"sort_by_fields": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Sort by multiple fields, in priority order (e.g. ['name', 'created_at']); use instead of `sort_by` for deterministic ordering on ties. " +
+					"Sent as repeated `sort_by` query parameters (e.g. ?sort_by=name&sort_by=created_at); the backend applies them in order. Allowed fields: 'name', 'created_at'.",
+				Optional: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("name", "created_at")),
+					listvalidator.ConflictsWith(path.MatchRoot("sort_by")),
+				},
+			},
 			"sort_order": schema.StringAttribute{
 				Description: "Sort direction: 'asc' or 'desc'.",
 				Optional:    true,
@@ -128,6 +159,11 @@ func (d *RulesDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 					stringvalidator.OneOf("asc", "desc"),
 				},
 			},
+			"include_parameter_types": schema.BoolAttribute{
+				Description: "When true, the parameter definitions of the templates the rules are based on are read to populate `parameter_types`. " +
+					"This costs one extra API call per distinct template. Defaults to false.",
+				Optional: true,
+			},
 			"rules": schema.ListNestedAttribute{
 				Description: "List of rules returned by the API.",
 				Computed:    true,
@@ -169,6 +205,20 @@ func (d *RulesDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 								},
 							},
 						},
+						"parameters_json": schema.StringAttribute{
+							Description: "The parameters serialized as a JSON object mapping parameter name to value, for use with `jsondecode()`.",
+							Computed:    true,
+						},
+						"parameter_types": schema.MapAttribute{
+							Description: "Parameter types by parameter name, as defined by the rule's template. Null unless `include_parameter_types` is true.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"is_noop": schema.BoolAttribute{
+							Description: "Whether the rule's template has data_source_type `noop`, i.e. the rule does not evaluate real evidence, " +
+								"for identifying placeholder rules. Only set when `expand` includes 'template' and the API returns the template; null otherwise.",
+							Computed: true,
+						},
 						"created_at": schema.StringAttribute{
 							Description: "Timestamp when the rule was created.",
 							Computed:    true,
@@ -180,6 +230,12 @@ func (d *RulesDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 					},
 				},
 			},
+			"effective_query": schema.StringAttribute{
+				Description: "The query string sent to the API for this read, URL-encoded with keys in alphabetical order and multi-value " +
+					"parameters repeated (e.g. `id=rule-1&id=rule-2&limit=10`). For debugging which filters reached the API. " +
+					"Template parameter type lookups are not included.",
+				Computed: true,
+			},
 			"offset": schema.Int64Attribute{
 				Description: "Current page offset.",
 				Computed:    true,
@@ -188,6 +244,17 @@ func (d *RulesDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Description: "Number of items in the current page.",
 				Computed:    true,
 			},
+			"has_more": schema.BoolAttribute{
+				Description: "Whether another page likely exists, derived as the number of returned items being equal to the `limit` " +
+					"returned by the API. Use it to drive manual pagination loops. When the last page is exactly full this is " +
+					"`true` and the following page comes back empty.",
+				Computed: true,
+			},
+			"result_id": schema.StringAttribute{
+				Description: "Stable identity of this read: a SHA-256 hash of `effective_query` and the sorted IDs of the returned rules. " +
+					"It changes whenever the filters or the results change. `id` is not used for this, since it is the single ID filter.",
+				Computed: true,
+			},
 		},
 	}
 }
@@ -196,7 +263,7 @@ func (d *RulesDataSource) Configure(ctx context.Context, req datasource.Configur
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
 }
 
 func (d *RulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -277,53 +344,122 @@ func (d *RulesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		request.SetQueryParam("sort_by", data.SortBy.ValueString())
 	}
 
+	// sort_by (array form, explode) for multiple sort keys
+	if !data.SortByFields.IsNull() {
+		var sortFields []string
+		resp.Diagnostics.Append(data.SortByFields.ElementsAs(ctx, &sortFields, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		request.SetQueryParamsFromValues(url.Values{"sort_by": sortFields})
+	}
+
 	if !data.SortOrder.IsNull() {
 		request.SetQueryParam("sort_order", data.SortOrder.ValueString())
 	}
 
-	var result resource.RulesListAPIModel
-	response, err := request.SetResult(&result).Get(resource.RulesEndpoint)
+	data.EffectiveQuery = types.StringValue(request.QueryParam.Encode())
 
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read Data Source",
-			"An unexpected error occurred while fetching the data source. "+
-				"Please report this issue to the provider developers.\n\n"+
-				"Error: "+err.Error(),
-		)
+	var result resource.RulesListAPIModel
+	if data.FetchAll.ValueBool() {
+		// Every page is accumulated into result, which then describes the first page's offset and all the items
+		first := true
+		resp.Diagnostics.Append(FetchListPages(request.QueryParam, func(query url.Values) (int, int, int, diag.Diagnostics) {
+			var page resource.RulesListAPIModel
+			diags := d.readRulesPage(ctx, d.ProviderData.Client.R().SetContext(ctx).SetQueryParamsFromValues(query), &page)
+			if first {
+				result.Offset, result.Limit = page.Offset, page.Limit
+				first = false
+			}
+			result.Items = append(result.Items, page.Items...)
+			return page.Offset, len(page.Items), page.Limit, diags
+		})...)
+		result.PageSize = len(result.Items)
+	} else {
+		resp.Diagnostics.Append(d.readRulesPage(ctx, request, &result)...)
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if response.IsError() {
-		diags := unifiedpolicy.HandleAPIErrorWithType(response, "read", "rule")
-		resp.Diagnostics.Append(diags...)
-		return
+	// Parameter types come from the templates, read once per distinct template
+	var parameterTypes map[string]map[string]string
+	if data.IncludeParameterTypes.ValueBool() {
+		parameterTypes = map[string]map[string]string{}
+		for _, rule := range result.Items {
+			if _, ok := parameterTypes[rule.TemplateID]; ok {
+				continue
+			}
+			templateParameterTypes, diags := resource.ReadTemplateParameterTypes(ctx, d.ProviderData, rule.TemplateID)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			parameterTypes[rule.TemplateID] = templateParameterTypes
+		}
 	}
 
-	diags := data.FromAPIModel(ctx, result)
+	diags := data.FromAPIModel(ctx, result, parameterTypes)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if data.FetchAll.ValueBool() {
+		data.HasMore = types.BoolValue(false)
+	}
+
+	ruleIDs := make([]string, len(result.Items))
+	for i, rule := range result.Items {
+		ruleIDs[i] = rule.ID
+	}
+	data.ResultID = types.StringValue(ListResultID(data.EffectiveQuery.ValueString(), ruleIDs))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// readRulesPage sends a prepared rules list request and decodes the page into result.
+func (d *RulesDataSource) readRulesPage(ctx context.Context, request *resty.Request, result *resource.RulesListAPIModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	response, err := request.SetResult(result).Get(d.ProviderData.Endpoint(resource.RulesEndpoint))
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while fetching the data source. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if response.IsError() {
+		diags.Append(unifiedpolicy.HandleAPIErrorWithType(response, "read", "rule")...)
+	}
+	return diags
+}
+
 // ruleListItemAttrTypes is used for converting list items to Terraform types.
 var ruleListItemAttrTypes = map[string]attr.Type{
-	"id":          types.StringType,
-	"name":        types.StringType,
-	"description": types.StringType,
-	"is_custom":   types.BoolType,
-	"template_id": types.StringType,
-	"parameters":  types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "value": types.StringType}}},
-	"created_at":  types.StringType,
-	"updated_at":  types.StringType,
+	"id":              types.StringType,
+	"name":            types.StringType,
+	"description":     types.StringType,
+	"is_custom":       types.BoolType,
+	"template_id":     types.StringType,
+	"parameters":      types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "value": types.StringType}}},
+	"parameters_json": types.StringType,
+	"parameter_types": types.MapType{ElemType: types.StringType},
+	"is_noop":         types.BoolType,
+	"created_at":      types.StringType,
+	"updated_at":      types.StringType,
 }
 
-func (m *RulesDataSourceModel) FromAPIModel(ctx context.Context, apiModel resource.RulesListAPIModel) diag.Diagnostics {
+// FromAPIModel converts the API response to the datasource model. parameterTypes holds the parameter types by
+// template ID, or is nil when parameter types are not included.
+func (m *RulesDataSourceModel) FromAPIModel(ctx context.Context, apiModel resource.RulesListAPIModel, parameterTypes map[string]map[string]string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	expandTemplate := expandsTemplate(m.Expand.ValueString())
+
 	rules := make([]types.Object, len(apiModel.Items))
 	paramAttrTypes := map[string]attr.Type{
 		"name":  types.StringType,
@@ -346,6 +482,27 @@ func (m *RulesDataSourceModel) FromAPIModel(ctx context.Context, apiModel resour
 			break
 		}
 
+		parametersJSON, err := resource.RuleParametersJSON(rule.Parameters)
+		if err != nil {
+			diags.AddError("Unable to Serialize Rule Parameters", err.Error())
+			break
+		}
+
+		parameterTypesMap := types.MapNull(types.StringType)
+		if templateParameterTypes, ok := parameterTypes[rule.TemplateID]; ok {
+			var mapDiags diag.Diagnostics
+			parameterTypesMap, mapDiags = types.MapValueFrom(ctx, types.StringType, templateParameterTypes)
+			diags.Append(mapDiags...)
+			if diags.HasError() {
+				break
+			}
+		}
+
+		isNoop := types.BoolNull()
+		if expandTemplate {
+			isNoop = expandedTemplateIsNoop(rule)
+		}
+
 		description := types.StringNull()
 		if rule.Description != "" {
 			description = types.StringValue(rule.Description)
@@ -360,14 +517,17 @@ func (m *RulesDataSourceModel) FromAPIModel(ctx context.Context, apiModel resour
 		}
 
 		ruleAttrs := map[string]attr.Value{
-			"id":          types.StringValue(rule.ID),
-			"name":        types.StringValue(rule.Name),
-			"description": description,
-			"is_custom":   types.BoolValue(rule.IsCustom),
-			"template_id": types.StringValue(rule.TemplateID),
-			"parameters":  parametersList,
-			"created_at":  createdAt,
-			"updated_at":  updatedAt,
+			"id":              types.StringValue(rule.ID),
+			"name":            types.StringValue(rule.Name),
+			"description":     description,
+			"is_custom":       types.BoolValue(rule.IsCustom),
+			"template_id":     types.StringValue(rule.TemplateID),
+			"parameters":      parametersList,
+			"parameters_json": types.StringValue(parametersJSON),
+			"parameter_types": parameterTypesMap,
+			"is_noop":         isNoop,
+			"created_at":      createdAt,
+			"updated_at":      updatedAt,
 		}
 
 		ruleObj, ruleDiags := types.ObjectValue(ruleListItemAttrTypes, ruleAttrs)
@@ -387,6 +547,146 @@ func (m *RulesDataSourceModel) FromAPIModel(ctx context.Context, apiModel resour
 
 	m.Offset = types.Int64Value(int64(apiModel.Offset))
 	m.PageSize = types.Int64Value(int64(apiModel.PageSize))
+	m.HasMore = types.BoolValue(hasMorePages(len(apiModel.Items), apiModel.Limit))
 
 	return diags
 }
+
+// expandsTemplate reports whether an expand value, a comma-separated list of related fields, includes the template.
+func expandsTemplate(expand string) bool {
+	for _, field := range strings.Split(expand, ",") {
+		if strings.TrimSpace(field) == "template" {
+			return true
+		}
+	}
+	return false
+}
+
+// expandedTemplateIsNoop returns whether the template expanded into a rule has data_source_type noop, or null when the
+// API did not return the template.
+func expandedTemplateIsNoop(rule resource.RuleAPIModel) types.Bool {
+	raw, ok := rule.ExtraFields["template"]
+	if !ok {
+		return types.BoolNull()
+	}
+	var template struct {
+		DataSourceType *string `json:"data_source_type"`
+	}
+	if err := json.Unmarshal(raw, &template); err != nil || template.DataSourceType == nil {
+		return types.BoolNull()
+	}
+	return types.BoolValue(*template.DataSourceType == "noop")
+}
+
+// ListResultID returns the synthetic identity of a list data source read: a hash of the filters and of the sorted
+// IDs of the returned objects, so it stays the same across reads until either of them changes.
+// This function is exported for testing purposes.
+func ListResultID(filters string, ids []string) string {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+
+	hash := sha256.New()
+	hash.Write([]byte(filters))
+	for _, id := range sorted {
+		// Separated by NUL so that neither the filters nor the IDs can run into each other
+		hash.Write([]byte{0})
+		hash.Write([]byte(id))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// hasMorePages reports whether a page of a list endpoint is likely followed by another one. The API returns no total
+// count, so a page with as many items as its limit is the only hint; an exactly full last page also reports true.
+func hasMorePages(items, limit int) bool {
+	return limit > 0 && items == limit
+}
+
+// FetchListPages reads a list endpoint page by page, starting at the page offset in query, until a page comes back
+// with fewer items than its limit. fetchPage reads the page selected by query and returns the offset, item count and
+// limit reported by the API. The read fails when the reported offset does not advance, so that a server ignoring
+// offset cannot make it loop forever.
+// This function is exported for testing purposes.
+func FetchListPages(query url.Values, fetchPage func(query url.Values) (offset, items, limit int, diags diag.Diagnostics)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	page := 0
+	if offset := query.Get("offset"); offset != "" {
+		var err error
+		if page, err = strconv.Atoi(offset); err != nil {
+			diags.AddError("Invalid Page Offset", fmt.Sprintf("Page offset '%s' is not a number.", offset))
+			return diags
+		}
+	}
+
+	previousOffset := -1
+	for ; ; page++ {
+		pageQuery := url.Values{}
+		for key, values := range query {
+			pageQuery[key] = values
+		}
+		pageQuery.Set("offset", strconv.Itoa(page))
+
+		offset, items, limit, pageDiags := fetchPage(pageQuery)
+		diags.Append(pageDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		if previousOffset >= 0 && offset <= previousOffset {
+			diags.AddError(
+				"Pagination Did Not Advance",
+				fmt.Sprintf("Requested page offset %d but the API returned page offset %d again, so not every item can be read. "+
+					"Set fetch_all to false and page through the results with page and limit instead.", page, offset),
+			)
+			return diags
+		}
+		if !hasMorePages(items, limit) {
+			return diags
+		}
+		previousOffset = offset
+	}
+}
+
+// listAllRules reads every page of the rules list. Shared by the datasources that resolve across all rules.
+func listAllRules(ctx context.Context, providerData unifiedpolicy.ProviderMetadata) ([]resource.RuleAPIModel, diag.Diagnostics) {
+	return listAllExpandedRules(ctx, providerData, "")
+}
+
+// listAllExpandedRules reads every page of the rules list, expanding the related fields in expand when it is set.
+func listAllExpandedRules(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, expand string) ([]resource.RuleAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var rules []resource.RuleAPIModel
+
+	for page := 0; ; page++ {
+		var result resource.RulesListAPIModel
+		request := providerData.Client.R().
+			SetContext(ctx).
+			SetQueryParam("offset", strconv.Itoa(page)).
+			SetQueryParam("limit", strconv.Itoa(listPageLimit))
+		if expand != "" {
+			request.SetQueryParam("expand", expand)
+		}
+		response, err := request.
+			SetResult(&result).
+			Get(providerData.Endpoint(resource.RulesEndpoint))
+
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Data Source",
+				"An unexpected error occurred while fetching the data source. "+
+					"Please report this issue to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return nil, diags
+		}
+
+		if response.IsError() {
+			diags.Append(unifiedpolicy.HandleAPIError(response, "read")...)
+			return nil, diags
+		}
+
+		rules = append(rules, result.Items...)
+		if len(result.Items) < listPageLimit {
+			return rules, diags
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+var _ datasource.DataSource = &RulesByScannerDataSource{}
+
+func NewRulesByScannerDataSource() datasource.DataSource {
+	return &RulesByScannerDataSource{}
+}
+
+type RulesByScannerDataSource struct {
+	ProviderData unifiedpolicy.ProviderMetadata
+}
+
+type RulesByScannerDataSourceModel struct {
+	Scanner     types.String `tfsdk:"scanner"`
+	TemplateIDs types.List   `tfsdk:"template_ids"`
+	Rules       types.List   `tfsdk:"rules"`
+}
+
+var rulesByScannerItemAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"name":        types.StringType,
+	"template_id": types.StringType,
+}
+
+func (d *RulesByScannerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rules_by_scanner"
+}
+
+func (d *RulesByScannerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the Unified Policy rules whose template supports a given scanner, answering questions such as \"which rules cover SCA\". " +
+			"All templates and rules are listed and matched client-side (template `scanners`, then rule `template_id`), " +
+			"so the result does not depend on the server-side scanner filter.",
+		Attributes: map[string]schema.Attribute{
+			"scanner": schema.StringAttribute{
+				Description: "Scanner type to look up. Must be one of: 'secrets', 'sca', 'exposures', 'contextual_analysis', 'malicious_package'.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("secrets", "sca", "exposures", "contextual_analysis", "malicious_package"),
+				},
+			},
+			"template_ids": schema.ListAttribute{
+				Description: "IDs of the templates that support the scanner.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Rules based on one of the matching templates.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the rule.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The rule name.",
+							Computed:    true,
+						},
+						"template_id": schema.StringAttribute{
+							Description: "The ID of the template the rule is based on.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RulesByScannerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+func (d *RulesByScannerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RulesByScannerDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading rules by scanner datasource", map[string]interface{}{
+		"scanner": data.Scanner.ValueString(),
+	})
+
+	templates, diags := listAllTemplates(ctx, d.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := listAllRules(ctx, d.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templateIDs, matched := RulesForScanner(templates, rules, data.Scanner.ValueString())
+
+	tflog.Debug(ctx, "Resolved rules by scanner", map[string]interface{}{
+		"templates": len(templateIDs),
+		"rules":     len(matched),
+	})
+
+	resp.Diagnostics.Append(data.FromAPIModel(ctx, templateIDs, matched)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// RulesForScanner returns the IDs of the templates that list scanner in their scanners, and the rules based on
+// one of those templates. Templates and rules are de-duplicated by ID and keep the order they were listed in.
+// This function is exported for testing purposes.
+func RulesForScanner(templates []resource.TemplateAPIModel, rules []resource.RuleAPIModel, scanner string) ([]string, []resource.RuleAPIModel) {
+	templateIDs := []string{}
+	matchingTemplates := map[string]bool{}
+	for _, template := range templates {
+		if matchingTemplates[template.ID] {
+			continue
+		}
+		for _, s := range template.Scanners {
+			if s == scanner {
+				matchingTemplates[template.ID] = true
+				templateIDs = append(templateIDs, template.ID)
+				break
+			}
+		}
+	}
+
+	matched := []resource.RuleAPIModel{}
+	seenRules := map[string]bool{}
+	for _, rule := range rules {
+		if matchingTemplates[rule.TemplateID] && !seenRules[rule.ID] {
+			seenRules[rule.ID] = true
+			matched = append(matched, rule)
+		}
+	}
+
+	return templateIDs, matched
+}
+
+// FromAPIModel sets template_ids and rules from the resolved templates and rules.
+func (m *RulesByScannerDataSourceModel) FromAPIModel(ctx context.Context, templateIDs []string, rules []resource.RuleAPIModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	templateIDsList, d := types.ListValueFrom(ctx, types.StringType, templateIDs)
+	diags.Append(d...)
+
+	ruleObjs := make([]attr.Value, 0, len(rules))
+	for _, rule := range rules {
+		ruleObj, d := types.ObjectValue(rulesByScannerItemAttrTypes, map[string]attr.Value{
+			"id":          types.StringValue(rule.ID),
+			"name":        types.StringValue(rule.Name),
+			"template_id": types.StringValue(rule.TemplateID),
+		})
+		diags.Append(d...)
+		ruleObjs = append(ruleObjs, ruleObj)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	rulesList, d := types.ListValue(types.ObjectType{AttrTypes: rulesByScannerItemAttrTypes}, ruleObjs)
+	diags.Append(d...)
+
+	m.TemplateIDs = templateIDsList
+	m.Rules = rulesList
+
+	return diags
+}
@@ -0,0 +1,140 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+func TestRulesForScanner(t *testing.T) {
+	templates := []unifiedpolicyresource.TemplateAPIModel{
+		{ID: "t1", Scanners: []string{"sca", "secrets"}},
+		{ID: "t2", Scanners: []string{"secrets"}},
+		{ID: "t3"},
+		{ID: "t4", Scanners: []string{"sca"}},
+		// Repeated across pages
+		{ID: "t1", Scanners: []string{"sca", "secrets"}},
+	}
+	rules := []unifiedpolicyresource.RuleAPIModel{
+		{ID: "r1", TemplateID: "t1"},
+		{ID: "r2", TemplateID: "t2"},
+		{ID: "r3", TemplateID: "t3"},
+		{ID: "r4", TemplateID: "t4"},
+		{ID: "r1", TemplateID: "t1"},
+	}
+
+	tests := []struct {
+		name              string
+		scanner           string
+		expectedTemplates []string
+		expectedRules     []string
+	}{
+		{
+			name:              "sca",
+			scanner:           "sca",
+			expectedTemplates: []string{"t1", "t4"},
+			expectedRules:     []string{"r1", "r4"},
+		},
+		{
+			name:              "secrets",
+			scanner:           "secrets",
+			expectedTemplates: []string{"t1", "t2"},
+			expectedRules:     []string{"r1", "r2"},
+		},
+		{
+			name:              "no template supports the scanner",
+			scanner:           "exposures",
+			expectedTemplates: []string{},
+			expectedRules:     []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			templateIDs, matched := unifiedpolicydatasource.RulesForScanner(templates, rules, tt.scanner)
+			if !reflect.DeepEqual(templateIDs, tt.expectedTemplates) {
+				t.Errorf("Expected templates %v, got %v", tt.expectedTemplates, templateIDs)
+			}
+			ruleIDs := make([]string, len(matched))
+			for i, rule := range matched {
+				ruleIDs[i] = rule.ID
+			}
+			if !reflect.DeepEqual(ruleIDs, tt.expectedRules) {
+				t.Errorf("Expected rules %v, got %v", tt.expectedRules, ruleIDs)
+			}
+		})
+	}
+}
+
+func TestAccRulesByScannerDataSource_basic(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, _, name := testutil.MkNames("test-rule-scanner-", "unifiedpolicy_rule")
+	dataSourceFqrn := "data.unifiedpolicy_rules_by_scanner.test"
+	resourceName := fmt.Sprintf("unifiedpolicy_rule.%s", name)
+
+	_, _, templateName := testutil.MkNames("test-template-", "template")
+	regoPath := acctest.RegoFixturePath(t, "basic_policy.rego")
+
+	config := fmt.Sprintf(`
+		resource "unifiedpolicy_template" "test" {
+			name             = "%s"
+			version          = "1.0.0"
+			category         = "security"
+			data_source_type = "evidence"
+			rego             = %q
+			parameters       = []
+			scanners         = ["sca"]
+		}
+
+		resource "unifiedpolicy_rule" "%s" {
+			name        = "%s"
+			template_id = unifiedpolicy_template.test.id
+			parameters  = []
+		}
+
+		data "unifiedpolicy_rules_by_scanner" "test" {
+			scanner = "sca"
+
+			depends_on = [%s]
+		}
+	`, templateName, regoPath, name, name, resourceName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkRuleAndTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceFqrn, "template_ids.*", "unifiedpolicy_template.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFqrn, "rules.*", map[string]string{
+						"name": name,
+					}),
+				),
+			},
+		},
+	})
+}
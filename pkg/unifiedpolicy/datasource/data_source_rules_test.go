@@ -15,12 +15,23 @@
 package datasource_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
 )
 
 func TestAccRulesDataSource_basic(t *testing.T) {
@@ -196,6 +207,156 @@ func TestAccRulesDataSource_filterByID(t *testing.T) {
 	})
 }
 
+func TestAccRulesDataSource_includeParameterTypes(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, _, name := testutil.MkNames("test-rule-", "unifiedpolicy_rule")
+	dataSourceFqrn := "data.unifiedpolicy_rules.test"
+	resourceName := fmt.Sprintf("unifiedpolicy_rule.%s", name)
+
+	_, _, templateName := testutil.MkNames("test-template-", "template")
+	regoPath := acctest.RegoFixturePath(t, "params_severity_policy.rego")
+
+	config := fmt.Sprintf(`
+		resource "unifiedpolicy_template" "test" {
+			name             = "%s"
+			version          = "1.0.0"
+			description      = "Test template"
+			category         = "security"
+			data_source_type = "evidence"
+			rego             = %q
+			parameters = [
+				{
+					name = "severity_threshold"
+					type = "string"
+				},
+				{
+					name = "max_count"
+					type = "int"
+				}
+			]
+		}
+
+		resource "unifiedpolicy_rule" "%s" {
+			name        = "%s"
+			description = "Test rule for parameter types"
+			template_id = unifiedpolicy_template.test.id
+			parameters = [
+				{
+					name  = "severity_threshold"
+					value = "high"
+				},
+				{
+					name  = "max_count"
+					value = "10"
+				}
+			]
+		}
+
+		data "unifiedpolicy_rules" "test" {
+			id                      = %s.id
+			include_parameter_types = true
+		}
+	`, templateName, regoPath, name, name, resourceName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkRuleAndTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFqrn, "rules.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "rules.0.parameter_types.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "rules.0.parameter_types.severity_threshold", "string"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "rules.0.parameter_types.max_count", "int"),
+				),
+			},
+		},
+	})
+}
+
+func TestRulesFromAPIModel_parameterTypes(t *testing.T) {
+	apiModel := unifiedpolicyresource.RulesListAPIModel{
+		Items: []unifiedpolicyresource.RuleAPIModel{
+			{ID: "1", Name: "with-types", TemplateID: "t1"},
+			{ID: "2", Name: "without-types", TemplateID: "t2"},
+		},
+	}
+
+	var model unifiedpolicydatasource.RulesDataSourceModel
+	diags := model.FromAPIModel(context.Background(), apiModel, map[string]map[string]string{
+		"t1": {"severity": "string"},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	rules := model.Rules.Elements()
+	first := rules[0].(types.Object).Attributes()["parameter_types"].(types.Map)
+	if got := first.Elements()["severity"].(types.String).ValueString(); got != "string" {
+		t.Errorf("expected severity type 'string', got %q", got)
+	}
+	second := rules[1].(types.Object).Attributes()["parameter_types"].(types.Map)
+	if !second.IsNull() {
+		t.Errorf("expected null parameter_types for a template that was not read, got %v", second)
+	}
+}
+
+func TestRulesFromAPIModel_hasMore(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    int
+		limit    int
+		expected bool
+	}{
+		{name: "full page", items: 2, limit: 2, expected: true},
+		{name: "partial page", items: 1, limit: 2, expected: false},
+		{name: "empty page", items: 0, limit: 2, expected: false},
+		{name: "no limit returned", items: 0, limit: 0, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiModel := unifiedpolicyresource.RulesListAPIModel{Limit: tt.limit}
+			for i := 0; i < tt.items; i++ {
+				apiModel.Items = append(apiModel.Items, unifiedpolicyresource.RuleAPIModel{ID: strconv.Itoa(i), TemplateID: "t1"})
+			}
+
+			var model unifiedpolicydatasource.RulesDataSourceModel
+			diags := model.FromAPIModel(context.Background(), apiModel, nil)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got := model.HasMore.ValueBool(); got != tt.expected {
+				t.Errorf("expected has_more %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestListResultID(t *testing.T) {
+	base := unifiedpolicydatasource.ListResultID("limit=10", []string{"rule-1", "rule-2"})
+
+	if got := unifiedpolicydatasource.ListResultID("limit=10", []string{"rule-2", "rule-1"}); got != base {
+		t.Errorf("expected the same ID regardless of result order, got %q and %q", base, got)
+	}
+	if got := unifiedpolicydatasource.ListResultID("limit=10", []string{"rule-1", "rule-2", "rule-3"}); got == base {
+		t.Errorf("expected the ID to change when a result is added")
+	}
+	if got := unifiedpolicydatasource.ListResultID("limit=10", []string{"rule-1"}); got == base {
+		t.Errorf("expected the ID to change when a result is removed")
+	}
+	if got := unifiedpolicydatasource.ListResultID("limit=20", []string{"rule-1", "rule-2"}); got == base {
+		t.Errorf("expected the ID to change when the filters change")
+	}
+	if unifiedpolicydatasource.ListResultID("", []string{"ab"}) == unifiedpolicydatasource.ListResultID("", []string{"a", "b"}) {
+		t.Errorf("expected IDs not to run into each other")
+	}
+}
+
 func TestAccRulesDataSource_filterByIDs(t *testing.T) {
 	acctest.SkipIfNotAcc(t)
 	acctest.PreCheck(t)
@@ -246,6 +407,7 @@ func TestAccRulesDataSource_filterByIDs(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceFqrn, "rules.#", "1"),
 					resource.TestCheckResourceAttrPair(dataSourceFqrn, "rules.0.id", resourceName, "id"),
 					resource.TestCheckResourceAttrPair(dataSourceFqrn, "rules.0.name", resourceName, "name"),
+					resource.TestMatchResourceAttr(dataSourceFqrn, "effective_query", regexp.MustCompile(`^id=[^&]+$`)),
 				),
 			},
 		},
@@ -638,6 +800,8 @@ func TestAccRulesDataSource_multiFilter(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceFqrn, "rules.#"),
 					resource.TestCheckResourceAttrSet(dataSourceFqrn, "offset"),
 					resource.TestCheckResourceAttr(dataSourceFqrn, "offset", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceFqrn, "has_more"),
+					resource.TestCheckResourceAttrSet(dataSourceFqrn, "result_id"),
 				),
 			},
 		},
@@ -752,3 +916,163 @@ func TestAccRulesDataSource_sorting(t *testing.T) {
 		},
 	})
 }
+
+func TestAccRulesDataSource_sortingMultipleFields(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, _, name := testutil.MkNames("test-rule-", "unifiedpolicy_rule")
+	dataSourceFqrn := "data.unifiedpolicy_rules.test"
+
+	_, _, templateName := testutil.MkNames("test-template-", "template")
+	regoPath := acctest.RegoFixturePath(t, "basic_policy.rego")
+
+	resourceConfig := fmt.Sprintf(`
+		resource "unifiedpolicy_template" "test" {
+			name             = "%s"
+			version          = "1.0.0"
+			description      = "Template"
+			category         = "security"
+			data_source_type = "evidence"
+			rego             = %q
+			parameters = []
+		}
+
+		resource "unifiedpolicy_rule" "%s" {
+			name        = "%s"
+			description = "Rule for sorting"
+			template_id = unifiedpolicy_template.test.id
+			parameters  = []
+		}
+	`, templateName, regoPath, name, name)
+
+	dataSourceConfig := fmt.Sprintf(`
+		%s
+
+		data "unifiedpolicy_rules" "test" {
+			sort_by_fields = ["name", "created_at"]
+			sort_order     = "asc"
+		}
+	`, resourceConfig)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkRuleAndTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceFqrn, "rules.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestRulesFromAPIModel_isNoop(t *testing.T) {
+	apiModel := unifiedpolicyresource.RulesListAPIModel{
+		Items: []unifiedpolicyresource.RuleAPIModel{
+			{ID: "1", TemplateID: "t1", ExtraFields: unifiedpolicy.ExtraFields{"template": json.RawMessage(`{"id":"t1","data_source_type":"noop"}`)}},
+			{ID: "2", TemplateID: "t2", ExtraFields: unifiedpolicy.ExtraFields{"template": json.RawMessage(`{"id":"t2","data_source_type":"evidence"}`)}},
+			{ID: "3", TemplateID: "t3"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		expand   types.String
+		expected []types.Bool
+	}{
+		{
+			name:     "template expanded",
+			expand:   types.StringValue("template"),
+			expected: []types.Bool{types.BoolValue(true), types.BoolValue(false), types.BoolNull()},
+		},
+		{
+			name:     "template among expanded fields",
+			expand:   types.StringValue("parameters, template"),
+			expected: []types.Bool{types.BoolValue(true), types.BoolValue(false), types.BoolNull()},
+		},
+		{
+			name:     "not expanded",
+			expand:   types.StringNull(),
+			expected: []types.Bool{types.BoolNull(), types.BoolNull(), types.BoolNull()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := unifiedpolicydatasource.RulesDataSourceModel{Expand: tt.expand}
+			diags := model.FromAPIModel(context.Background(), apiModel, nil)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			for i, rule := range model.Rules.Elements() {
+				if got := rule.(types.Object).Attributes()["is_noop"]; !got.Equal(tt.expected[i]) {
+					t.Errorf("rule %d: expected is_noop %v, got %v", i, tt.expected[i], got)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchListPages(t *testing.T) {
+	const total = 25
+	tests := []struct {
+		name        string
+		query       url.Values
+		stuckAt     int
+		fetchError  int
+		wantOffsets []string
+		wantItems   int
+		wantError   string
+	}{
+		{name: "all pages", query: url.Values{"limit": {"10"}}, stuckAt: -1, fetchError: -1, wantOffsets: []string{"0", "1", "2"}, wantItems: 25},
+		{name: "from page", query: url.Values{"offset": {"1"}, "limit": {"10"}}, stuckAt: -1, fetchError: -1, wantOffsets: []string{"1", "2"}, wantItems: 15},
+		{name: "offset not advancing", query: url.Values{"limit": {"10"}}, stuckAt: 1, fetchError: -1, wantOffsets: []string{"0", "1", "2"}, wantItems: 30, wantError: "Pagination Did Not Advance"},
+		{name: "page error", query: url.Values{"limit": {"10"}}, stuckAt: -1, fetchError: 1, wantOffsets: []string{"0", "1"}, wantItems: 10, wantError: "Unable to Read Data Source"},
+		{name: "invalid offset", query: url.Values{"offset": {"first"}}, stuckAt: -1, fetchError: -1, wantError: "Invalid Page Offset"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var offsets []string
+			items := 0
+			diags := unifiedpolicydatasource.FetchListPages(tt.query, func(query url.Values) (int, int, int, diag.Diagnostics) {
+				var diags diag.Diagnostics
+				offsets = append(offsets, query.Get("offset"))
+				if query.Get("limit") != "10" {
+					t.Errorf("expected limit to be kept, got query %v", query)
+				}
+				page, _ := strconv.Atoi(query.Get("offset"))
+				if page == tt.fetchError {
+					diags.AddError("Unable to Read Data Source", "boom")
+					return 0, 0, 0, diags
+				}
+				// A server stuck at a page keeps returning that page
+				if tt.stuckAt >= 0 && page > tt.stuckAt {
+					page = tt.stuckAt
+				}
+				count := min(10, max(0, total-page*10))
+				items += count
+				return page, count, 10, diags
+			})
+
+			if tt.wantError == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error diagnostics: %v", diags)
+				}
+			} else if !diags.HasError() || diags.Errors()[0].Summary() != tt.wantError {
+				t.Fatalf("expected error %q, got %v", tt.wantError, diags)
+			}
+			if !slices.Equal(offsets, tt.wantOffsets) {
+				t.Errorf("expected pages %v to be read, got %v", tt.wantOffsets, offsets)
+			}
+			if items != tt.wantItems {
+				t.Errorf("expected %d items, got %d", tt.wantItems, items)
+			}
+		})
+	}
+}
@@ -18,14 +18,18 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
 )
@@ -37,12 +41,13 @@ func NewTemplateDataSource() datasource.DataSource {
 }
 
 type TemplateDataSource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData unifiedpolicy.ProviderMetadata
 }
 
 type TemplateDataSourceModel struct {
 	ID             types.String `tfsdk:"id"`
 	Name           types.String `tfsdk:"name"`
+	Version        types.String `tfsdk:"version"`
 	Description    types.String `tfsdk:"description"`
 	Category       types.String `tfsdk:"category"`
 	DataSourceType types.String `tfsdk:"data_source_type"`
@@ -50,6 +55,8 @@ type TemplateDataSourceModel struct {
 	Rego           types.String `tfsdk:"rego"`
 	Scanners       types.List   `tfsdk:"scanners"`
 	IsCustom       types.Bool   `tfsdk:"is_custom"`
+	IncludeUsage   types.Bool   `tfsdk:"include_usage"`
+	InUse          types.Bool   `tfsdk:"in_use"`
 	CreatedAt      types.String `tfsdk:"created_at"`
 	CreatedBy      types.String `tfsdk:"created_by"`
 	UpdatedAt      types.String `tfsdk:"updated_at"`
@@ -62,16 +69,30 @@ func (d *TemplateDataSource) Metadata(ctx context.Context, req datasource.Metada
 
 func (d *TemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Returns the details of a Unified Policy template by its ID. " +
+		MarkdownDescription: "Returns the details of a Unified Policy template by its ID, or by its name and version. " +
 			"Templates define reusable logic (business rules) for policies using Rego policy language.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "The ID of the template to query.",
-				Required:    true,
+				Description: "The ID of the template to query. Exactly one of `id` and `name` must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The template name.",
+				Description: "The template name. Set it instead of `id` to look the template up by name; when several versions of the " +
+					"template share the name, `version` must be set as well.",
+				Optional: true,
+				Computed: true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The template version. Selects the version of the template looked up by `name`.",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("name")),
+				},
 			},
 			"description": schema.StringAttribute{
 				Description: "A free-text description of the template.",
@@ -114,6 +135,15 @@ func (d *TemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Description: "Whether the template is user-defined (true) or built-in (false).",
 				Computed:    true,
 			},
+			"include_usage": schema.BoolAttribute{
+				Description: "When true, every page of the rules list is read to populate `in_use`. Defaults to false.",
+				Optional:    true,
+			},
+			"in_use": schema.BoolAttribute{
+				Description: "Whether any rule is based on this template, i.e. whether deleting it would break a rule. " +
+					"Only populated when `include_usage` is true; null otherwise.",
+				Computed: true,
+			},
 			"created_at": schema.StringAttribute{
 				Description: "Timestamp when the template was created.",
 				Computed:    true,
@@ -138,7 +168,7 @@ func (d *TemplateDataSource) Configure(ctx context.Context, req datasource.Confi
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
 }
 
 func (d *TemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -149,6 +179,20 @@ func (d *TemplateDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	if !data.Name.IsNull() {
+		templates, diags := listAllTemplates(ctx, d.ProviderData)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		template, diags := FindTemplateByName(templates, data.Name.ValueString(), data.Version.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.ID = types.StringValue(template.ID)
+	}
+
 	tflog.Info(ctx, "Reading template datasource", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
@@ -158,7 +202,7 @@ func (d *TemplateDataSource) Read(ctx context.Context, req datasource.ReadReques
 		SetContext(ctx).
 		SetPathParam("templateId", data.ID.ValueString()).
 		SetResult(&result).
-		Get(resource.TemplateEndpoint)
+		Get(d.ProviderData.Endpoint(resource.TemplateEndpoint))
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -189,15 +233,85 @@ func (d *TemplateDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	if data.IncludeUsage.ValueBool() {
+		rules, diags := listAllRules(ctx, d.ProviderData)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.InUse = types.BoolValue(TemplateInUse(result.ID, rules))
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// TemplateInUse reports whether any of the rules is based on the template with the given ID.
+// This function is exported for testing purposes.
+func TemplateInUse(templateID string, rules []resource.RuleAPIModel) bool {
+	for _, rule := range rules {
+		if rule.TemplateID == templateID {
+			return true
+		}
+	}
+	return false
+}
+
+// FindTemplateByName returns the template with the given name and, when version is not empty, version. A name
+// shared by several versions is an error listing the versions, so that a version is never picked arbitrarily.
+// This function is exported for testing purposes.
+func FindTemplateByName(templates []resource.TemplateAPIModel, name, version string) (resource.TemplateAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var named, matches []resource.TemplateAPIModel
+	for _, template := range templates {
+		if template.Name != name {
+			continue
+		}
+		named = append(named, template)
+		if version == "" || template.Version == version {
+			matches = append(matches, template)
+		}
+	}
+
+	switch {
+	case len(matches) == 1:
+		return matches[0], diags
+	case len(named) == 0:
+		diags.AddError(
+			"Template Not Found",
+			fmt.Sprintf("No template named '%s' was found.", name),
+		)
+	case len(matches) == 0:
+		diags.AddError(
+			"Template Not Found",
+			fmt.Sprintf("No version '%s' of template '%s' was found. Available versions: %s.", version, name, templateVersions(named)),
+		)
+	default:
+		diags.AddError(
+			"Ambiguous Template Name",
+			fmt.Sprintf("%d templates are named '%s', with versions: %s. Set version to select one of them.", len(matches), name, templateVersions(matches)),
+		)
+	}
+	return resource.TemplateAPIModel{}, diags
+}
+
+// templateVersions lists the versions and IDs of templates for an error message, sorted by version.
+func templateVersions(templates []resource.TemplateAPIModel) string {
+	versions := make([]string, len(templates))
+	for i, template := range templates {
+		versions[i] = fmt.Sprintf("%s (ID '%s')", template.Version, template.ID)
+	}
+	slices.Sort(versions)
+	return strings.Join(versions, ", ")
+}
+
 // FromAPIModel converts the API response model to the Terraform datasource model.
 func (m *TemplateDataSourceModel) FromAPIModel(ctx context.Context, apiModel resource.TemplateAPIModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	m.ID = types.StringValue(apiModel.ID)
 	m.Name = types.StringValue(apiModel.Name)
+	m.Version = types.StringValue(apiModel.Version)
 
 	// Handle description: if pointer is nil, set to null; otherwise use the value (even if empty string)
 	if apiModel.Description != nil {
@@ -210,6 +324,7 @@ func (m *TemplateDataSourceModel) FromAPIModel(ctx context.Context, apiModel res
 	m.DataSourceType = types.StringValue(apiModel.DataSourceType)
 	m.Rego = types.StringValue(apiModel.Rego)
 	m.IsCustom = types.BoolValue(apiModel.IsCustom)
+	m.InUse = types.BoolNull()
 
 	paramAttrTypes := map[string]attr.Type{
 		"name": types.StringType,
@@ -22,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
 )
 
 func TestAccTemplateDataSource_basic(t *testing.T) {
@@ -256,3 +258,141 @@ func TestAccTemplateDataSource_emptyDescription(t *testing.T) {
 		},
 	})
 }
+
+func TestAccTemplateDataSource_includeUsage(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, _, templateName := testutil.MkNames("test-template-usage-ds-", "unifiedpolicy_template")
+	_, _, ruleName := testutil.MkNames("test-rule-usage-ds-", "unifiedpolicy_rule")
+	dataSourceFqrn := "data.unifiedpolicy_template.test"
+
+	regoPath := acctest.RegoFixturePath(t, "params_severity_policy.rego")
+	templateConfig := fmt.Sprintf(`
+		resource "unifiedpolicy_template" "test" {
+			name             = "%s"
+			version          = "1.0.0"
+			category         = "security"
+			data_source_type = "evidence"
+			rego             = %q
+			parameters = [
+				{
+					name = "severity_threshold"
+					type = "string"
+				},
+				{
+					name = "max_count"
+					type = "int"
+				}
+			]
+		}
+	`, templateName, regoPath)
+
+	unusedConfig := fmt.Sprintf(`
+		%s
+
+		data "unifiedpolicy_template" "test" {
+			id            = unifiedpolicy_template.test.id
+			include_usage = true
+		}
+	`, templateConfig)
+
+	// depends_on defers the read until the rule exists
+	usedConfig := fmt.Sprintf(`
+		%s
+
+		resource "unifiedpolicy_rule" "test" {
+			name        = "%s"
+			template_id = unifiedpolicy_template.test.id
+			parameters = [
+				{
+					name  = "severity_threshold"
+					value = "high"
+				},
+				{
+					name  = "max_count"
+					value = "10"
+				}
+			]
+		}
+
+		data "unifiedpolicy_template" "test" {
+			id            = unifiedpolicy_template.test.id
+			include_usage = true
+			depends_on    = [unifiedpolicy_rule.test]
+		}
+	`, templateConfig, ruleName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkRuleAndTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: unusedConfig,
+				Check:  resource.TestCheckResourceAttr(dataSourceFqrn, "in_use", "false"),
+			},
+			{
+				Config: usedConfig,
+				Check:  resource.TestCheckResourceAttr(dataSourceFqrn, "in_use", "true"),
+			},
+		},
+	})
+}
+
+func TestTemplateInUse(t *testing.T) {
+	rules := []unifiedpolicyresource.RuleAPIModel{
+		{ID: "1", TemplateID: "t1"},
+		{ID: "2", TemplateID: "t2"},
+	}
+
+	if !unifiedpolicydatasource.TemplateInUse("t2", rules) {
+		t.Errorf("expected template t2 to be in use")
+	}
+	if unifiedpolicydatasource.TemplateInUse("t3", rules) {
+		t.Errorf("expected template t3 not to be in use")
+	}
+	if unifiedpolicydatasource.TemplateInUse("t1", nil) {
+		t.Errorf("expected no template to be in use without rules")
+	}
+}
+
+func TestFindTemplateByName(t *testing.T) {
+	templates := []unifiedpolicyresource.TemplateAPIModel{
+		{ID: "1001", Name: "severity-gate", Version: "1.0.0"},
+		{ID: "1002", Name: "severity-gate", Version: "2.0.0"},
+		{ID: "1003", Name: "license-gate", Version: "1.0.0"},
+	}
+
+	tests := []struct {
+		name       string
+		lookupName string
+		version    string
+		expectedID string
+		errorRegex string
+	}{
+		{name: "single version", lookupName: "license-gate", expectedID: "1003"},
+		{name: "version selected", lookupName: "severity-gate", version: "2.0.0", expectedID: "1002"},
+		{name: "several versions", lookupName: "severity-gate", errorRegex: `^2 templates are named 'severity-gate', with versions: 1\.0\.0 \(ID '1001'\), 2\.0\.0 \(ID '1002'\)\. Set version`},
+		{name: "unknown version", lookupName: "severity-gate", version: "3.0.0", errorRegex: `Available versions: 1\.0\.0 \(ID '1001'\), 2\.0\.0 \(ID '1002'\)`},
+		{name: "unknown name", lookupName: "missing", errorRegex: `No template named 'missing'`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, diags := unifiedpolicydatasource.FindTemplateByName(templates, tt.lookupName, tt.version)
+			if tt.errorRegex == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				if template.ID != tt.expectedID {
+					t.Errorf("expected template %s, got %s", tt.expectedID, template.ID)
+				}
+				return
+			}
+			if len(diags.Errors()) != 1 || !regexp.MustCompile(tt.errorRegex).MatchString(diags.Errors()[0].Detail()) {
+				t.Errorf("expected an error matching %q, got %v", tt.errorRegex, diags)
+			}
+		})
+	}
+}
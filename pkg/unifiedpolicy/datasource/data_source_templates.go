@@ -19,6 +19,7 @@ import (
 	"net/url"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -26,7 +27,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
 )
@@ -38,22 +38,37 @@ func NewTemplatesDataSource() datasource.DataSource {
 }
 
 type TemplatesDataSource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData unifiedpolicy.ProviderMetadata
 }
 
+// Template sources, derived from is_custom.
+const (
+	TemplateSourceCustom = "custom"
+	TemplateSourceSystem = "system"
+)
+
+// templateCategories are the values accepted by the category filters.
+var templateCategories = []string{"security", "legal", "operational", "quality", "audit", "workflow"}
+
+// templateDataSourceTypes are the values accepted by the data_source_type filter.
+var templateDataSourceTypes = []string{"noop", "evidence", "xray"}
+
 type TemplatesDataSourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	IDs       types.List   `tfsdk:"ids"`
-	Name      types.String `tfsdk:"name"`
-	Names     types.List   `tfsdk:"names"`
-	Category  types.String `tfsdk:"category"`
-	Page      types.Int64  `tfsdk:"page"`
-	Limit     types.Int64  `tfsdk:"limit"`
-	SortBy    types.String `tfsdk:"sort_by"`
-	SortOrder types.String `tfsdk:"sort_order"`
-	Templates types.List   `tfsdk:"templates"`
-	Offset    types.Int64  `tfsdk:"offset"`
-	PageSize  types.Int64  `tfsdk:"page_size"`
+	ID             types.String `tfsdk:"id"`
+	IDs            types.List   `tfsdk:"ids"`
+	Name           types.String `tfsdk:"name"`
+	Names          types.List   `tfsdk:"names"`
+	Category       types.String `tfsdk:"category"`
+	Categories     types.List   `tfsdk:"categories"`
+	Source         types.String `tfsdk:"source"`
+	DataSourceType types.String `tfsdk:"data_source_type"`
+	Page           types.Int64  `tfsdk:"page"`
+	Limit          types.Int64  `tfsdk:"limit"`
+	SortBy         types.String `tfsdk:"sort_by"`
+	SortOrder      types.String `tfsdk:"sort_order"`
+	Templates      types.List   `tfsdk:"templates"`
+	Offset         types.Int64  `tfsdk:"offset"`
+	PageSize       types.Int64  `tfsdk:"page_size"`
 }
 
 func (d *TemplatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -87,7 +102,33 @@ func (d *TemplatesDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				Description: "Filter by template category. Must be one of: security, legal, operational, quality, audit, workflow.",
 				Optional:    true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("security", "legal", "operational", "quality", "audit", "workflow"),
+					stringvalidator.OneOf(templateCategories...),
+				},
+			},
+			"categories": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Filter by template categories; a template matches when it has any of them. Multiple categories are sent as repeated `category` " +
+					"query parameters (e.g. ?category=security&category=quality). Each must be one of: security, legal, operational, quality, audit, workflow. " +
+					"Takes precedence over `category`.",
+				Optional: true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(templateCategories...)),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "Filter by template origin: 'custom' for user-defined templates or 'system' for built-in ones. " +
+					"Sent as query parameter `is_custom`; the returned page is also filtered client-side, so the result is correct on backends that ignore it.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(TemplateSourceCustom, TemplateSourceSystem),
+				},
+			},
+			"data_source_type": schema.StringAttribute{
+				Description: "Filter by the type of data source the templates expect. Must be one of: noop, evidence, xray. " +
+					"Sent as query parameter `data_source_type`; the returned page is also filtered client-side, so the result is correct on backends that ignore it.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(templateDataSourceTypes...),
 				},
 			},
 			"page": schema.Int64Attribute{
@@ -138,6 +179,10 @@ func (d *TemplatesDataSource) Schema(ctx context.Context, req datasource.SchemaR
 							Description: "Whether the template is user-defined (true) or built-in (false).",
 							Computed:    true,
 						},
+						"source": schema.StringAttribute{
+							Description: "Origin of the template: 'custom' (user-defined) or 'system' (built-in). Derived from `is_custom`.",
+							Computed:    true,
+						},
 						"created_at": schema.StringAttribute{
 							Description: "Timestamp when the template was created.",
 							Computed:    true,
@@ -165,7 +210,7 @@ func (d *TemplatesDataSource) Configure(ctx context.Context, req datasource.Conf
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
 }
 
 func (d *TemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -178,7 +223,7 @@ func (d *TemplatesDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	request := d.ProviderData.Client.R().SetContext(ctx)
 
-	// Build multi-value query params (id, name) in one Values so both can be sent
+	// Build multi-value query params (id, name, category) in one Values so all can be sent
 	queryValues := url.Values{}
 	if !data.IDs.IsNull() && len(data.IDs.Elements()) > 0 {
 		idStrings := make([]string, 0, len(data.IDs.Elements()))
@@ -206,14 +251,23 @@ func (d *TemplatesDataSource) Read(ctx context.Context, req datasource.ReadReque
 	} else if !data.Name.IsNull() {
 		queryValues.Set("name", data.Name.ValueString())
 	}
+	if !data.Categories.IsNull() && len(data.Categories.Elements()) > 0 {
+		categoryStrings := make([]string, 0, len(data.Categories.Elements()))
+		for _, e := range data.Categories.Elements() {
+			if s, ok := e.(types.String); ok && !s.IsNull() {
+				categoryStrings = append(categoryStrings, s.ValueString())
+			}
+		}
+		if len(categoryStrings) > 0 {
+			queryValues["category"] = categoryStrings
+		}
+	} else if !data.Category.IsNull() {
+		queryValues.Set("category", data.Category.ValueString())
+	}
 	if len(queryValues) > 0 {
 		request.SetQueryParamsFromValues(queryValues)
 	}
 
-	if !data.Category.IsNull() {
-		request.SetQueryParam("category", data.Category.ValueString())
-	}
-
 	// API spec uses 'offset' for pagination (not 'page')
 	if !data.Page.IsNull() {
 		request.SetQueryParam("offset", strconv.FormatInt(data.Page.ValueInt64(), 10))
@@ -231,8 +285,16 @@ func (d *TemplatesDataSource) Read(ctx context.Context, req datasource.ReadReque
 		request.SetQueryParam("sort_order", data.SortOrder.ValueString())
 	}
 
+	if !data.Source.IsNull() {
+		request.SetQueryParam("is_custom", strconv.FormatBool(data.Source.ValueString() == TemplateSourceCustom))
+	}
+
+	if !data.DataSourceType.IsNull() {
+		request.SetQueryParam("data_source_type", data.DataSourceType.ValueString())
+	}
+
 	var result resource.TemplatesListAPIModel
-	response, err := request.SetResult(&result).Get(resource.TemplatesEndpoint)
+	response, err := request.SetResult(&result).Get(d.ProviderData.Endpoint(resource.TemplatesEndpoint))
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -250,6 +312,14 @@ func (d *TemplatesDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	if !data.Source.IsNull() {
+		result.Items = FilterTemplatesBySource(result.Items, data.Source.ValueString())
+	}
+
+	if !data.DataSourceType.IsNull() {
+		result.Items = FilterTemplatesByDataSourceType(result.Items, data.DataSourceType.ValueString())
+	}
+
 	diags := data.FromAPIModel(ctx, result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -259,6 +329,38 @@ func (d *TemplatesDataSource) Read(ctx context.Context, req datasource.ReadReque
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// TemplateSource returns the source of a template: TemplateSourceCustom or TemplateSourceSystem.
+func TemplateSource(isCustom bool) string {
+	if isCustom {
+		return TemplateSourceCustom
+	}
+	return TemplateSourceSystem
+}
+
+// FilterTemplatesBySource returns the templates whose source is source, keeping their order.
+// This function is exported for testing purposes.
+func FilterTemplatesBySource(templates []resource.TemplateAPIModel, source string) []resource.TemplateAPIModel {
+	filtered := []resource.TemplateAPIModel{}
+	for _, template := range templates {
+		if TemplateSource(template.IsCustom) == source {
+			filtered = append(filtered, template)
+		}
+	}
+	return filtered
+}
+
+// FilterTemplatesByDataSourceType returns the templates that expect dataSourceType, keeping their order.
+// This function is exported for testing purposes.
+func FilterTemplatesByDataSourceType(templates []resource.TemplateAPIModel, dataSourceType string) []resource.TemplateAPIModel {
+	filtered := []resource.TemplateAPIModel{}
+	for _, template := range templates {
+		if template.DataSourceType == dataSourceType {
+			filtered = append(filtered, template)
+		}
+	}
+	return filtered
+}
+
 func (m *TemplatesDataSourceModel) FromAPIModel(ctx context.Context, apiModel resource.TemplatesListAPIModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -271,6 +373,7 @@ func (m *TemplatesDataSourceModel) FromAPIModel(ctx context.Context, apiModel re
 		"category":         types.StringType,
 		"data_source_type": types.StringType,
 		"is_custom":        types.BoolType,
+		"source":           types.StringType,
 		"created_at":       types.StringType,
 		"updated_at":       types.StringType,
 	}
@@ -282,6 +385,7 @@ func (m *TemplatesDataSourceModel) FromAPIModel(ctx context.Context, apiModel re
 			"category":         types.StringValue(template.Category),
 			"data_source_type": types.StringValue(template.DataSourceType),
 			"is_custom":        types.BoolValue(template.IsCustom),
+			"source":           types.StringValue(TemplateSource(template.IsCustom)),
 		}
 
 		// Handle description: if pointer is nil, set to null; otherwise use the value (even if empty string)
@@ -323,3 +427,42 @@ func (m *TemplatesDataSourceModel) FromAPIModel(ctx context.Context, apiModel re
 
 	return diags
 }
+
+// listPageLimit is the largest page size accepted by the list endpoints.
+const listPageLimit = 250
+
+// listAllTemplates reads every page of the templates list. Shared by the datasources that resolve across all templates.
+func listAllTemplates(ctx context.Context, providerData unifiedpolicy.ProviderMetadata) ([]resource.TemplateAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var templates []resource.TemplateAPIModel
+
+	for page := 0; ; page++ {
+		var result resource.TemplatesListAPIModel
+		response, err := providerData.Client.R().
+			SetContext(ctx).
+			SetQueryParam("offset", strconv.Itoa(page)).
+			SetQueryParam("limit", strconv.Itoa(listPageLimit)).
+			SetResult(&result).
+			Get(providerData.Endpoint(resource.TemplatesEndpoint))
+
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Data Source",
+				"An unexpected error occurred while fetching the data source. "+
+					"Please report this issue to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return nil, diags
+		}
+
+		if response.IsError() {
+			diags.Append(unifiedpolicy.HandleAPIErrorWithType(response, "read", "template")...)
+			return nil, diags
+		}
+
+		templates = append(templates, result.Items...)
+		if len(result.Items) < listPageLimit {
+			return templates, diags
+		}
+	}
+}
@@ -15,12 +15,17 @@
 package datasource_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicydatasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
 )
 
 func TestAccTemplatesDataSource_basic(t *testing.T) {
@@ -377,3 +382,206 @@ func TestAccTemplatesDataSource_filterByNames(t *testing.T) {
 		},
 	})
 }
+
+func TestAccTemplatesDataSource_filterByCategories(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, fqrn, name1 := testutil.MkNames("test-template-security-", "unifiedpolicy_template")
+	_, _, name2 := testutil.MkNames("test-template-quality-", "unifiedpolicy_template")
+	_, _, name3 := testutil.MkNames("test-template-legal-", "unifiedpolicy_template")
+	dataSourceFqrn := "data.unifiedpolicy_templates.test"
+
+	regoPath := acctest.RegoFixturePath(t, "params_policy.rego")
+	templateConfig := func(name, category string) string {
+		return fmt.Sprintf(`
+		resource "unifiedpolicy_template" "%s" {
+			name             = "%s"
+			version          = "1.0.0"
+			category         = "%s"
+			data_source_type = "evidence"
+			rego             = %q
+			parameters = []
+		}
+		`, name, name, category, regoPath)
+	}
+
+	// The names filter keeps the result to the templates of this test; the legal one must be filtered out
+	dataSourceConfig := fmt.Sprintf(`
+		%s
+		%s
+		%s
+
+		data "unifiedpolicy_templates" "test" {
+			names      = [unifiedpolicy_template.%s.name, unifiedpolicy_template.%s.name, unifiedpolicy_template.%s.name]
+			categories = ["security", "quality"]
+		}
+	`, templateConfig(name1, "security"), templateConfig(name2, "quality"), templateConfig(name3, "legal"), name1, name2, name3)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.TestAccCheckTemplateDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFqrn, "templates.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFqrn, "templates.*", map[string]string{"name": name1, "category": "security"}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceFqrn, "templates.*", map[string]string{"name": name2, "category": "quality"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTemplatesDataSource_invalidCategories(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "unifiedpolicy_templates" "test" {
+						categories = ["security", "compliance"]
+					}
+				`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccTemplatesDataSource_filterBySource(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, fqrn, name := testutil.MkNames("test-template-source-", "unifiedpolicy_template")
+	dataSourceFqrn := "data.unifiedpolicy_templates.test"
+
+	regoPath := acctest.RegoFixturePath(t, "params_policy.rego")
+	resourceConfig := fmt.Sprintf(`
+		resource "unifiedpolicy_template" "%s" {
+			name             = "%s"
+			version          = "1.0.0"
+			category         = "security"
+			data_source_type = "evidence"
+			rego             = %q
+			parameters = []
+		}
+	`, name, name, regoPath)
+
+	sourceConfig := func(source string) string {
+		return fmt.Sprintf(`
+			%s
+
+			data "unifiedpolicy_templates" "test" {
+				name   = unifiedpolicy_template.%s.name
+				source = "%s"
+			}
+		`, resourceConfig, name, source)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.TestAccCheckTemplateDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: sourceConfig("custom"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFqrn, "templates.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "templates.0.source", "custom"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "templates.0.is_custom", "true"),
+				),
+			},
+			{
+				Config: sourceConfig("system"),
+				Check:  resource.TestCheckResourceAttr(dataSourceFqrn, "templates.#", "0"),
+			},
+		},
+	})
+}
+
+func TestFilterTemplatesBySource(t *testing.T) {
+	templates := []unifiedpolicyresource.TemplateAPIModel{
+		{ID: "1", IsCustom: true},
+		{ID: "2", IsCustom: false},
+		{ID: "3", IsCustom: true},
+	}
+
+	tests := []struct {
+		source  string
+		wantIDs []string
+	}{
+		{source: unifiedpolicydatasource.TemplateSourceCustom, wantIDs: []string{"1", "3"}},
+		{source: unifiedpolicydatasource.TemplateSourceSystem, wantIDs: []string{"2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			filtered := unifiedpolicydatasource.FilterTemplatesBySource(templates, tt.source)
+			ids := make([]string, len(filtered))
+			for i, template := range filtered {
+				ids[i] = template.ID
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(tt.wantIDs) {
+				t.Errorf("expected IDs %v, got %v", tt.wantIDs, ids)
+			}
+		})
+	}
+}
+
+func TestFilterTemplatesByDataSourceType(t *testing.T) {
+	templates := []unifiedpolicyresource.TemplateAPIModel{
+		{ID: "1", DataSourceType: "noop"},
+		{ID: "2", DataSourceType: "evidence"},
+		{ID: "3", DataSourceType: "noop"},
+	}
+
+	tests := []struct {
+		dataSourceType string
+		wantIDs        []string
+	}{
+		{dataSourceType: "noop", wantIDs: []string{"1", "3"}},
+		{dataSourceType: "evidence", wantIDs: []string{"2"}},
+		{dataSourceType: "xray", wantIDs: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dataSourceType, func(t *testing.T) {
+			filtered := unifiedpolicydatasource.FilterTemplatesByDataSourceType(templates, tt.dataSourceType)
+			ids := make([]string, len(filtered))
+			for i, template := range filtered {
+				ids[i] = template.ID
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(tt.wantIDs) {
+				t.Errorf("expected IDs %v, got %v", tt.wantIDs, ids)
+			}
+		})
+	}
+}
+
+func TestTemplatesFromAPIModel_source(t *testing.T) {
+	apiModel := unifiedpolicyresource.TemplatesListAPIModel{
+		Items: []unifiedpolicyresource.TemplateAPIModel{
+			{ID: "1", Name: "custom-template", IsCustom: true},
+			{ID: "2", Name: "system-template", IsCustom: false},
+		},
+	}
+
+	var model unifiedpolicydatasource.TemplatesDataSourceModel
+	if diags := model.FromAPIModel(context.Background(), apiModel); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	for i, want := range []string{"custom", "system"} {
+		source := model.Templates.Elements()[i].(types.Object).Attributes()["source"].(types.String)
+		if source.ValueString() != want {
+			t.Errorf("template %d: expected source %q, got %q", i, want, source.ValueString())
+		}
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy
+
+import "strings"
+
+// PrefixDescription returns the description sent to the API for a description configured on a resource: prefix
+// (provider attribute `description_prefix`) and description joined by a space. Empty descriptions are sent
+// unchanged, so that an unset description stays unset.
+func PrefixDescription(prefix, description string) string {
+	if prefix == "" || description == "" {
+		return description
+	}
+	return prefix + " " + description
+}
+
+// StripDescriptionPrefix reverses PrefixDescription for a description returned by the API. Descriptions without
+// the prefix, e.g. of objects created before it was configured, are returned unchanged.
+func StripDescriptionPrefix(prefix, description string) string {
+	if prefix == "" {
+		return description
+	}
+	return strings.TrimPrefix(description, prefix+" ")
+}
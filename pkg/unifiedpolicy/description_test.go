@@ -0,0 +1,53 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy_test
+
+import (
+	"testing"
+
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+)
+
+func TestDescriptionPrefix_roundTrip(t *testing.T) {
+	tests := []struct {
+		name        string
+		prefix      string
+		description string
+		sent        string
+	}{
+		{name: "prefixed", prefix: "[managed-by-terraform]", description: "Blocks critical CVEs", sent: "[managed-by-terraform] Blocks critical CVEs"},
+		{name: "empty description", prefix: "[managed-by-terraform]", description: "", sent: ""},
+		{name: "no prefix", prefix: "", description: "Blocks critical CVEs", sent: "Blocks critical CVEs"},
+		{name: "description starting with prefix", prefix: "[tf]", description: "[tf] nested", sent: "[tf] [tf] nested"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent := unifiedpolicy.PrefixDescription(tt.prefix, tt.description)
+			if sent != tt.sent {
+				t.Errorf("expected %q to be sent, got %q", tt.sent, sent)
+			}
+			if got := unifiedpolicy.StripDescriptionPrefix(tt.prefix, sent); got != tt.description {
+				t.Errorf("expected %q after round trip, got %q", tt.description, got)
+			}
+		})
+	}
+}
+
+func TestStripDescriptionPrefix_unprefixed(t *testing.T) {
+	if got := unifiedpolicy.StripDescriptionPrefix("[tf]", "Created outside Terraform"); got != "Created outside Terraform" {
+		t.Errorf("expected description without the prefix to be unchanged, got %q", got)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ETagPrivateStateKey is the resource private state key holding the ETag of the last read or write.
+const ETagPrivateStateKey = "api_etag"
+
+// GetPrivateETag reads the ETag stored in resource private state. It is empty when none was stored.
+func GetPrivateETag(ctx context.Context, private PrivateStateGetter) (string, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, ETagPrivateStateKey)
+	if diags.HasError() || len(data) == 0 {
+		return "", diags
+	}
+
+	var etag string
+	if err := json.Unmarshal(data, &etag); err != nil {
+		diags.AddError("Unable to Read Private State", "Stored ETag could not be decoded: "+err.Error())
+	}
+	return etag, diags
+}
+
+// SetPrivateETag stores the ETag header of an API response in resource private state, or clears it when the
+// response has none so a stale ETag is never sent. Private state values must be JSON, so the ETag is stored
+// as a JSON string.
+func SetPrivateETag(ctx context.Context, private privateStateSetter, response *resty.Response) diag.Diagnostics {
+	etag := response.Header().Get("ETag")
+	if etag == "" {
+		return private.SetKey(ctx, ETagPrivateStateKey, nil)
+	}
+	data, err := json.Marshal(etag)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Unable to Write Private State", "ETag could not be encoded: "+err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, ETagPrivateStateKey, data)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ExtraFieldsPrivateStateKey is the resource private state key holding API fields unknown to the provider.
+// Private state is internal to the provider and never shown to users.
+const ExtraFieldsPrivateStateKey = "api_extra_fields"
+
+// ExtraFields holds API response fields that have no counterpart in an API model struct. They are kept so
+// they can be sent back unchanged on update when a newer backend requires it. Internal round-tripping only;
+// they are not exposed as resource attributes.
+type ExtraFields map[string]json.RawMessage
+
+// UnmarshalWithExtra decodes data into v (a pointer to a struct) and returns the top-level fields that do
+// not match any of the struct's JSON field names.
+func UnmarshalWithExtra(data []byte, v interface{}) (ExtraFields, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		// Not a JSON object (e.g. null); nothing extra to keep
+		return nil, nil
+	}
+
+	for _, name := range jsonFieldNames(reflect.TypeOf(v).Elem()) {
+		delete(all, name)
+	}
+	if len(all) == 0 {
+		return nil, nil
+	}
+	return all, nil
+}
+
+// MarshalWithExtra encodes v and adds the extra fields that are not already part of the encoded object.
+func MarshalWithExtra(v interface{}, extra ExtraFields) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	for name, value := range extra {
+		if _, ok := all[name]; !ok {
+			all[name] = value
+		}
+	}
+	return json.Marshal(all)
+}
+
+// jsonFieldNames returns the JSON names of the exported fields of a struct type.
+func jsonFieldNames(t reflect.Type) []string {
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			tagName := strings.Split(tag, ",")[0]
+			if tagName == "-" {
+				continue
+			}
+			if tagName != "" {
+				name = tagName
+			}
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// PrivateStateGetter reads resource private state, such as the Private field of resource requests.
+type PrivateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// GetPrivateExtraFields reads the extra API fields stored in resource private state.
+func GetPrivateExtraFields(ctx context.Context, private PrivateStateGetter) (ExtraFields, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, ExtraFieldsPrivateStateKey)
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+
+	var extra ExtraFields
+	if err := json.Unmarshal(data, &extra); err != nil {
+		diags.AddError("Unable to Read Private State", "Stored API fields could not be decoded: "+err.Error())
+	}
+	return extra, diags
+}
+
+// SetPrivateExtraFields stores extra API fields in resource private state, or clears them when there are none.
+func SetPrivateExtraFields(ctx context.Context, private privateStateSetter, extra ExtraFields) diag.Diagnostics {
+	if len(extra) == 0 {
+		return private.SetKey(ctx, ExtraFieldsPrivateStateKey, nil)
+	}
+
+	data, err := json.Marshal(extra)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Unable to Write Private State", "API fields could not be encoded: "+err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, ExtraFieldsPrivateStateKey, data)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LifecycleGates are the lifecycle gates known to the provider. They are the valid gates when the backend does not
+// enumerate its own (see ReadLifecycleGates).
+var LifecycleGates = []string{"entry", "exit", "release"}
+
+// LifecycleGatesEndpoint returns the lifecycle gates the backend supports. Not available on all backend versions.
+const LifecycleGatesEndpoint = DefaultAPIPathPrefix + "/lifecycle/gates"
+
+// lifecycleGatesAPIModel is the response shape for GET unifiedpolicy/api/v1/lifecycle/gates.
+type lifecycleGatesAPIModel struct {
+	Gates []string `json:"gates"`
+}
+
+// ReadLifecycleGates returns the lifecycle gates the backend supports. It falls back to LifecycleGates when the
+// backend cannot be asked or does not enumerate its gates, e.g. on versions without the endpoint.
+func ReadLifecycleGates(ctx context.Context, m ProviderMetadata) []string {
+	if m.Client == nil {
+		return LifecycleGates
+	}
+
+	var result lifecycleGatesAPIModel
+	response, err := m.Client.R().
+		SetContext(ctx).
+		SetResult(&result).
+		Get(m.Endpoint(LifecycleGatesEndpoint))
+	if err != nil || response.IsError() || len(result.Gates) == 0 {
+		fields := map[string]interface{}{"fallback": LifecycleGates}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = response.StatusCode()
+		}
+		tflog.Debug(ctx, "Backend lifecycle gates not available", fields)
+		return LifecycleGates
+	}
+	return result.Gates
+}
+
+// ValidLifecycleGates returns the lifecycle gates read from the backend when the provider was configured, and
+// LifecycleGates otherwise.
+func (m ProviderMetadata) ValidLifecycleGates() []string {
+	if len(m.LifecycleGates) == 0 {
+		return LifecycleGates
+	}
+	return m.LifecycleGates
+}
+
+// ValidateLifecycleGate returns an error message when gate is not one of gates, and an empty string otherwise.
+func ValidateLifecycleGate(gates []string, gate string) string {
+	if slices.Contains(gates, gate) {
+		return ""
+	}
+	return fmt.Sprintf("Lifecycle gate '%s' is not valid. Must be one of: '%s'.", gate, strings.Join(gates, "', '"))
+}
@@ -0,0 +1,96 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+)
+
+func TestReadLifecycleGates(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   []string
+	}{
+		{name: "backend gates", status: http.StatusOK, body: `{"gates":["entry","exit","release","promotion"]}`, want: []string{"entry", "exit", "release", "promotion"}},
+		{name: "endpoint not available", status: http.StatusNotFound, body: `{"errors":[{"code":"NOT_FOUND","message":"not found"}]}`, want: unifiedpolicy.LifecycleGates},
+		{name: "server error", status: http.StatusInternalServerError, body: `{}`, want: unifiedpolicy.LifecycleGates},
+		{name: "empty list", status: http.StatusOK, body: `{"gates":[]}`, want: unifiedpolicy.LifecycleGates},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/"+unifiedpolicy.LifecycleGatesEndpoint {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			m := unifiedpolicy.ProviderMetadata{
+				ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+			}
+			if got := unifiedpolicy.ReadLifecycleGates(context.Background(), m); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestReadLifecycleGates_unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	m := unifiedpolicy.ProviderMetadata{
+		ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+	}
+	if got := unifiedpolicy.ReadLifecycleGates(context.Background(), m); !reflect.DeepEqual(got, unifiedpolicy.LifecycleGates) {
+		t.Errorf("expected fallback %v, got %v", unifiedpolicy.LifecycleGates, got)
+	}
+}
+
+func TestValidLifecycleGates(t *testing.T) {
+	if got := (unifiedpolicy.ProviderMetadata{}).ValidLifecycleGates(); !reflect.DeepEqual(got, unifiedpolicy.LifecycleGates) {
+		t.Errorf("expected fallback %v for an unconfigured provider, got %v", unifiedpolicy.LifecycleGates, got)
+	}
+	backend := []string{"entry", "promotion"}
+	if got := (unifiedpolicy.ProviderMetadata{LifecycleGates: backend}).ValidLifecycleGates(); !reflect.DeepEqual(got, backend) {
+		t.Errorf("expected backend gates %v, got %v", backend, got)
+	}
+}
+
+func TestValidateLifecycleGate(t *testing.T) {
+	if msg := unifiedpolicy.ValidateLifecycleGate(unifiedpolicy.LifecycleGates, "release"); msg != "" {
+		t.Errorf("expected release to be valid, got %q", msg)
+	}
+	if msg := unifiedpolicy.ValidateLifecycleGate(unifiedpolicy.LifecycleGates, "promotion"); msg == "" {
+		t.Error("expected promotion to be invalid with the fallback gates")
+	}
+	if msg := unifiedpolicy.ValidateLifecycleGate([]string{"entry", "promotion"}, "promotion"); msg != "" {
+		t.Errorf("expected promotion to be valid when the backend supports it, got %q", msg)
+	}
+}
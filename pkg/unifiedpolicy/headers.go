@@ -0,0 +1,35 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy
+
+import "regexp"
+
+// ReservedHeaders cannot be set through the provider custom_headers: they carry credentials or are managed by the
+// HTTP client, and overriding them would bypass authentication or corrupt requests.
+var ReservedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"X-JFrog-Art-Api",
+	"Cookie",
+	"Host",
+	"Content-Length",
+	"Transfer-Encoding",
+}
+
+// HeaderNameRegex matches a well-formed HTTP header name (an RFC 9110 token).
+var HeaderNameRegex = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+// HeaderValueRegex matches a header value without line breaks, which would otherwise inject extra headers.
+var HeaderValueRegex = regexp.MustCompile(`^[^\r\n]*$`)
@@ -0,0 +1,73 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/provider"
+)
+
+func TestCustomHeadersValidation(t *testing.T) {
+	resp := &fwprovider.SchemaResponse{}
+	provider.Framework()().Schema(context.Background(), fwprovider.SchemaRequest{}, resp)
+	validators := resp.Schema.Attributes["custom_headers"].(schema.MapAttribute).Validators
+
+	tests := []struct {
+		name        string
+		headers     map[string]string
+		expectError bool
+	}{
+		{name: "tenant header", headers: map[string]string{"X-Tenant-ID": "acme"}},
+		{name: "several headers", headers: map[string]string{"X-Tenant-ID": "acme", "X-Route": "eu-west"}},
+		{name: "space in name", headers: map[string]string{"X Tenant": "acme"}, expectError: true},
+		{name: "colon in name", headers: map[string]string{"X-Tenant:": "acme"}, expectError: true},
+		{name: "empty name", headers: map[string]string{"": "acme"}, expectError: true},
+		{name: "authorization", headers: map[string]string{"Authorization": "Bearer x"}, expectError: true},
+		{name: "authorization in lower case", headers: map[string]string{"authorization": "Bearer x"}, expectError: true},
+		{name: "api key", headers: map[string]string{"X-JFrog-Art-Api": "key"}, expectError: true},
+		{name: "line break in value", headers: map[string]string{"X-Tenant-ID": "acme\r\nAuthorization: Bearer x"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elements := map[string]attr.Value{}
+			for name, value := range tt.headers {
+				elements[name] = types.StringValue(value)
+			}
+			req := validator.MapRequest{
+				Path:        path.Root("custom_headers"),
+				ConfigValue: types.MapValueMust(types.StringType, elements),
+			}
+
+			hasError := false
+			for _, v := range validators {
+				validateResp := &validator.MapResponse{}
+				v.ValidateMap(context.Background(), req, validateResp)
+				hasError = hasError || validateResp.Diagnostics.HasError()
+			}
+			if hasError != tt.expectError {
+				t.Errorf("expected error %v, got %v", tt.expectError, hasError)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy
+
+// Field limits enforced by the Unified Policy API. Resource schemas validate against these constants so that
+// templates, rules and lifecycle policies share the same limits.
+const (
+	// MaxNameLength is the maximum length of a template, rule or lifecycle policy name.
+	MaxNameLength = 255
+	// MaxDescriptionLength is the maximum length of a template, rule or lifecycle policy description.
+	MaxDescriptionLength = 2048
+	// MaxVersionLength is the maximum length of a template version.
+	MaxVersionLength = 100
+	// MaxParameterNameLength is the maximum length of a template or rule parameter name.
+	MaxParameterNameLength = 100
+	// MaxParameters is the maximum number of parameters of a template, and therefore of a rule.
+	MaxParameters = 20
+	// MinPolicyPriority and MaxPolicyPriority bound the priority of a lifecycle policy.
+	MinPolicyPriority = 0
+	MaxPolicyPriority = 1000
+	// DefaultMaxRegoChars is the maximum length of template Rego code accepted by the API unless the
+	// provider attribute `max_rego_chars` raises it.
+	DefaultMaxRegoChars = 65536
+	// DefaultMaxRulesPerPolicy is the number of rules per lifecycle policy accepted by the API unless the provider
+	// attribute `max_rules_per_policy` raises it.
+	DefaultMaxRulesPerPolicy = 1
+)
@@ -0,0 +1,167 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+func resourceSchema(t *testing.T, r fwresource.Resource) schema.Schema {
+	resp := &fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+func validateString(validators []validator.String, value string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, v := range validators {
+		resp := &validator.StringResponse{}
+		v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("test"), ConfigValue: types.StringValue(value)}, resp)
+		diags.Append(resp.Diagnostics...)
+	}
+	return diags
+}
+
+func validateListSize(validators []validator.List, size int) diag.Diagnostics {
+	elements := make([]attr.Value, size)
+	for i := range elements {
+		elements[i] = types.StringValue(fmt.Sprintf("p%d", i))
+	}
+	var diags diag.Diagnostics
+	for _, v := range validators {
+		resp := &validator.ListResponse{}
+		v.ValidateList(context.Background(), validator.ListRequest{Path: path.Root("test"), ConfigValue: types.ListValueMust(types.StringType, elements)}, resp)
+		diags.Append(resp.Diagnostics...)
+	}
+	return diags
+}
+
+func TestFieldLimits(t *testing.T) {
+	templateSchema := resourceSchema(t, unifiedpolicyresource.NewTemplateResource())
+	ruleSchema := resourceSchema(t, unifiedpolicyresource.NewRuleResource())
+	policySchema := resourceSchema(t, unifiedpolicyresource.NewLifecyclePolicyResource())
+
+	stringValidators := func(s schema.Schema, name string) []validator.String {
+		return s.Attributes[name].(schema.StringAttribute).Validators
+	}
+	parameterNameValidators := func(s schema.Schema) []validator.String {
+		parameters := s.Attributes["parameters"].(schema.ListNestedAttribute)
+		return parameters.NestedObject.Attributes["name"].(schema.StringAttribute).Validators
+	}
+	listValidators := func(s schema.Schema, name string) []validator.List {
+		return s.Attributes[name].(schema.ListNestedAttribute).Validators
+	}
+
+	stringLimits := []struct {
+		name       string
+		validators []validator.String
+		limit      int
+	}{
+		{"template name", stringValidators(templateSchema, "name"), unifiedpolicy.MaxNameLength},
+		{"rule name", stringValidators(ruleSchema, "name"), unifiedpolicy.MaxNameLength},
+		{"policy name", stringValidators(policySchema, "name"), unifiedpolicy.MaxNameLength},
+		{"template description", stringValidators(templateSchema, "description"), unifiedpolicy.MaxDescriptionLength},
+		{"rule description", stringValidators(ruleSchema, "description"), unifiedpolicy.MaxDescriptionLength},
+		{"policy description", stringValidators(policySchema, "description"), unifiedpolicy.MaxDescriptionLength},
+		{"template version", stringValidators(templateSchema, "version"), unifiedpolicy.MaxVersionLength},
+		{"template parameter name", parameterNameValidators(templateSchema), unifiedpolicy.MaxParameterNameLength},
+		{"rule parameter name", parameterNameValidators(ruleSchema), unifiedpolicy.MaxParameterNameLength},
+	}
+
+	for _, tt := range stringLimits {
+		t.Run(tt.name, func(t *testing.T) {
+			if diags := validateString(tt.validators, strings.Repeat("a", tt.limit)); diags.HasError() {
+				t.Errorf("expected %d characters to be accepted, got %v", tt.limit, diags)
+			}
+			if diags := validateString(tt.validators, strings.Repeat("a", tt.limit+1)); !diags.HasError() {
+				t.Errorf("expected %d characters to be rejected", tt.limit+1)
+			}
+		})
+	}
+
+	listLimits := []struct {
+		name       string
+		validators []validator.List
+		limit      int
+	}{
+		{"template parameters", listValidators(templateSchema, "parameters"), unifiedpolicy.MaxParameters},
+		{"rule parameters", listValidators(ruleSchema, "parameters"), unifiedpolicy.MaxParameters},
+	}
+
+	for _, tt := range listLimits {
+		t.Run(tt.name, func(t *testing.T) {
+			if diags := validateListSize(tt.validators, tt.limit); diags.HasError() {
+				t.Errorf("expected %d elements to be accepted, got %v", tt.limit, diags)
+			}
+			if diags := validateListSize(tt.validators, tt.limit+1); !diags.HasError() {
+				t.Errorf("expected %d elements to be rejected", tt.limit+1)
+			}
+		})
+	}
+}
+
+func TestPolicyPriorityLimits(t *testing.T) {
+	policySchema := resourceSchema(t, unifiedpolicyresource.NewLifecyclePolicyResource())
+	validators := policySchema.Attributes["priority"].(schema.Int64Attribute).Validators
+
+	validate := func(value int64) diag.Diagnostics {
+		var diags diag.Diagnostics
+		for _, v := range validators {
+			resp := &validator.Int64Response{}
+			v.ValidateInt64(context.Background(), validator.Int64Request{Path: path.Root("priority"), ConfigValue: types.Int64Value(value)}, resp)
+			diags.Append(resp.Diagnostics...)
+		}
+		return diags
+	}
+
+	for _, value := range []int64{unifiedpolicy.MinPolicyPriority, unifiedpolicy.MaxPolicyPriority} {
+		if diags := validate(value); diags.HasError() {
+			t.Errorf("expected priority %d to be accepted, got %v", value, diags)
+		}
+	}
+	for _, value := range []int64{unifiedpolicy.MinPolicyPriority - 1, unifiedpolicy.MaxPolicyPriority + 1} {
+		if diags := validate(value); !diags.HasError() {
+			t.Errorf("expected priority %d to be rejected", value)
+		}
+	}
+}
+
+func TestMaxRegoCharsLimit(t *testing.T) {
+	code := "package unifiedpolicy\n\nallow := true\n"
+	padding := "#" + strings.Repeat("x", unifiedpolicy.DefaultMaxRegoChars-len(code)-2) + "\n"
+
+	if result := unifiedpolicyresource.ValidateRegoCode(code+padding, false, unifiedpolicy.DefaultMaxRegoChars, unifiedpolicyresource.RegoVersionV0, nil); result.Error != "" {
+		t.Errorf("expected %d characters to be accepted, got %q", len(code+padding), result.Error)
+	}
+	if result := unifiedpolicyresource.ValidateRegoCode(code+padding+"#", false, unifiedpolicy.DefaultMaxRegoChars, unifiedpolicyresource.RegoVersionV0, nil); result.Error == "" {
+		t.Errorf("expected %d characters to be rejected", len(code+padding)+1)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PrefixName returns the name sent to the API for a name configured on a resource: prefix (provider attribute
+// `name_prefix`) followed by the name. The prefix is used as is, so it carries its own separator (e.g. `teamA/`).
+func PrefixName(prefix, name string) string {
+	return prefix + name
+}
+
+// StripNamePrefix reverses PrefixName for a name returned by the API. Names without the prefix, e.g. of objects
+// created before it was configured, are returned unchanged.
+func StripNamePrefix(prefix, name string) string {
+	return strings.TrimPrefix(name, prefix)
+}
+
+// PrefixedNameLengthError returns an error message when the name sent to the API for name exceeds MaxNameLength
+// with the prefix added, and an empty string otherwise.
+func PrefixedNameLengthError(prefix, name string) string {
+	prefixed := PrefixName(prefix, name)
+	if len(prefixed) <= MaxNameLength {
+		return ""
+	}
+	return "With the provider name_prefix '" + prefix + "', the name sent to the API is " + strconv.Itoa(len(prefixed)) +
+		" characters long; it must be at most " + strconv.Itoa(MaxNameLength) + ". Use a name of at most " +
+		strconv.Itoa(MaxNameLength-len(prefix)) + " characters."
+}
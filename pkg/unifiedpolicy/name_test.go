@@ -0,0 +1,66 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+)
+
+func TestNamePrefix_roundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		value  string
+		sent   string
+	}{
+		{name: "prefixed", prefix: "teamA/", value: "block-critical", sent: "teamA/block-critical"},
+		{name: "no prefix", prefix: "", value: "block-critical", sent: "block-critical"},
+		{name: "name starting with prefix", prefix: "teamA/", value: "teamA/nested", sent: "teamA/teamA/nested"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent := unifiedpolicy.PrefixName(tt.prefix, tt.value)
+			if sent != tt.sent {
+				t.Errorf("expected %q to be sent, got %q", tt.sent, sent)
+			}
+			if got := unifiedpolicy.StripNamePrefix(tt.prefix, sent); got != tt.value {
+				t.Errorf("expected %q after round trip, got %q", tt.value, got)
+			}
+		})
+	}
+}
+
+func TestStripNamePrefix_unprefixed(t *testing.T) {
+	if got := unifiedpolicy.StripNamePrefix("teamA/", "created-outside-terraform"); got != "created-outside-terraform" {
+		t.Errorf("expected name without the prefix to be unchanged, got %q", got)
+	}
+}
+
+func TestPrefixedNameLengthError(t *testing.T) {
+	prefix := "teamA/"
+	if msg := unifiedpolicy.PrefixedNameLengthError(prefix, strings.Repeat("a", unifiedpolicy.MaxNameLength-len(prefix))); msg != "" {
+		t.Errorf("expected a name at the limit to be accepted, got %q", msg)
+	}
+	if msg := unifiedpolicy.PrefixedNameLengthError(prefix, strings.Repeat("a", unifiedpolicy.MaxNameLength-len(prefix)+1)); msg == "" {
+		t.Error("expected an error for a name over the limit with the prefix")
+	}
+	if msg := unifiedpolicy.PrefixedNameLengthError("", strings.Repeat("a", unifiedpolicy.MaxNameLength)); msg != "" {
+		t.Errorf("expected a name at the limit without prefix to be accepted, got %q", msg)
+	}
+}
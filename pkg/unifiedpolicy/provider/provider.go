@@ -19,11 +19,19 @@ import (
 	"crypto/tls"
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,6 +40,7 @@ import (
 	"github.com/jfrog/terraform-provider-shared/client"
 	"github.com/jfrog/terraform-provider-shared/util"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
 	unifiedpolicy_datasource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/datasource"
 	unifiedpolicy_resource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
 )
@@ -52,9 +61,41 @@ type UnifiedPolicyProvider struct{}
 
 // UnifiedPolicyProviderModel describes the provider data model.
 type UnifiedPolicyProviderModel struct {
-	Url         types.String `tfsdk:"url"`
-	AccessToken types.String `tfsdk:"access_token"`
-	ApiKey      types.String `tfsdk:"api_key"`
+	Url                           types.String  `tfsdk:"url"`
+	AccessToken                   types.String  `tfsdk:"access_token"`
+	ApiKey                        types.String  `tfsdk:"api_key"`
+	AllowScannersWithNoop         types.Bool    `tfsdk:"allow_scanners_with_noop"`
+	APIPathPrefix                 types.String  `tfsdk:"api_path_prefix"`
+	ApplicationLabelKeyPattern    types.String  `tfsdk:"application_label_key_pattern"`
+	CustomHeaders                 types.Map     `tfsdk:"custom_headers"`
+	DefaultApplicationKeys        types.List    `tfsdk:"default_application_keys"`
+	DefaultPolicyMode             types.String  `tfsdk:"default_policy_mode"`
+	DefaultProjectKey             types.String  `tfsdk:"default_project_key"`
+	DeferRegoValidation           types.Bool    `tfsdk:"defer_rego_validation"`
+	DescriptionPrefix             types.String  `tfsdk:"description_prefix"`
+	SystemTemplateHandling        types.String  `tfsdk:"system_template_handling"`
+	DisableAllPolicies            types.Bool    `tfsdk:"disable_all_policies"`
+	ExpandRegoPath                types.Bool    `tfsdk:"expand_rego_path"`
+	ExpectedInputKeys             types.Map     `tfsdk:"expected_input_keys"`
+	ExtraAllowedRegoOperations    types.List    `tfsdk:"extra_allowed_rego_operations"`
+	IgnoreDescriptionChanges      types.Bool    `tfsdk:"ignore_description_changes"`
+	MaxRegoChars                  types.Int64   `tfsdk:"max_rego_chars"`
+	MaxRulesPerPolicy             types.Int64   `tfsdk:"max_rules_per_policy"`
+	NamePrefix                    types.String  `tfsdk:"name_prefix"`
+	NotFoundStatusCodes           types.List    `tfsdk:"not_found_status_codes"`
+	OnConflict                    types.String  `tfsdk:"on_conflict"`
+	RegoBaseDir                   types.String  `tfsdk:"rego_base_dir"`
+	RequireDefaultDecision        types.Bool    `tfsdk:"require_default_decision"`
+	RequireScannersForSecurity    types.Bool    `tfsdk:"require_scanners_for_security"`
+	RequiredStageGates            types.Map     `tfsdk:"required_stage_gates"`
+	RetryJitter                   types.Float64 `tfsdk:"retry_jitter"`
+	RuleParametersInTemplateOrder types.Bool    `tfsdk:"rule_parameters_in_template_order"`
+	SortParametersByName          types.Bool    `tfsdk:"sort_parameters_by_name"`
+	StrictParameterUsage          types.Bool    `tfsdk:"strict_parameter_usage"`
+	UseETags                      types.Bool    `tfsdk:"use_etags"`
+	ValidateBeforeEnforce         types.Bool    `tfsdk:"validate_before_enforce"`
+	ValidateScopeKeys             types.Bool    `tfsdk:"validate_scope_keys"`
+	VersionFormatRegex            types.String  `tfsdk:"version_format_regex"`
 }
 
 func (p *UnifiedPolicyProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -86,6 +127,297 @@ func (p *UnifiedPolicyProvider) Schema(ctx context.Context, req provider.SchemaR
 				Optional:           true,
 				Sensitive:          true,
 			},
+			"allow_scanners_with_noop": schema.BoolAttribute{
+				Description: "When true, `unifiedpolicy_template` resources with `data_source_type = \"noop\"` may list `scanners`. " +
+					"By default such templates are rejected at plan time, since a noop template receives no scanner data; " +
+					"enable this only for backends that accept them. Default: `false`.",
+				Optional: true,
+			},
+			"api_path_prefix": schema.StringAttribute{
+				Description: "Path under the platform URL where the Unified Policy API is mounted. All template, rule and policy endpoints are derived from it. " +
+					"Only needed behind a reverse proxy or for non-standard deployments. Must be a path only (no scheme or host). Default: `" + unifiedpolicy.DefaultAPIPathPrefix + "`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^/?[^/:?#][^:?#]*$`),
+						"must be a URL path without scheme, host, query or fragment, e.g. 'unifiedpolicy/api/v1'",
+					),
+				},
+			},
+			"application_label_key_pattern": schema.StringAttribute{
+				Description: "Regular expression (Go RE2 syntax) that the `key` of every `application_labels` entry in the scope of " +
+					"`unifiedpolicy_lifecycle_policy` resources must match, e.g. `^[a-z0-9_.-]+$` for lowercase keys without spaces. " +
+					"Keys that do not match are rejected at plan time, since labels with a key the platform does not accept never match an application. " +
+					"Anchor the pattern with `^` and `$` to match whole keys. Default: `" + unifiedpolicy.DefaultApplicationLabelKeyPattern + "` (any non-empty key).",
+				Optional: true,
+				Validators: []validator.String{
+					regexPatternValidator{},
+				},
+			},
+			"custom_headers": schema.MapAttribute{
+				Description: "Additional HTTP headers sent with every request to the platform, e.g. `X-Tenant-ID` for gateways with header-based routing. " +
+					"Header names must be well-formed. Headers carrying credentials or managed by the HTTP client (`" +
+					strings.Join(unifiedpolicy.ReservedHeaders, "`, `") + "`) cannot be set.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(unifiedpolicy.HeaderNameRegex, "must be a valid HTTP header name"),
+						stringvalidator.NoneOfCaseInsensitive(unifiedpolicy.ReservedHeaders...),
+					),
+					mapvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(unifiedpolicy.HeaderValueRegex, "must not contain line breaks"),
+					),
+				},
+			},
+			"default_application_keys": schema.ListAttribute{
+				Description: "Application keys of `unifiedpolicy_lifecycle_policy` resources with scope `type = \"application\"` that set neither " +
+					"`application_keys` nor `application_labels` in the scope block. Keys set on the resource always take precedence, and the default " +
+					"is never applied to project scopes. When not set, application scopes need `application_keys` or `application_labels` on every policy.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
+			"default_policy_mode": schema.StringAttribute{
+				Description: "Enforcement mode (`block` or `warning`) of `unifiedpolicy_lifecycle_policy` resources that do not set `mode`. " +
+					"A `mode` set on the resource always takes precedence. Changing this value updates every policy that relies on it, " +
+					"e.g. to switch a rollout from `warning` to `block`. When not set, `mode` is required on every policy.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(unifiedpolicy.PolicyModeBlock, unifiedpolicy.PolicyModeWarning),
+				},
+			},
+			"default_project_key": schema.StringAttribute{
+				Description: "Project key of `unifiedpolicy_lifecycle_policy` resources with scope `type = \"project\"` that do not set `project_keys` " +
+					"in the scope block. `project_keys` set on the resource always take precedence, and the default is never applied to application scopes. " +
+					"When not set, `project_keys` is required on every project-scoped policy.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"defer_rego_validation": schema.BoolAttribute{
+				Description: "When true, a `rego` file of a `unifiedpolicy_template` that does not exist at plan time does not fail the plan; " +
+					"the file is read and fully validated when the template is created or updated, for workflows that generate the file during apply. " +
+					"Files that exist at plan time are validated as usual. The tradeoff: an invalid or still missing file is only reported by apply, " +
+					"possibly after other resources were changed, and the plan cannot show whether the Rego code of a missing file changed. Default: `false`.",
+				Optional: true,
+			},
+			"description_prefix": schema.StringAttribute{
+				Description: "Text prepended, followed by a space, to the `description` of every `unifiedpolicy_template`, `unifiedpolicy_rule` " +
+					"and `unifiedpolicy_lifecycle_policy` sent to the API, e.g. `[managed-by-terraform]` to mark objects managed by Terraform. " +
+					"The prefix is removed again when reading, so state keeps the configured description. Empty descriptions are sent without the prefix. " +
+					"Existing objects receive the prefix when they are next updated. Keep it short: the prefixed description must fit the " +
+					"API limit of " + strconv.Itoa(unifiedpolicy.MaxDescriptionLength) + " characters.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"disable_all_policies": schema.BoolAttribute{
+				Description: "Emergency control: when true, every `unifiedpolicy_lifecycle_policy` resource is disabled on the platform on the next apply, " +
+					"regardless of its `enabled` value, and each policy configured as enabled reports a warning. Bind it to a variable to switch " +
+					"off Terraform-managed enforcement fleet-wide during an incident, e.g. `terraform apply -var disable_all_policies=true`. " +
+					"`enabled` keeps its configured value; the computed `effective_enabled` shows the state on the platform. When set back to false, " +
+					"the next apply restores the configured `enabled` values. Default: `false`.",
+				Optional: true,
+			},
+			"expand_rego_path": schema.BoolAttribute{
+				Description: "When true, environment variable references (`$VAR`, `${VAR}`) and a leading `~` in the `rego` path of " +
+					"`unifiedpolicy_template` resources are expanded before the path is validated and read; a relative expanded path is resolved like any relative `rego` path. " +
+					"The path is stored in state as written. Default: `false`.",
+				Optional: true,
+			},
+			"expected_input_keys": schema.MapAttribute{
+				Description: "Maps template data source types (`noop`, `evidence`, `xray`) to the top-level `input` keys the Rego code of " +
+					"`unifiedpolicy_template` resources with that `data_source_type` is expected to read, e.g. `{ evidence = [\"evidence\"] }`. " +
+					"At plan time, a warning names each other top-level key the Rego code reads, such as `input.xray` in an evidence template, " +
+					"which usually means the template was written for another data source. `parameters` is always expected. " +
+					"Data source types not listed are not checked. No check is made when not set.",
+				ElementType: types.ListType{ElemType: types.StringType},
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf("noop", "evidence", "xray")),
+				},
+			},
+			"extra_allowed_rego_operations": schema.ListAttribute{
+				Description: "Rego operations (OPA built-in function names, e.g. `http.send`) to allow in `unifiedpolicy_template` Rego code in addition " +
+					"to the built-in list of valid Rego operations, for built-ins the backend supports before the provider lists them. " +
+					"The list only adds operations; built-in operations cannot be removed. Disallowed operation errors name the operations allowed here. " +
+					"Default: none.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"ignore_description_changes": schema.BoolAttribute{
+				Description: "When true, a change to `description` alone does not produce a plan diff for `unifiedpolicy_template`, `unifiedpolicy_rule` " +
+					"and `unifiedpolicy_lifecycle_policy` resources, so apply does not update them; the previous description is kept in state. " +
+					"Changes to any other attribute are planned as usual, including the new description. Default: `false`.",
+				Optional: true,
+			},
+			"max_rego_chars": schema.Int64Attribute{
+				Description: "Maximum length, in characters, of the Rego code of a `unifiedpolicy_template`. Code is validated against it at plan time. " +
+					"Raise it only if your Unified Policy version accepts larger policies. Default: `" + strconv.Itoa(unifiedpolicy.DefaultMaxRegoChars) + "`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_rules_per_policy": schema.Int64Attribute{
+				Description: "Maximum number of `rule_ids` of a `unifiedpolicy_lifecycle_policy`, validated at plan time. " +
+					"Raise it only if your Unified Policy version accepts several rules per policy. Default: `" + strconv.Itoa(unifiedpolicy.DefaultMaxRulesPerPolicy) + "`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Text prepended to the `name` of every `unifiedpolicy_template`, `unifiedpolicy_rule` and `unifiedpolicy_lifecycle_policy` " +
+					"sent to the API, e.g. `teamA/` to namespace the objects of a team sharing a tenant with others. The prefix is used as is, so " +
+					"include a separator. It is removed again when reading, so state and configuration keep the unprefixed name. Existing objects " +
+					"are renamed when they are next updated. The prefixed name must fit the API limit of " + strconv.Itoa(unifiedpolicy.MaxNameLength) +
+					" characters, which is checked at plan time.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, unifiedpolicy.MaxNameLength-1),
+				},
+			},
+			"not_found_status_codes": schema.ListAttribute{
+				Description: "HTTP status codes (400-599) that mean a resource no longer exists when `unifiedpolicy_template`, `unifiedpolicy_rule` " +
+					"and `unifiedpolicy_lifecycle_policy` resources are refreshed; the resource is then removed from state and planned for creation. " +
+					"Set it when a gateway signals deleted objects differently, e.g. `[404, 410]` for gateways that return 410 Gone. " +
+					"Codes not listed are reported as errors, so include 404 unless the backend never returns it for deleted objects. Default: `[404]`.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueInt64sAre(
+						int64validator.Between(400, 599),
+					),
+				},
+			},
+			"on_conflict": schema.StringAttribute{
+				Description: "What to do when creating a `unifiedpolicy_template`, `unifiedpolicy_rule` or `unifiedpolicy_lifecycle_policy` fails " +
+					"because an object with the same name already exists, e.g. after state was lost. `error` fails the apply. " +
+					"`adopt` brings the existing object into state when it matches the configuration: every field sent on create has the same " +
+					"value in the existing object, lists in the same order; fields the API adds, such as IDs and timestamps, are ignored. " +
+					"Otherwise the apply fails and lists the differing fields. `import` also takes over an existing object that does not match, " +
+					"by updating it to the configuration. Default: `error`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(unifiedpolicy.OnConflictError, unifiedpolicy.OnConflictAdopt, unifiedpolicy.OnConflictImport),
+				},
+			},
+			"rego_base_dir": schema.StringAttribute{
+				Description: "Full (absolute) path of an existing directory that the `rego` files of `unifiedpolicy_template` resources, and the files " +
+					"validated by the `unifiedpolicy_rego_validation`, `unifiedpolicy_rego_files` and `unifiedpolicy_policy_preflight` data sources, " +
+					"must be within, e.g. the checkout directory in shared CI, so that configurations cannot read arbitrary files. Relative paths are " +
+					"resolved against it instead of the Terraform working directory. Paths are cleaned " +
+					"and their symlinks resolved before the check; paths outside the directory are rejected at plan time and never read by the provider. " +
+					"`terraform validate` runs without the provider configuration and still reads the files to validate them. " +
+					"No restriction is applied when not set.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"require_default_decision": schema.BoolAttribute{
+				Description: "When true, the Rego code of `unifiedpolicy_template` resources must declare a default for the `allow` decision " +
+					"(e.g. `default allow = false`), so that the policy never evaluates to an undefined decision. Templates without it are rejected " +
+					"at plan time. Default: `false`.",
+				Optional: true,
+			},
+			"require_scanners_for_security": schema.BoolAttribute{
+				Description: "When true, `unifiedpolicy_template` resources with `category = \"security\"` and `data_source_type = \"evidence\"` " +
+					"must list at least one of `scanners`, for organizations that require security templates to declare the scanner data they evaluate. " +
+					"Such templates without scanners are rejected at plan time. Default: `false`.",
+				Optional: true,
+			},
+			"required_stage_gates": schema.MapAttribute{
+				Description: "Maps lifecycle stage keys to the gate (e.g. `entry`, `exit` or `release`) that `unifiedpolicy_lifecycle_policy` actions on that stage must use, " +
+					"e.g. `{ production = \"release\" }` to require the release gate on the terminal stage. Checked at plan time. " +
+					"Gates must be supported by the backend; when it does not enumerate its gates, they must be one of: " + strings.Join(unifiedpolicy.LifecycleGates, ", ") + ". " +
+					"Stage keys not listed are not constrained. No constraint is applied when not set.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"retry_jitter": schema.Float64Attribute{
+				Description: "Fraction (0 to 1) of the exponential retry backoff that is randomized, so that many resources retrying after the same " +
+					"backend failure do not retry in lockstep. `1` waits a random time between the base wait and the exponential delay (full jitter), " +
+					"`0` always waits the full exponential delay. Default: `1`.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+			},
+			"rule_parameters_in_template_order": schema.BoolAttribute{
+				Description: "When true, `unifiedpolicy_rule` parameters are stored in the order their template declares them, followed by any parameters " +
+					"the template does not declare, instead of the order returned by the API. The template is read once per plan, apply and refresh of each rule. " +
+					"Rule `parameters` must then be configured in that order; planning fails otherwise and reports the expected order. " +
+					"Cannot be combined with `sort_parameters_by_name`. Default: `false`.",
+				Optional: true,
+			},
+			"sort_parameters_by_name": schema.BoolAttribute{
+				Description: "When true, `parameters` of `unifiedpolicy_template` and `unifiedpolicy_rule` resources are sent to the API and stored in state " +
+					"sorted by `name`, so their order never depends on the backend. Configurations must then list parameters in alphabetical order of name; " +
+					"any other order is reported as an error at plan time. When false, the configured order is preserved. Default: `false`.",
+				Optional: true,
+			},
+			"strict_parameter_usage": schema.BoolAttribute{
+				Description: "When true, mismatches between the declared `parameters` of a `unifiedpolicy_template` and the `input.parameters` " +
+					"references of its Rego code are reported as errors instead of warnings, see `validate_parameter_usage` on the resource. Default: `false`.",
+				Optional: true,
+			},
+			"system_template_handling": schema.StringAttribute{
+				Description: "What to do when a `unifiedpolicy_template` resource reads a system (`is_custom = false`) template, e.g. after importing one. " +
+					"System templates cannot be managed as resources; use the `unifiedpolicy_template` data source instead. " +
+					"`error` fails the import or refresh, `warn` only reports a warning. Default: `error`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(unifiedpolicy.SystemTemplateHandlingError, unifiedpolicy.SystemTemplateHandlingWarn),
+				},
+			},
+			"use_etags": schema.BoolAttribute{
+				Description: "When true, `unifiedpolicy_lifecycle_policy` resources keep the `ETag` returned by the API and send it as `If-Match` on update, " +
+					"so an update fails with a conflict error instead of overwriting a policy changed by someone else since the last refresh. " +
+					"Requires a backend that returns ETags; without one, updates behave as if this were false. Default: `false`.",
+				Optional: true,
+			},
+			"validate_before_enforce": schema.BoolAttribute{
+				Description: "When true, before a `unifiedpolicy_lifecycle_policy` that is enabled in `block` mode is created or updated, " +
+					"each of its `rule_ids` is read to confirm that the rule and its template exist and that the template Rego code stored " +
+					"on the backend parses and uses only allowed operations, so enforcement is never activated on a broken rule. " +
+					"Costs two extra API calls per rule on every create and update of such a policy. Default: `false`.",
+				Optional: true,
+			},
+			"validate_scope_keys": schema.BoolAttribute{
+				Description: "When true, the `project_keys` and `application_keys` of `unifiedpolicy_lifecycle_policy` scopes are checked at plan time " +
+					"against the projects and applications on the platform (see the `unifiedpolicy_available_projects` and " +
+					"`unifiedpolicy_available_applications` data sources), so a typo fails the plan instead of the apply. " +
+					"Each plan of a scoped policy lists the projects or applications once. Planning fails with a clear error when the platform " +
+					"does not expose the list, e.g. application scopes on a platform without AppTrust. Default: `false`.",
+				Optional: true,
+			},
+			"version_format_regex": schema.StringAttribute{
+				Description: "Regular expression (Go RE2 syntax) that the `version` of every `unifiedpolicy_template` resource must match, " +
+					"e.g. `^\\d+\\.\\d+\\.\\d+$` to require semantic versions such as `1.0.0` across all templates. " +
+					"Versions that do not match are rejected at plan time. Anchor the pattern with `^` and `$` to match whole versions. " +
+					"When not set, any version is accepted.",
+				Optional: true,
+				Validators: []validator.String{
+					regexPatternValidator{},
+				},
+			},
 		},
 	}
 }
@@ -104,6 +436,15 @@ func (p *UnifiedPolicyProvider) Configure(ctx context.Context, req provider.Conf
 		return
 	}
 
+	if config.SortParametersByName.ValueBool() && config.RuleParametersInTemplateOrder.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rule_parameters_in_template_order"),
+			"Conflicting Parameter Order",
+			"rule_parameters_in_template_order and sort_parameters_by_name cannot both be set. Choose one order for rule parameters.",
+		)
+		return
+	}
+
 	if config.Url.ValueString() != "" {
 		url = config.Url.ValueString()
 	}
@@ -161,6 +502,20 @@ func (p *UnifiedPolicyProvider) Configure(ctx context.Context, req provider.Conf
 		restyClient.SetTLSClientConfig(tlsConfig)
 	}
 
+	// Set before the version checks so gateways routing on these headers also see those requests
+	customHeaders := map[string]string{}
+	resp.Diagnostics.Append(config.CustomHeaders.ElementsAs(ctx, &customHeaders, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	restyClient.SetHeaders(customHeaders)
+
+	retryJitter := unifiedpolicy.DefaultRetryJitter
+	if !config.RetryJitter.IsNull() {
+		retryJitter = config.RetryJitter.ValueFloat64()
+	}
+	restyClient.SetRetryAfter(unifiedpolicy.RetryAfterWithJitter(retryJitter))
+
 	artifactoryVersion, err := util.GetArtifactoryVersion(restyClient)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -234,11 +589,130 @@ func (p *UnifiedPolicyProvider) Configure(ctx context.Context, req provider.Conf
 	featureUsage := fmt.Sprintf("Terraform/%s", req.TerraformVersion)
 	go util.SendUsage(ctx, restyClient.R(), productId, featureUsage)
 
-	meta := util.ProviderMetadata{
-		Client:             restyClient,
-		ProductId:          productId,
-		ArtifactoryVersion: artifactoryVersion,
-		XrayVersion:        xrayVersion,
+	apiPathPrefix := unifiedpolicy.DefaultAPIPathPrefix
+	if config.APIPathPrefix.ValueString() != "" {
+		apiPathPrefix = unifiedpolicy.JoinPath(config.APIPathPrefix.ValueString())
+	}
+
+	systemTemplateHandling := unifiedpolicy.SystemTemplateHandlingError
+	if config.SystemTemplateHandling.ValueString() != "" {
+		systemTemplateHandling = config.SystemTemplateHandling.ValueString()
+	}
+
+	onConflict := unifiedpolicy.OnConflictError
+	if config.OnConflict.ValueString() != "" {
+		onConflict = config.OnConflict.ValueString()
+	}
+
+	maxRegoChars := unifiedpolicy.DefaultMaxRegoChars
+	if !config.MaxRegoChars.IsNull() {
+		maxRegoChars = int(config.MaxRegoChars.ValueInt64())
+	}
+
+	maxRulesPerPolicy := unifiedpolicy.DefaultMaxRulesPerPolicy
+	if !config.MaxRulesPerPolicy.IsNull() {
+		maxRulesPerPolicy = int(config.MaxRulesPerPolicy.ValueInt64())
+	}
+
+	var notFoundStatusCodes []int
+	resp.Diagnostics.Append(config.NotFoundStatusCodes.ElementsAs(ctx, &notFoundStatusCodes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var defaultApplicationKeys []string
+	resp.Diagnostics.Append(config.DefaultApplicationKeys.ElementsAs(ctx, &defaultApplicationKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationLabelKeyPattern := regexp.MustCompile(unifiedpolicy.DefaultApplicationLabelKeyPattern)
+	if !config.ApplicationLabelKeyPattern.IsNull() {
+		// Validated by regexPatternValidator
+		applicationLabelKeyPattern = regexp.MustCompile(config.ApplicationLabelKeyPattern.ValueString())
+	}
+
+	var versionFormatRegex *regexp.Regexp
+	if !config.VersionFormatRegex.IsNull() {
+		// Validated by regexPatternValidator
+		versionFormatRegex = regexp.MustCompile(config.VersionFormatRegex.ValueString())
+	}
+
+	regoBaseDir := ""
+	if !config.RegoBaseDir.IsNull() {
+		var err error
+		if regoBaseDir, err = resolveRegoBaseDir(config.RegoBaseDir.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rego_base_dir"), "Invalid Rego Base Directory", err.Error())
+			return
+		}
+	}
+
+	expectedInputKeys := map[string][]string{}
+	resp.Diagnostics.Append(config.ExpectedInputKeys.ElementsAs(ctx, &expectedInputKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var extraAllowedRegoOperations []string
+	resp.Diagnostics.Append(config.ExtraAllowedRegoOperations.ElementsAs(ctx, &extraAllowedRegoOperations, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requiredStageGates := map[string]string{}
+	resp.Diagnostics.Append(config.RequiredStageGates.ElementsAs(ctx, &requiredStageGates, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	meta := unifiedpolicy.ProviderMetadata{
+		ProviderMetadata: util.ProviderMetadata{
+			Client:             restyClient,
+			ProductId:          productId,
+			ArtifactoryVersion: artifactoryVersion,
+			XrayVersion:        xrayVersion,
+		},
+		AllowScannersWithNoop:         config.AllowScannersWithNoop.ValueBool(),
+		APIPathPrefix:                 apiPathPrefix,
+		ApplicationLabelKeyPattern:    applicationLabelKeyPattern,
+		DefaultApplicationKeys:        defaultApplicationKeys,
+		DefaultPolicyMode:             config.DefaultPolicyMode.ValueString(),
+		DefaultProjectKey:             config.DefaultProjectKey.ValueString(),
+		DeferRegoValidation:           config.DeferRegoValidation.ValueBool(),
+		DescriptionPrefix:             config.DescriptionPrefix.ValueString(),
+		DisableAllPolicies:            config.DisableAllPolicies.ValueBool(),
+		SystemTemplateHandling:        systemTemplateHandling,
+		ExpandRegoPath:                config.ExpandRegoPath.ValueBool(),
+		ExpectedInputKeys:             expectedInputKeys,
+		ExtraAllowedRegoOperations:    extraAllowedRegoOperations,
+		IgnoreDescriptionChanges:      config.IgnoreDescriptionChanges.ValueBool(),
+		MaxRegoChars:                  maxRegoChars,
+		MaxRulesPerPolicy:             maxRulesPerPolicy,
+		NamePrefix:                    config.NamePrefix.ValueString(),
+		NotFoundStatusCodes:           notFoundStatusCodes,
+		OnConflict:                    onConflict,
+		RegoBaseDir:                   regoBaseDir,
+		RequireDefaultDecision:        config.RequireDefaultDecision.ValueBool(),
+		RequireScannersForSecurity:    config.RequireScannersForSecurity.ValueBool(),
+		RequiredStageGates:            requiredStageGates,
+		RuleParametersInTemplateOrder: config.RuleParametersInTemplateOrder.ValueBool(),
+		SortParametersByName:          config.SortParametersByName.ValueBool(),
+		StrictParameterUsage:          config.StrictParameterUsage.ValueBool(),
+		UseETags:                      config.UseETags.ValueBool(),
+		ValidateBeforeEnforce:         config.ValidateBeforeEnforce.ValueBool(),
+		ValidateScopeKeys:             config.ValidateScopeKeys.ValueBool(),
+		VersionFormatRegex:            versionFormatRegex,
+	}
+
+	// Read once per configuration, so resources and data sources validate gates without further requests
+	meta.LifecycleGates = unifiedpolicy.ReadLifecycleGates(ctx, meta)
+	for stageKey, gate := range requiredStageGates {
+		if message := unifiedpolicy.ValidateLifecycleGate(meta.LifecycleGates, gate); message != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("required_stage_gates").AtMapKey(stageKey), "Invalid Stage Gate", message)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	resp.DataSourceData = meta
@@ -263,5 +737,58 @@ func (p *UnifiedPolicyProvider) DataSources(ctx context.Context) []func() dataso
 		unifiedpolicy_datasource.NewRulesDataSource,
 		unifiedpolicy_datasource.NewTemplateDataSource,
 		unifiedpolicy_datasource.NewTemplatesDataSource,
+		unifiedpolicy_datasource.NewRegoValidationDataSource,
+		unifiedpolicy_datasource.NewRegoFilesDataSource,
+		unifiedpolicy_datasource.NewPolicyStatsDataSource,
+		unifiedpolicy_datasource.NewPolicyAuditDataSource,
+		unifiedpolicy_datasource.NewDuplicatePoliciesDataSource,
+		unifiedpolicy_datasource.NewRuleParameterOverridesDataSource,
+		unifiedpolicy_datasource.NewRulesByScannerDataSource,
+		unifiedpolicy_datasource.NewRuleStatsDataSource,
+		unifiedpolicy_datasource.NewManifestDataSource,
+		unifiedpolicy_datasource.NewBackendAllowedOperationsDataSource,
+		unifiedpolicy_datasource.NewPolicyPreflightDataSource,
+		unifiedpolicy_datasource.NewAvailableProjectsDataSource,
+		unifiedpolicy_datasource.NewAvailableApplicationsDataSource,
+	}
+}
+
+// regexPatternValidator checks that a string is a valid regular expression.
+type regexPatternValidator struct{}
+
+func (v regexPatternValidator) Description(ctx context.Context) string {
+	return "Value must be a valid regular expression."
+}
+
+func (v regexPatternValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexPatternValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Regular Expression", err.Error())
+	}
+}
+
+// resolveRegoBaseDir checks that the provider attribute rego_base_dir is the full (absolute) path of an existing
+// directory and returns it with its symlinks resolved, so that it can be compared with resolved rego paths.
+func resolveRegoBaseDir(dir string) (string, error) {
+	if !filepath.IsAbs(dir) {
+		return "", fmt.Errorf("rego_base_dir must be a full (absolute) path: %s", dir)
+	}
+	resolved, err := filepath.EvalSymlinks(filepath.Clean(dir))
+	if err != nil {
+		return "", fmt.Errorf("rego_base_dir cannot be resolved: %w", err)
+	}
+	info, err := os.Stat(resolved)
+	if err != nil {
+		return "", fmt.Errorf("rego_base_dir cannot be read: %w", err)
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("rego_base_dir is not a directory: %s", dir)
 	}
+	return resolved, nil
 }
@@ -0,0 +1,174 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy
+
+import (
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// DefaultAPIPathPrefix is the path under which the Unified Policy API is mounted on a standard deployment.
+// All endpoint constants in resources and data sources are built on this prefix; ProviderMetadata.Endpoint swaps it
+// for the configured one with JoinPath.
+const DefaultAPIPathPrefix = "unifiedpolicy/api/v1"
+
+// Values for the provider attribute `system_template_handling`.
+const (
+	SystemTemplateHandlingError = "error"
+	SystemTemplateHandlingWarn  = "warn"
+)
+
+// Values for the provider attribute `on_conflict`.
+const (
+	OnConflictError  = "error"
+	OnConflictAdopt  = "adopt"
+	OnConflictImport = "import"
+)
+
+// Lifecycle policy enforcement modes, also the values of the provider attribute `default_policy_mode`.
+const (
+	PolicyModeBlock   = "block"
+	PolicyModeWarning = "warning"
+)
+
+// ProviderMetadata is the provider data passed to resources and data sources. It extends the shared
+// JFrog provider metadata (client, versions) with settings from the Unified Policy provider configuration.
+type ProviderMetadata struct {
+	util.ProviderMetadata
+	// APIPathPrefix replaces DefaultAPIPathPrefix in every endpoint (provider attribute `api_path_prefix`).
+	APIPathPrefix string
+	// SystemTemplateHandling controls whether reading a system (is_custom=false) template into a
+	// template resource is an error or a warning (provider attribute `system_template_handling`).
+	SystemTemplateHandling string
+	// ExpandRegoPath enables expansion of environment variables and ~ in template rego paths (provider attribute `expand_rego_path`).
+	ExpandRegoPath bool
+	// RegoBaseDir is the directory, with its symlinks resolved, that template rego files must be within; empty when not
+	// configured (provider attribute `rego_base_dir`).
+	RegoBaseDir string
+	// DeferRegoValidation validates template rego files that do not exist at plan time when they are read at apply time,
+	// instead of failing the plan (provider attribute `defer_rego_validation`).
+	DeferRegoValidation bool
+	// DescriptionPrefix is prepended to the descriptions of templates, rules and lifecycle policies sent to the API and
+	// removed from those read back; empty when not configured (provider attribute `description_prefix`).
+	DescriptionPrefix string
+	// DisableAllPolicies disables every lifecycle policy on the platform regardless of its enabled value, as an
+	// emergency control (provider attribute `disable_all_policies`).
+	DisableAllPolicies bool
+	// NamePrefix is prepended to the names of templates, rules and lifecycle policies sent to the API and removed from
+	// those read back; empty when not configured (provider attribute `name_prefix`).
+	NamePrefix string
+	// OnConflict controls how creating a template, rule or lifecycle policy whose name is already taken is handled: as an
+	// error, by adopting a matching existing object, or by taking the existing object over (provider attribute `on_conflict`).
+	OnConflict string
+	// IgnoreDescriptionChanges suppresses plans in which description is the only change (provider attribute `ignore_description_changes`).
+	IgnoreDescriptionChanges bool
+	// SortParametersByName keeps template and rule parameters sorted by name instead of in configured order
+	// (provider attribute `sort_parameters_by_name`).
+	SortParametersByName bool
+	// RuleParametersInTemplateOrder stores rule parameters in the declaration order of their template (provider
+	// attribute `rule_parameters_in_template_order`). Mutually exclusive with SortParametersByName.
+	RuleParametersInTemplateOrder bool
+	// StrictParameterUsage reports template parameter usage mismatches as errors instead of warnings
+	// (provider attribute `strict_parameter_usage`).
+	StrictParameterUsage bool
+	// DefaultPolicyMode is the enforcement mode of lifecycle policies that do not set mode; empty when not
+	// configured (provider attribute `default_policy_mode`).
+	DefaultPolicyMode string
+	// DefaultProjectKey is the project key of project-scoped lifecycle policies that do not set project_keys; empty
+	// when not configured (provider attribute `default_project_key`).
+	DefaultProjectKey string
+	// DefaultApplicationKeys are the application keys of application-scoped lifecycle policies that set neither
+	// application_keys nor application_labels; empty when not configured (provider attribute `default_application_keys`).
+	DefaultApplicationKeys []string
+	// ApplicationLabelKeyPattern must match the application label keys of lifecycle policy scopes (provider
+	// attribute `application_label_key_pattern`, DefaultApplicationLabelKeyPattern when not set). Nil skips the check.
+	ApplicationLabelKeyPattern *regexp.Regexp
+	// VersionFormatRegex must match the version of every template resource; nil when not configured (provider attribute
+	// `version_format_regex`).
+	VersionFormatRegex *regexp.Regexp
+	// ExpectedInputKeys maps template data source types to the top-level input keys their Rego code is expected to read;
+	// empty when not configured (provider attribute `expected_input_keys`).
+	ExpectedInputKeys map[string][]string
+	// ExtraAllowedRegoOperations are allowed in template Rego code in addition to the built-in allowed operations; empty
+	// when not configured (provider attribute `extra_allowed_rego_operations`).
+	ExtraAllowedRegoOperations []string
+	// RequiredStageGates maps lifecycle stage keys to the gate lifecycle policy actions on that stage must use;
+	// empty when not configured (provider attribute `required_stage_gates`).
+	RequiredStageGates map[string]string
+	// LifecycleGates are the lifecycle gates the backend supports, read once when the provider is configured;
+	// LifecycleGates (the package variable) when the backend does not enumerate them. See ValidLifecycleGates.
+	LifecycleGates []string
+	// UseETags enables optimistic concurrency control for lifecycle policy updates: the ETag returned on read is
+	// sent as If-Match on update (provider attribute `use_etags`).
+	UseETags bool
+	// ValidateBeforeEnforce checks the rules and templates of enabled block-mode lifecycle policies before they are
+	// created or updated (provider attribute `validate_before_enforce`).
+	ValidateBeforeEnforce bool
+	// ValidateScopeKeys checks lifecycle policy scope project and application keys against those on the platform at plan
+	// time (provider attribute `validate_scope_keys`).
+	ValidateScopeKeys bool
+	// AllowScannersWithNoop permits scanners on templates with data_source_type noop (provider attribute `allow_scanners_with_noop`).
+	AllowScannersWithNoop bool
+	// RequireScannersForSecurity requires at least one scanner on templates with category security and data_source_type
+	// evidence (provider attribute `require_scanners_for_security`).
+	RequireScannersForSecurity bool
+	// RequireDefaultDecision requires template Rego code to declare a default for the allow decision (provider attribute
+	// `require_default_decision`).
+	RequireDefaultDecision bool
+	// MaxRegoChars is the maximum length of template Rego code (provider attribute `max_rego_chars`).
+	MaxRegoChars int
+	// MaxRulesPerPolicy is the maximum number of rule IDs of a lifecycle policy (provider attribute `max_rules_per_policy`).
+	MaxRulesPerPolicy int
+	// NotFoundStatusCodes are the HTTP status codes for which resource reads remove the resource from state (provider
+	// attribute `not_found_status_codes`). Empty means 404 only.
+	NotFoundStatusCodes []int
+}
+
+// Endpoint returns the request path for an endpoint built on DefaultAPIPathPrefix, using the configured
+// API path prefix instead of the default one when set.
+func (m ProviderMetadata) Endpoint(endpoint string) string {
+	if m.APIPathPrefix == "" || m.APIPathPrefix == DefaultAPIPathPrefix {
+		return endpoint
+	}
+	return JoinPath(m.APIPathPrefix, strings.TrimPrefix(endpoint, DefaultAPIPathPrefix))
+}
+
+// JoinPath joins URL path segments with single slashes. Leading, trailing and repeated slashes in the segments are
+// dropped, as are empty segments, so that a prefix such as '/proxy/unifiedpolicy/api/v1/' never yields a request path
+// like 'proxy/unifiedpolicy/api/v1//templates'. The result is relative to the platform URL, as resty expects.
+func JoinPath(segments ...string) string {
+	var parts []string
+	for _, segment := range segments {
+		for _, part := range strings.Split(segment, "/") {
+			if part != "" {
+				parts = append(parts, part)
+			}
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// IsNotFoundStatus reports whether a status code returned when reading a resource means that the object no longer
+// exists: one of NotFoundStatusCodes when configured, 404 otherwise.
+func (m ProviderMetadata) IsNotFoundStatus(statusCode int) bool {
+	if len(m.NotFoundStatusCodes) == 0 {
+		return statusCode == http.StatusNotFound
+	}
+	return slices.Contains(m.NotFoundStatusCodes, statusCode)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+)
+
+func TestProviderMetadataEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		endpoint string
+		expected string
+	}{
+		{
+			name:     "default prefix when unset",
+			prefix:   "",
+			endpoint: unifiedpolicy.DefaultAPIPathPrefix + "/templates",
+			expected: "unifiedpolicy/api/v1/templates",
+		},
+		{
+			name:     "explicit default prefix",
+			prefix:   unifiedpolicy.DefaultAPIPathPrefix,
+			endpoint: unifiedpolicy.DefaultAPIPathPrefix + "/rules/{rule_id}",
+			expected: "unifiedpolicy/api/v1/rules/{rule_id}",
+		},
+		{
+			name:     "custom prefix",
+			prefix:   "proxy/unifiedpolicy/api/v1",
+			endpoint: unifiedpolicy.DefaultAPIPathPrefix + "/policies/{policyId}",
+			expected: "proxy/unifiedpolicy/api/v1/policies/{policyId}",
+		},
+		{
+			name:     "custom prefix with slashes",
+			prefix:   "/proxy//unifiedpolicy/api/v1/",
+			endpoint: unifiedpolicy.DefaultAPIPathPrefix + "/templates",
+			expected: "proxy/unifiedpolicy/api/v1/templates",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := unifiedpolicy.ProviderMetadata{APIPathPrefix: tt.prefix}
+			if got := meta.Endpoint(tt.endpoint); got != tt.expected {
+				t.Errorf("Endpoint(%q) = %q, expected %q", tt.endpoint, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestJoinPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments []string
+		expected string
+	}{
+		{name: "no segments", segments: nil, expected: ""},
+		{name: "single segment", segments: []string{"unifiedpolicy/api/v1"}, expected: "unifiedpolicy/api/v1"},
+		{name: "plain segments", segments: []string{"unifiedpolicy/api/v1", "templates"}, expected: "unifiedpolicy/api/v1/templates"},
+		{name: "trailing slash on prefix", segments: []string{"unifiedpolicy/api/v1/", "templates"}, expected: "unifiedpolicy/api/v1/templates"},
+		{name: "leading slash on suffix", segments: []string{"unifiedpolicy/api/v1", "/templates"}, expected: "unifiedpolicy/api/v1/templates"},
+		{name: "slashes on both sides", segments: []string{"/unifiedpolicy/api/v1/", "/templates/"}, expected: "unifiedpolicy/api/v1/templates"},
+		{name: "repeated slashes", segments: []string{"proxy//unifiedpolicy/api/v1", "//policies"}, expected: "proxy/unifiedpolicy/api/v1/policies"},
+		{name: "empty segments", segments: []string{"", "unifiedpolicy/api/v1", "", "/", "rules"}, expected: "unifiedpolicy/api/v1/rules"},
+		{name: "path parameter", segments: []string{"unifiedpolicy/api/v1/rules", "{rule_id}"}, expected: "unifiedpolicy/api/v1/rules/{rule_id}"},
+		{name: "several segments", segments: []string{"unifiedpolicy/api/v1", "lifecycle", "gates"}, expected: "unifiedpolicy/api/v1/lifecycle/gates"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unifiedpolicy.JoinPath(tt.segments...); got != tt.expected {
+				t.Errorf("JoinPath(%q) = %q, expected %q", tt.segments, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestProviderMetadataIsNotFoundStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		codes      []int
+		statusCode int
+		expected   bool
+	}{
+		{name: "404 by default", statusCode: http.StatusNotFound, expected: true},
+		{name: "410 not by default", statusCode: http.StatusGone, expected: false},
+		{name: "410 when configured", codes: []int{http.StatusNotFound, http.StatusGone}, statusCode: http.StatusGone, expected: true},
+		{name: "404 when configured", codes: []int{http.StatusNotFound, http.StatusGone}, statusCode: http.StatusNotFound, expected: true},
+		{name: "404 when not configured", codes: []int{http.StatusGone}, statusCode: http.StatusNotFound, expected: false},
+		{name: "200 never", codes: []int{http.StatusGone}, statusCode: http.StatusOK, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := unifiedpolicy.ProviderMetadata{NotFoundStatusCodes: tt.codes}
+			if got := meta.IsNotFoundStatus(tt.statusCode); got != tt.expected {
+				t.Errorf("IsNotFoundStatus(%d) = %v, expected %v", tt.statusCode, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// changeSummaryAttribute is the change_summary attribute shared by all resources, see planChangeSummary.
+var changeSummaryAttribute = schema.StringAttribute{
+	Description: "Best-effort, human-readable summary of the changes planned for the resource, e.g. `mode block→warning; rule_ids changed`, " +
+		"for change review in plan output. Set during plan and cleared when the resource is refreshed, so it never causes a diff by itself " +
+		"and is null when nothing changes. Values of sensitive and multi-line attributes are not shown.",
+	Computed: true,
+}
+
+// maxChangeSummaryValueLength is the length up to which changed values are shown in change_summary; longer values
+// are only reported as changed.
+const maxChangeSummaryValueLength = 64
+
+// planChangeSummary sets change_summary in the plan to the summary of the planned changes. When nothing but
+// computed attributes changes, the prior value is kept, so change_summary never causes a diff by itself. Read
+// clears the value, so it is null in the plan of an unchanged resource. Called deferred from ModifyPlan, after
+// every other plan modification.
+func planChangeSummary(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to describe on destroy, or when the plan is invalid anyway
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	summary := types.StringValue(ChangeSummary(ctx, req.Config, resp.Plan, req.State))
+	if summary.ValueString() == "" {
+		summary = types.StringNull()
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("change_summary"), &summary)...)
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("change_summary"), summary)...)
+}
+
+// resolveChangeSummary returns the value of change_summary to store after apply: the planned summary, or null when
+// it was not known at plan time.
+func resolveChangeSummary(planned types.String) types.String {
+	if planned.IsUnknown() {
+		return types.StringNull()
+	}
+	return planned
+}
+
+// ChangeSummary describes the differences between the planned and the prior values of the configurable attributes
+// and blocks, in alphabetical order and separated by "; ", e.g. "mode block→warning; rule_ids changed". Values that
+// are unknown in the plan are skipped unless their configuration is unknown too, since the provider only recomputes
+// them. It returns "create" when there is no prior state and an empty string when nothing configurable changes.
+// This function is exported for testing purposes.
+func ChangeSummary(ctx context.Context, config tfsdk.Config, plan tfsdk.Plan, state tfsdk.State) string {
+	if state.Raw.IsNull() {
+		return "create"
+	}
+
+	var configValues, planValues, stateValues map[string]tftypes.Value
+	if config.Raw.As(&configValues) != nil || plan.Raw.As(&planValues) != nil || state.Raw.As(&stateValues) != nil {
+		return ""
+	}
+
+	sensitive := map[string]bool{}
+	for name, attribute := range plan.Schema.GetAttributes() {
+		if attribute.IsRequired() || attribute.IsOptional() {
+			sensitive[name] = attribute.IsSensitive()
+		}
+	}
+	for name := range plan.Schema.GetBlocks() {
+		sensitive[name] = false
+	}
+	names := make([]string, 0, len(sensitive))
+	for name := range sensitive {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var changes []string
+	for _, name := range names {
+		planValue, stateValue := planValues[name], stateValues[name]
+		if planValue.Equal(stateValue) {
+			continue
+		}
+		if !planValue.IsKnown() && (configValues[name].IsNull() || configValues[name].IsKnown()) {
+			continue
+		}
+		changes = append(changes, describeChanges(name, stateValue, planValue, sensitive[name])...)
+	}
+	return strings.Join(changes, "; ")
+}
+
+// describeChanges describes a changed value as "name old→new" when both values are short primitives, and as
+// "name changed" otherwise. Objects, such as single nested blocks, are described per changed attribute.
+func describeChanges(name string, oldValue, newValue tftypes.Value, sensitive bool) []string {
+	if sensitive {
+		return []string{name + " changed"}
+	}
+
+	var oldAttrs, newAttrs map[string]tftypes.Value
+	_, isObject := newValue.Type().(tftypes.Object)
+	if isObject && oldValue.IsKnown() && newValue.IsKnown() && !oldValue.IsNull() && !newValue.IsNull() &&
+		oldValue.As(&oldAttrs) == nil && newValue.As(&newAttrs) == nil {
+		keys := make([]string, 0, len(newAttrs))
+		for key := range newAttrs {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		var changes []string
+		for _, key := range keys {
+			if !newAttrs[key].Equal(oldAttrs[key]) {
+				changes = append(changes, describeChanges(name+"."+key, oldAttrs[key], newAttrs[key], false)...)
+			}
+		}
+		return changes
+	}
+
+	oldText, oldOK := changeSummaryValue(oldValue)
+	newText, newOK := changeSummaryValue(newValue)
+	if !oldOK || !newOK {
+		return []string{name + " changed"}
+	}
+	return []string{name + " " + oldText + "→" + newText}
+}
+
+// changeSummaryValue renders a primitive value for change_summary. ok is false for unknown, collection and
+// multi-line or long values.
+func changeSummaryValue(value tftypes.Value) (text string, ok bool) {
+	if !value.IsKnown() {
+		return "", false
+	}
+	if value.IsNull() {
+		return "null", true
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		if value.As(&s) != nil || strings.Contains(s, "\n") || len(s) > maxChangeSummaryValueLength {
+			return "", false
+		}
+		if s == "" {
+			return `""`, true
+		}
+		return s, true
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		if value.As(&b) != nil {
+			return "", false
+		}
+		return strconv.FormatBool(b), true
+	case value.Type().Is(tftypes.Number):
+		var n big.Float
+		if value.As(&n) != nil {
+			return "", false
+		}
+		return n.Text('g', -1), true
+	}
+	return "", false
+}
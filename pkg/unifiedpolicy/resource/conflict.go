@@ -0,0 +1,284 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+)
+
+// CreateConflict describes an object whose create was rejected because an object with the same name already exists.
+type CreateConflict struct {
+	// Kind names the object type in messages, e.g. "template", and Title in summaries, e.g. "Template".
+	Kind  string
+	Title string
+	// Name is the name sent to the API, including the provider name_prefix.
+	Name string
+	// ListEndpoint lists the objects of the type and accepts a name filter.
+	ListEndpoint string
+	// ItemEndpoint and PathParam address a single object by ID.
+	ItemEndpoint string
+	PathParam    string
+}
+
+// conflictReadOnlyFields are top-level fields that create requests may send but the API sets, so they are neither
+// compared nor overwritten when resolving a conflict.
+var conflictReadOnlyFields = []string{"id", "is_custom"}
+
+// namedObjectsListAPIModel is the part of a list response used to find an object by name.
+type namedObjectsListAPIModel struct {
+	Items []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"items"`
+}
+
+// adoptOnConflict reports whether a create conflict is resolved by ResolveCreateConflict rather than reported as an
+// error, following the provider on_conflict setting.
+func adoptOnConflict(providerData unifiedpolicy.ProviderMetadata) bool {
+	return providerData.OnConflict == unifiedpolicy.OnConflictAdopt || providerData.OnConflict == unifiedpolicy.OnConflictImport
+}
+
+// ResolveCreateConflict applies the provider on_conflict setting to a create conflict. With "adopt" it reads the
+// existing object into result and keeps it when it matches requested, see ConflictMismatches. With "import" it also
+// takes over an object that does not match, by updating it to requested. It returns the response of the last request
+// that produced result, or diagnostics when the object was not adopted.
+// This function is exported for testing purposes.
+func ResolveCreateConflict[T any](ctx context.Context, providerData unifiedpolicy.ProviderMetadata, c CreateConflict, requested T, result *T) (*resty.Response, diag.Diagnostics) {
+	summary := c.Title + " Already Exists"
+	conflictDetail := fmt.Sprintf("A %s with name '%s' already exists.", c.Kind, c.Name)
+
+	id, diags := findIDByName(ctx, providerData, c.ListEndpoint, c.Name)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if id == "" {
+		diags.AddError(summary, conflictDetail+" It could not be adopted, since no "+c.Kind+" with this name was found when listing them.")
+		return nil, diags
+	}
+
+	response, err := providerData.Client.R().
+		SetContext(ctx).
+		SetPathParam(c.PathParam, id).
+		SetResult(result).
+		Get(providerData.Endpoint(c.ItemEndpoint))
+	if err != nil {
+		diags.AddError("Unable to Read Existing "+c.Title, "An unexpected error occurred while reading the existing "+c.Kind+
+			" '"+c.Name+"' to adopt it.\n\nError: "+err.Error())
+		return nil, diags
+	}
+	if response.IsError() {
+		diags.Append(unifiedpolicy.HandleAPIErrorWithType(response, "read", c.Kind)...)
+		return nil, diags
+	}
+
+	mismatches, err := ConflictMismatches(requested, *result)
+	if err != nil {
+		diags.AddError(summary, conflictDetail+" It could not be compared with the configuration: "+err.Error())
+		return nil, diags
+	}
+	if len(mismatches) == 0 {
+		tflog.Info(ctx, "Adopting existing object matching the configuration", map[string]interface{}{
+			"kind": c.Kind,
+			"id":   id,
+			"name": c.Name,
+		})
+		return response, diags
+	}
+
+	if providerData.OnConflict == unifiedpolicy.OnConflictAdopt {
+		diags.AddError(summary, fmt.Sprintf("%s It was not adopted, since it differs from the configuration in: %s. "+
+			"With on_conflict = \"adopt\" only matching objects are adopted. Change the configuration to match, "+
+			"set on_conflict = \"import\" to take the %s over and update it, or use a different name.",
+			conflictDetail, strings.Join(mismatches, ", "), c.Kind))
+		return nil, diags
+	}
+
+	body, err := mergeConflictBody(requested, *result)
+	if err != nil {
+		diags.AddError(summary, conflictDetail+" It could not be updated to the configuration: "+err.Error())
+		return nil, diags
+	}
+
+	tflog.Info(ctx, "Importing existing object and updating it to the configuration", map[string]interface{}{
+		"kind":       c.Kind,
+		"id":         id,
+		"name":       c.Name,
+		"mismatches": mismatches,
+	})
+	var updated T
+	response, err = providerData.Client.R().
+		SetContext(ctx).
+		SetPathParam(c.PathParam, id).
+		SetBody(body).
+		SetResult(&updated).
+		Put(providerData.Endpoint(c.ItemEndpoint))
+	if err != nil {
+		diags.AddError("Unable to Update Existing "+c.Title, "An unexpected error occurred while updating the existing "+c.Kind+
+			" '"+c.Name+"' to the configuration.\n\nError: "+err.Error())
+		return nil, diags
+	}
+	if response.IsError() {
+		diags.Append(unifiedpolicy.HandleAPIErrorWithType(response, "update", c.Kind)...)
+		return nil, diags
+	}
+	*result = updated
+	return response, diags
+}
+
+// findIDByName returns the ID of the object named name in the list at endpoint, or an empty string when there is none.
+func findIDByName(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, endpoint, name string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var result namedObjectsListAPIModel
+	response, err := providerData.Client.R().
+		SetContext(ctx).
+		SetQueryParam("name", name).
+		SetResult(&result).
+		Get(providerData.Endpoint(endpoint))
+	if err != nil {
+		diags.AddError("Unable to List Existing Objects", "An unexpected error occurred while looking up '"+name+"' to adopt it.\n\nError: "+err.Error())
+		return "", diags
+	}
+	if response.IsError() {
+		diags.Append(unifiedpolicy.HandleAPIError(response, "read")...)
+		return "", diags
+	}
+
+	// The name filter may match more than the exact name
+	for _, item := range result.Items {
+		if item.Name == name {
+			return item.ID, diags
+		}
+	}
+	return "", diags
+}
+
+// ConflictMismatches compares the body of a create request with the existing object returned by the API, both as
+// JSON. The existing object matches when every field of the request has the same value in it; fields the request
+// leaves out, such as timestamps, and the read-only id and is_custom are not compared. Objects are compared the same way at every level,
+// lists element by element in order. It returns the sorted paths of the fields that differ, e.g. "scope.project_keys".
+// This function is exported for testing purposes.
+func ConflictMismatches(requested, existing interface{}) ([]string, error) {
+	requestedValue, err := jsonValue(requested)
+	if err != nil {
+		return nil, err
+	}
+	existingValue, err := jsonValue(existing)
+	if err != nil {
+		return nil, err
+	}
+
+	if requestedMap, ok := requestedValue.(map[string]interface{}); ok {
+		for _, field := range conflictReadOnlyFields {
+			delete(requestedMap, field)
+		}
+	}
+
+	var mismatches []string
+	collectMismatches("", requestedValue, existingValue, &mismatches)
+	sort.Strings(mismatches)
+	return mismatches, nil
+}
+
+func collectMismatches(path string, requested, existing interface{}, mismatches *[]string) {
+	switch requestedValue := requested.(type) {
+	case map[string]interface{}:
+		existingMap, ok := existing.(map[string]interface{})
+		if !ok {
+			*mismatches = append(*mismatches, path)
+			return
+		}
+		for key, value := range requestedValue {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			collectMismatches(fieldPath, value, existingMap[key], mismatches)
+		}
+	case []interface{}:
+		existingList, ok := existing.([]interface{})
+		if !ok && existing == nil && len(requestedValue) == 0 {
+			return
+		}
+		if !ok || len(existingList) != len(requestedValue) {
+			*mismatches = append(*mismatches, path)
+			return
+		}
+		var elementMismatches []string
+		for i, value := range requestedValue {
+			collectMismatches(path+"["+strconv.Itoa(i)+"]", value, existingList[i], &elementMismatches)
+		}
+		// Lists are reported as a whole
+		if len(elementMismatches) > 0 {
+			*mismatches = append(*mismatches, path)
+		}
+	default:
+		if !reflect.DeepEqual(requested, existing) {
+			*mismatches = append(*mismatches, path)
+		}
+	}
+}
+
+// mergeConflictBody returns the body of the update that takes an existing object over: the existing object with the
+// fields of the create request, so that API fields the provider does not model are kept.
+func mergeConflictBody(requested, existing interface{}) (map[string]interface{}, error) {
+	requestedValue, err := jsonValue(requested)
+	if err != nil {
+		return nil, err
+	}
+	existingValue, err := jsonValue(existing)
+	if err != nil {
+		return nil, err
+	}
+	requestedMap, ok := requestedValue.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("the request is not a JSON object")
+	}
+	body, ok := existingValue.(map[string]interface{})
+	if !ok {
+		body = map[string]interface{}{}
+	}
+	for key, value := range requestedMap {
+		if !slices.Contains(conflictReadOnlyFields, key) {
+			body[key] = value
+		}
+	}
+	return body, nil
+}
+
+// jsonValue returns v encoded to JSON and decoded into maps, lists and scalars.
+func jsonValue(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
@@ -0,0 +1,312 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
+)
+
+func TestConflictMismatches(t *testing.T) {
+	existing := map[string]interface{}{
+		"id":          "3001",
+		"name":        "rule",
+		"description": "desc",
+		"is_custom":   true,
+		"template_id": "2001",
+		"parameters":  []interface{}{map[string]interface{}{"name": "severity", "value": "high"}},
+		"scope":       map[string]interface{}{"type": "project", "project_keys": []interface{}{"proj"}},
+		"created_at":  "2025-01-01T00:00:00Z",
+	}
+
+	tests := []struct {
+		name      string
+		requested map[string]interface{}
+		expected  []string
+	}{
+		{
+			name: "match ignoring fields not requested",
+			requested: map[string]interface{}{
+				"name":        "rule",
+				"template_id": "2001",
+				"parameters":  []interface{}{map[string]interface{}{"name": "severity", "value": "high"}},
+			},
+		},
+		{
+			name:      "read-only fields are not compared",
+			requested: map[string]interface{}{"id": "", "is_custom": false, "name": "rule"},
+		},
+		{
+			name: "differing fields",
+			requested: map[string]interface{}{
+				"name":        "rule",
+				"description": "other",
+				"template_id": "2002",
+			},
+			expected: []string{"description", "template_id"},
+		},
+		{
+			name: "list differs in an element",
+			requested: map[string]interface{}{
+				"parameters": []interface{}{map[string]interface{}{"name": "severity", "value": "low"}},
+			},
+			expected: []string{"parameters"},
+		},
+		{
+			name:      "list differs in length",
+			requested: map[string]interface{}{"parameters": []interface{}{}},
+			expected:  []string{"parameters"},
+		},
+		{
+			name:      "empty list matches missing field",
+			requested: map[string]interface{}{"scanners": []interface{}{}},
+		},
+		{
+			name:      "nested object",
+			requested: map[string]interface{}{"scope": map[string]interface{}{"type": "project", "project_keys": []interface{}{"other"}}},
+			expected:  []string{"scope.project_keys"},
+		},
+		{
+			name:      "missing field",
+			requested: map[string]interface{}{"priority": 1},
+			expected:  []string{"priority"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mismatches, err := unifiedpolicyresource.ConflictMismatches(tt.requested, existing)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(mismatches, tt.expected) {
+				t.Errorf("expected mismatches %v, got %v", tt.expected, mismatches)
+			}
+		})
+	}
+}
+
+func TestResolveCreateConflict(t *testing.T) {
+	ctx := context.Background()
+
+	const existingRule = `{"id":"3001","name":"team-rule","description":"desc","is_custom":true,"template_id":"2001",` +
+		`"parameters":[{"name":"severity","value":"high"}],"owner":"team"}`
+
+	conflict := unifiedpolicyresource.CreateConflict{
+		Kind:         "rule",
+		Title:        "Rule",
+		Name:         "team-rule",
+		ListEndpoint: unifiedpolicyresource.RulesEndpoint,
+		ItemEndpoint: unifiedpolicyresource.RuleEndpoint,
+		PathParam:    "rule_id",
+	}
+	matching := unifiedpolicyresource.RuleAPIModel{
+		Name:        "team-rule",
+		Description: "desc",
+		TemplateID:  "2001",
+		Parameters:  []unifiedpolicyresource.RuleParameterAPIModel{{Name: "severity", Value: "high"}},
+	}
+	differing := matching
+	differing.Description = "changed"
+
+	tests := []struct {
+		name       string
+		onConflict string
+		requested  unifiedpolicyresource.RuleAPIModel
+		listed     string
+		// expectedError is a substring of the expected error, empty when the rule is adopted
+		expectedError string
+		// expectedPut is the body of the expected update, nil when no update is expected
+		expectedPut map[string]interface{}
+	}{
+		{
+			name:       "adopt matching rule",
+			onConflict: unifiedpolicy.OnConflictAdopt,
+			requested:  matching,
+			listed:     `{"items":[{"id":"3000","name":"team-rule-2"},{"id":"3001","name":"team-rule"}]}`,
+		},
+		{
+			name:          "adopt differing rule",
+			onConflict:    unifiedpolicy.OnConflictAdopt,
+			requested:     differing,
+			listed:        `{"items":[{"id":"3001","name":"team-rule"}]}`,
+			expectedError: "differs from the configuration in: description.",
+		},
+		{
+			name:       "import differing rule",
+			onConflict: unifiedpolicy.OnConflictImport,
+			requested:  differing,
+			listed:     `{"items":[{"id":"3001","name":"team-rule"}]}`,
+			expectedPut: map[string]interface{}{
+				"id":          "3001",
+				"name":        "team-rule",
+				"description": "changed",
+				"is_custom":   true,
+				"template_id": "2001",
+				"parameters":  []interface{}{map[string]interface{}{"name": "severity", "value": "high"}},
+				"owner":       "team",
+			},
+		},
+		{
+			name:       "import matching rule",
+			onConflict: unifiedpolicy.OnConflictImport,
+			requested:  matching,
+			listed:     `{"items":[{"id":"3001","name":"team-rule"}]}`,
+		},
+		{
+			name:          "existing rule not listed",
+			onConflict:    unifiedpolicy.OnConflictAdopt,
+			requested:     matching,
+			listed:        `{"items":[{"id":"3000","name":"team-rule-2"}]}`,
+			expectedError: "no rule with this name was found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var put map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/"+unifiedpolicyresource.RulesEndpoint:
+					if name := r.URL.Query().Get("name"); name != "team-rule" {
+						t.Errorf("expected rules to be listed by name team-rule, got %q", name)
+					}
+					_, _ = w.Write([]byte(tt.listed))
+				case r.Method == http.MethodGet && r.URL.Path == "/"+unifiedpolicyresource.RulesEndpoint+"/3001":
+					_, _ = w.Write([]byte(existingRule))
+				case r.Method == http.MethodPut && r.URL.Path == "/"+unifiedpolicyresource.RulesEndpoint+"/3001":
+					body, _ := io.ReadAll(r.Body)
+					if err := json.Unmarshal(body, &put); err != nil {
+						t.Errorf("invalid update body: %v", err)
+					}
+					_, _ = w.Write(body)
+				default:
+					t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			providerData := unifiedpolicy.ProviderMetadata{
+				ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+				OnConflict:       tt.onConflict,
+			}
+			var result unifiedpolicyresource.RuleAPIModel
+			response, diags := unifiedpolicyresource.ResolveCreateConflict(ctx, providerData, conflict, tt.requested, &result)
+
+			if tt.expectedError != "" {
+				if diags.ErrorsCount() != 1 || !strings.Contains(diags.Errors()[0].Detail(), tt.expectedError) {
+					t.Fatalf("expected error containing %q, got diagnostics: %v", tt.expectedError, diags)
+				}
+				if summary := diags.Errors()[0].Summary(); summary != "Rule Already Exists" {
+					t.Errorf("expected summary 'Rule Already Exists', got %q", summary)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if response == nil {
+				t.Fatal("expected the response of the adopted rule")
+			}
+			if !reflect.DeepEqual(put, tt.expectedPut) {
+				t.Errorf("expected update %v, got %v", tt.expectedPut, put)
+			}
+			if result.ID != "3001" || result.Description != tt.requested.Description {
+				t.Errorf("expected rule 3001 with description %q, got %+v", tt.requested.Description, result)
+			}
+		})
+	}
+}
+
+func TestRuleCreateOnConflictError(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/"+unifiedpolicyresource.RulesEndpoint:
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"errors":[{"message":"rule already exists"}]}`))
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/"+unifiedpolicyresource.TemplatesEndpoint+"/"):
+			_, _ = w.Write([]byte(`{"id":"2001","parameters":[{"name":"severity","type":"string"}]}`))
+		case strings.HasPrefix(r.URL.Path, "/"+unifiedpolicyresource.RulesEndpoint):
+			t.Errorf("unexpected %s %s: the conflict must not be resolved", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			// Usage reporting
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &unifiedpolicyresource.RuleResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+			OnConflict:       unifiedpolicy.OnConflictError,
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	ruleSchema := schemaResp.Schema
+	parameterType := ruleSchema.Attributes["parameters"].GetType().(types.ListType).ElemType.(types.ObjectType)
+
+	plan := tfsdk.Plan{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+	diags := plan.Set(ctx, &unifiedpolicyresource.RuleResourceModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("rule"),
+		Description: types.StringNull(),
+		IsCustom:    types.BoolUnknown(),
+		TemplateID:  types.StringValue("2001"),
+		Parameters: types.ListValueMust(parameterType, []attr.Value{
+			types.ObjectValueMust(parameterType.AttrTypes, map[string]attr.Value{
+				"name":            types.StringValue("severity"),
+				"value":           types.StringValue("high"),
+				"sensitive":       types.BoolValue(false),
+				"sensitive_value": types.StringNull(),
+			}),
+		}),
+		ParametersJSON:     types.StringUnknown(),
+		ParameterTypes:     types.MapNull(types.StringType),
+		TemplateParameters: templateParametersNull,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != "Rule Already Exists" {
+		t.Errorf("expected a 'Rule Already Exists' error, got diagnostics: %v", resp.Diagnostics)
+	}
+}
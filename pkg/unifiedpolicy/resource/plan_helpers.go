@@ -0,0 +1,110 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+)
+
+// checkPrefixedNameLength reports an error on name when the name sent to the API exceeds the name length limit with
+// the provider attribute name_prefix added. Shared by all resources.
+func checkPrefixedNameLength(ctx context.Context, plan tfsdk.Plan, namePrefix string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if namePrefix == "" {
+		return diags
+	}
+
+	var name types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if diags.HasError() || name.IsUnknown() || name.IsNull() {
+		return diags
+	}
+	if msg := unifiedpolicy.PrefixedNameLengthError(namePrefix, name.ValueString()); msg != "" {
+		diags.AddAttributeError(path.Root("name"), "Name Too Long", msg)
+	}
+	return diags
+}
+
+// ignoreDescriptionOnlyChange keeps the prior description in the plan when the provider attribute
+// ignore_description_changes is set and description is the only configured change, so apply skips the update.
+// Values that are unknown in the plan are compared as their prior state values, since they are only computed
+// because of the change. Shared by all resources; description must be Computed for the plan to differ from config.
+func ignoreDescriptionOnlyChange(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare on create or destroy
+	if !providerData.IgnoreDescriptionChanges || req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configDescription, stateDescription types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("description"), &configDescription)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("description"), &stateDescription)...)
+	if resp.Diagnostics.HasError() || configDescription.IsUnknown() || configDescription.Equal(stateDescription) {
+		return
+	}
+
+	candidate := resp.Plan
+	resp.Diagnostics.Append(candidate.SetAttribute(ctx, path.Root("description"), stateDescription)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unchanged, err := tftypes.Transform(candidate.Raw, func(attrPath *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if value.IsKnown() {
+			return value, nil
+		}
+		stateValue, _, err := tftypes.WalkAttributePath(req.State.Raw, attrPath)
+		if err != nil {
+			return value, nil
+		}
+		return stateValue.(tftypes.Value), nil
+	})
+	if err != nil || !unchanged.Equal(req.State.Raw) {
+		return
+	}
+
+	tflog.Debug(ctx, "Ignoring description-only change", map[string]interface{}{
+		"config_description": configDescription.ValueString(),
+		"state_description":  stateDescription.ValueString(),
+	})
+	resp.Plan.Raw = unchanged
+}
+
+// nullDescriptionPlanModifier keeps an unconfigured description null in the plan. description is Computed only
+// so that ignoreDescriptionOnlyChange can keep the prior value; otherwise it behaves as a plain optional attribute.
+type nullDescriptionPlanModifier struct{}
+
+func (m nullDescriptionPlanModifier) Description(ctx context.Context) string {
+	return "Keeps description null in the plan when it is not configured."
+}
+
+func (m nullDescriptionPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m nullDescriptionPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		resp.PlanValue = types.StringNull()
+	}
+}
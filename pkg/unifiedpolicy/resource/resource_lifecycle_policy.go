@@ -19,18 +19,28 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
@@ -39,12 +49,12 @@ import (
 
 // Lifecycle policy API endpoints (used by this resource and lifecycle policy datasources)
 const (
-	PoliciesEndpoint = "unifiedpolicy/api/v1/policies"
+	PoliciesEndpoint = unifiedpolicy.DefaultAPIPathPrefix + "/policies"
 	PolicyEndpoint   = PoliciesEndpoint + "/{policyId}"
 )
 
 type LifecyclePolicyResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData unifiedpolicy.ProviderMetadata
 	TypeName     string
 }
 
@@ -57,6 +67,16 @@ type LifecyclePolicyResourceModel struct {
 	Action      types.Object `tfsdk:"action"`
 	Scope       types.Object `tfsdk:"scope"`
 	RuleIDs     types.List   `tfsdk:"rule_ids"`
+	Priority    types.Int64  `tfsdk:"priority"`
+
+	DisableBeforeDelete      types.Bool   `tfsdk:"disable_before_delete"`
+	DeleteGracePeriodSeconds types.Int64  `tfsdk:"delete_grace_period_seconds"`
+	FailOnDisabledRule       types.Bool   `tfsdk:"fail_on_disabled_rule"`
+	DataSourceCompatibility  types.Map    `tfsdk:"data_source_compatibility"`
+	EffectiveEnabled         types.Bool   `tfsdk:"effective_enabled"`
+	ScopeDescription         types.String `tfsdk:"scope_description"`
+
+	ChangeSummary types.String `tfsdk:"change_summary"`
 }
 
 type LifecycleActionModel struct {
@@ -81,6 +101,11 @@ type ApplicationLabelModel struct {
 	Value types.String `tfsdk:"value"`
 }
 
+type DataSourceCompatibilityModel struct {
+	ScopeTypes  types.List `tfsdk:"scope_types"`
+	ActionTypes types.List `tfsdk:"action_types"`
+}
+
 type LifecyclePolicyAPIModel struct {
 	ID          string           `json:"id,omitempty"`
 	Name        string           `json:"name"`
@@ -90,15 +115,61 @@ type LifecyclePolicyAPIModel struct {
 	Action      *LifecycleAction `json:"action"`
 	Scope       *LifecycleScope  `json:"scope"`
 	RuleIDs     []string         `json:"rule_ids,omitempty"`
+	Priority    *int64           `json:"priority,omitempty"`
 	CreatedAt   string           `json:"created_at,omitempty"`
 	CreatedBy   string           `json:"created_by,omitempty"`
 	UpdatedAt   string           `json:"updated_at,omitempty"`
 	UpdatedBy   string           `json:"updated_by,omitempty"`
+
+	// ExtraFields holds response fields unknown to the provider so they survive read/update round-trips.
+	ExtraFields unifiedpolicy.ExtraFields `json:"-"`
+}
+
+// UnmarshalJSON keeps fields the provider does not know about in ExtraFields.
+func (m *LifecyclePolicyAPIModel) UnmarshalJSON(data []byte) error {
+	type alias LifecyclePolicyAPIModel
+	var a alias
+	extra, err := unifiedpolicy.UnmarshalWithExtra(data, &a)
+	if err != nil {
+		return err
+	}
+	*m = LifecyclePolicyAPIModel(a)
+	m.ExtraFields = extra
+	return nil
+}
+
+// MarshalJSON sends ExtraFields back alongside the known fields.
+func (m LifecyclePolicyAPIModel) MarshalJSON() ([]byte, error) {
+	type alias LifecyclePolicyAPIModel
+	return unifiedpolicy.MarshalWithExtra(alias(m), m.ExtraFields)
 }
 
 type LifecycleAction struct {
 	Type  string          `json:"type"`
-	Stage *LifecycleStage `json:"stage"`
+	Stage *LifecycleStage `json:"stage,omitempty"`
+}
+
+// Whether an action type takes an action.stage block.
+const (
+	StageRequired  = "required"
+	StageOptional  = "optional"
+	StageForbidden = "forbidden"
+)
+
+// LifecycleActionTypes lists the supported action types and whether each takes an action.stage block.
+// Supporting a new action type only requires adding it here.
+var LifecycleActionTypes = map[string]string{
+	"certify_to_gate": StageRequired,
+}
+
+// lifecycleActionTypeNames returns the supported action types in a stable order.
+func lifecycleActionTypeNames() []string {
+	names := make([]string, 0, len(LifecycleActionTypes))
+	for name := range LifecycleActionTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 type LifecycleStage struct {
@@ -118,7 +189,16 @@ type ApplicationLabel struct {
 	Value string `json:"value"`
 }
 
+// DataSourceCompatibility lists the scope and action types a template data_source_type is compatible with. A nil
+// list allows any type.
+type DataSourceCompatibility struct {
+	ScopeTypes  []string
+	ActionTypes []string
+}
+
 var _ resource.Resource = &LifecyclePolicyResource{}
+var _ resource.ResourceWithModifyPlan = &LifecyclePolicyResource{}
+var _ resource.ResourceWithConfigValidators = &LifecyclePolicyResource{}
 
 func NewLifecyclePolicyResource() resource.Resource {
 	return &LifecyclePolicyResource{
@@ -135,6 +215,7 @@ func (r *LifecyclePolicyResource) Schema(ctx context.Context, req resource.Schem
 		MarkdownDescription: "Provides a Unified Policy lifecycle policy resource. This resource allows you to create, update, and delete lifecycle policies. " +
 			"Lifecycle policies define enforcement mode, lifecycle actions (stage/gate), scope (project or application), and the rules to apply.",
 		Attributes: map[string]schema.Attribute{
+			"change_summary": changeSummaryAttribute,
 			"id": schema.StringAttribute{
 				Description: "The ID of the lifecycle policy. This is computed and assigned by the API.",
 				Computed:    true,
@@ -143,67 +224,149 @@ func (r *LifecyclePolicyResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The policy name. Must be unique.",
+				Description: "The policy name. Must be unique. 1-" + strconv.Itoa(unifiedpolicy.MaxNameLength) + " characters.",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, unifiedpolicy.MaxNameLength),
+				},
 			},
 			"description": schema.StringAttribute{
-				Description: "A free-text description of the policy. This field is optional.",
+				Description: "A free-text description of the policy. This field is optional. Up to " + strconv.Itoa(unifiedpolicy.MaxDescriptionLength) + " characters.",
 				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					nullDescriptionPlanModifier{},
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(unifiedpolicy.MaxDescriptionLength),
+				},
 			},
 			"enabled": schema.BoolAttribute{
 				Description: "Whether the policy is active. Set to true to enable the policy, false to disable it.",
 				Required:    true,
 			},
+			"effective_enabled": schema.BoolAttribute{
+				Description: "Whether the policy is active on the platform: `enabled`, unless the provider `disable_all_policies` emergency " +
+					"control is on, which disables every policy while `enabled` keeps its configured value.",
+				Computed: true,
+			},
+			"scope_description": schema.StringAttribute{
+				Description: "The scope in plain English for reports and notifications, e.g. `project PROD-1` or " +
+					"`applications labeled environment=production`. Derived from `scope`; it only changes when the scope does.",
+				Computed: true,
+			},
 			"mode": schema.StringAttribute{
 				Description: "Enforcement mode. Must be either 'block' or 'warning'. " +
 					"'block' will prevent promotion when rules are violated. " +
-					"'warning' will allow promotion but log violations.",
-				Required: true,
+					"'warning' will allow promotion but log violations. " +
+					"When not set, the provider `default_policy_mode` is used; one of the two is required.",
+				Optional: true,
+				Computed: true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("block", "warning"),
+					stringvalidator.OneOf(unifiedpolicy.PolicyModeBlock, unifiedpolicy.PolicyModeWarning),
 				},
 			},
 			"rule_ids": schema.ListAttribute{
 				Description: "IDs of rules enforced by this policy. " +
-					"The API allows exactly one rule per policy (documentation describes an array but validation enforces maximum 1 item). " +
-					"The rule ID must reference a valid rule that exists in the system.",
+					"By default the API allows exactly one rule per policy; the provider attribute `max_rules_per_policy` raises the limit for " +
+					"backends that accept several rules, and the limit is validated at plan time. " +
+					"The rule IDs must reference valid rules that exist in the system.",
 				ElementType: types.StringType,
 				Required:    true,
 				Validators: []validator.List{
 					listvalidator.SizeAtLeast(1),
-					listvalidator.SizeAtMost(1),
 					listvalidator.ValueStringsAre(
 						stringvalidator.LengthAtLeast(1),
 					),
 				},
 			},
+			"priority": schema.Int64Attribute{
+				Description: "Evaluation order of the policy among policies that apply to the same scope, for deterministic enforcement precedence. " +
+					"Lower values are evaluated first. " + strconv.Itoa(unifiedpolicy.MinPolicyPriority) + "-" + strconv.Itoa(unifiedpolicy.MaxPolicyPriority) + ". " +
+					"When not set, the backend default is kept. Requires a backend that supports policy priority.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(unifiedpolicy.MinPolicyPriority, unifiedpolicy.MaxPolicyPriority),
+				},
+			},
+			"disable_before_delete": schema.BoolAttribute{
+				Description: "When true and the policy is enabled, destroying the resource first disables the policy (PUT with `enabled = false`), " +
+					"waits `delete_grace_period_seconds`, and only then deletes it. This gives in-flight promotions a chance to finish " +
+					"before an enforcing policy disappears. Provider-only setting; it is not sent to the API. Defaults to false.",
+				Optional: true,
+			},
+			"delete_grace_period_seconds": schema.Int64Attribute{
+				Description: "Seconds to wait between disabling and deleting the policy when `disable_before_delete` is true. 0-3600. Defaults to 0.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 3600),
+					int64validator.AlsoRequires(path.MatchRoot("disable_before_delete")),
+				},
+			},
+			"fail_on_disabled_rule": schema.BoolAttribute{
+				Description: "When the policy is enabled, the referenced rules are checked at plan time and a warning is reported for any rule " +
+					"the backend reports as disabled. Set to true to report an error instead. Provider-only setting; it is not sent to the API. Defaults to false.",
+				Optional: true,
+			},
+			"data_source_compatibility": schema.MapNestedAttribute{
+				Description: "Compatibility table checked at plan time, keyed by template `data_source_type` (noop, evidence, xray). " +
+					"When set, the template of each referenced rule is read and a warning is reported when the policy scope or action type " +
+					"is not listed for its data source type. Data source types without an entry are not checked. " +
+					"Provider-only setting; it is not sent to the API. When not set, no check is made.",
+				Optional: true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf("noop", "evidence", "xray")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"scope_types": schema.ListAttribute{
+							Description: "Scope types compatible with the data source type: 'project' and/or 'application'. When not set, any scope type is.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(stringvalidator.OneOf("project", "application")),
+							},
+						},
+						"action_types": schema.ListAttribute{
+							Description: "Action types compatible with the data source type, e.g. 'certify_to_gate'. When not set, any action type is.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(stringvalidator.OneOf(lifecycleActionTypeNames()...)),
+							},
+						},
+					},
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"action": schema.SingleNestedBlock{
 				Description: "Lifecycle action governed by the policy.",
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{
-						Description: "Action type. Currently supports 'certify_to_gate'.",
+						Description: "Action type. Currently supports 'certify_to_gate', which requires a stage block.",
 						Required:    true,
 						Validators: []validator.String{
-							stringvalidator.OneOf("certify_to_gate"),
+							stringvalidator.OneOf(lifecycleActionTypeNames()...),
 						},
 					},
 				},
 				Blocks: map[string]schema.Block{
 					"stage": schema.SingleNestedBlock{
-						Description: "Lifecycle stage and gate configuration.",
+						Description: "Lifecycle stage and gate configuration. Required or not allowed depending on the action type; required for 'certify_to_gate'.",
 						Attributes: map[string]schema.Attribute{
 							"key": schema.StringAttribute{
 								Description: "Lifecycle stage key (e.g., 'qa', 'production').",
 								Required:    true,
 							},
 							"gate": schema.StringAttribute{
-								Description: "Lifecycle gate. Must be one of: 'entry', 'exit', 'release'.",
-								Required:    true,
-								Validators: []validator.String{
-									stringvalidator.OneOf("entry", "exit", "release"),
-								},
+								Description: "Lifecycle gate. Must be a gate the backend supports, checked at plan time; when the backend does not " +
+									"enumerate its gates, one of: '" + strings.Join(unifiedpolicy.LifecycleGates, "', '") + "'.",
+								Required: true,
 							},
 						},
 					},
@@ -221,9 +384,11 @@ func (r *LifecyclePolicyResource) Schema(ctx context.Context, req resource.Schem
 					},
 					"project_keys": schema.ListAttribute{
 						Description: "Projects to include (required for project scope). " +
-							"The API requires exactly one project key. Each key must be at least 1 character.",
+							"The API requires exactly one project key. Each key must be at least 1 character. " +
+							"When not set on a project scope, the provider `default_project_key` is used.",
 						ElementType: types.StringType,
 						Optional:    true,
+						Computed:    true,
 						Validators: []validator.List{
 							listvalidator.SizeAtMost(1),
 							listvalidator.ValueStringsAre(
@@ -233,9 +398,11 @@ func (r *LifecyclePolicyResource) Schema(ctx context.Context, req resource.Schem
 					},
 					"application_keys": schema.ListAttribute{
 						Description: "Applications to include (used with application scope). " +
-							"Each application key must be at least 1 character in length.",
+							"Each application key must be at least 1 character in length. " +
+							"When neither this nor `application_labels` is set on an application scope, the provider `default_application_keys` are used.",
 						ElementType: types.StringType,
 						Optional:    true,
+						Computed:    true,
 						Validators: []validator.List{
 							listvalidator.ValueStringsAre(
 								stringvalidator.LengthAtLeast(1),
@@ -249,7 +416,7 @@ func (r *LifecyclePolicyResource) Schema(ctx context.Context, req resource.Schem
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"key": schema.StringAttribute{
-									Description: "Label key.",
+									Description: "Label key. Must match the provider `application_label_key_pattern` when it is set.",
 									Required:    true,
 								},
 								"value": schema.StringAttribute{
@@ -265,15 +432,661 @@ func (r *LifecyclePolicyResource) Schema(ctx context.Context, req resource.Schem
 	}
 }
 
+// ConfigValidators reports missing action and scope blocks at plan time. Blocks cannot be marked Required
+// in the schema, so without this the API model conversion would only fail during apply.
+func (r *LifecyclePolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		requiredBlockValidator{
+			block:  "action",
+			detail: "The API requires an action block with type and, for action types that take one, stage (key and gate).",
+		},
+		requiredBlockValidator{
+			block:  "scope",
+			detail: "The API requires a scope block with type and project_keys or application_keys/application_labels.",
+		},
+		actionStageValidator{},
+	}
+}
+
+// actionStageValidator checks the action.stage block against the stage requirement of action.type.
+type actionStageValidator struct{}
+
+// Description returns a plain text description of the validator.
+func (v actionStageValidator) Description(ctx context.Context) string {
+	return "Validates that action.stage is configured if and only if the action type allows it"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator.
+func (v actionStageValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates that `action.stage` is configured if and only if the action type allows it"
+}
+
+// ValidateResource performs the validation.
+func (v actionStageValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var actionType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("action").AtName("type"), &actionType)...)
+	var stage types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("action").AtName("stage"), &stage)...)
+	if resp.Diagnostics.HasError() || actionType.IsNull() || actionType.IsUnknown() || stage.IsUnknown() {
+		return
+	}
+
+	if message := ValidateActionStage(actionType.ValueString(), !stage.IsNull()); message != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("action").AtName("stage"), "Invalid Action Stage", message)
+	}
+}
+
+// requiredBlockValidator validates that a top-level single nested block is present in the configuration.
+type requiredBlockValidator struct {
+	block  string
+	detail string
+}
+
+// Description returns a plain text description of the validator.
+func (v requiredBlockValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Validates that the %s block is configured", v.block)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator.
+func (v requiredBlockValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Validates that the `%s` block is configured", v.block)
+}
+
+// ValidateResource performs the validation.
+func (v requiredBlockValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var block types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(v.block), &block)...)
+	if resp.Diagnostics.HasError() || block.IsUnknown() {
+		return
+	}
+
+	if block.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(v.block),
+			"Missing Required Block",
+			fmt.Sprintf("The %s block is required. %s", v.block, v.detail),
+		)
+	}
+}
+
 func (r *LifecyclePolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+// ModifyPlan checks that an enabled policy does not reference rules that are disabled on the backend.
+// Such a policy would be active but never enforce anything. change_summary is set last, see planChangeSummary.
+func (r *LifecyclePolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer planChangeSummary(ctx, req, resp)
+
+	// Nothing to check on destroy, or when the provider is not configured (e.g. terraform validate)
+	if req.Plan.Raw.IsNull() || r.ProviderData.Client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(checkPrefixedNameLength(ctx, req.Plan, r.ProviderData.NamePrefix)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkRuleIDCount(ctx, r.ProviderData, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyDefaultPolicyMode(ctx, r.ProviderData, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyDisableAllPolicies(ctx, r.ProviderData, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkLifecycleGate(ctx, r.ProviderData, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkRequiredStageGate(ctx, r.ProviderData, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applyDefaultScope(ctx, r.ProviderData, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planScopeDescription(ctx, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkApplicationLabelKeys(ctx, r.ProviderData, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkScopeKeys(ctx, r.ProviderData, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkDataSourceCompatibility(ctx, r.ProviderData, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ignoreDescriptionOnlyChange(ctx, r.ProviderData, req, resp)
+
+	var plan LifecyclePolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Enabled.ValueBool() || r.ProviderData.DisableAllPolicies || plan.RuleIDs.IsUnknown() || plan.RuleIDs.IsNull() {
+		return
+	}
+
+	var ruleIDs []types.String
+	resp.Diagnostics.Append(plan.RuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, ruleID := range ruleIDs {
+		// Rules created in the same apply are not known yet
+		if ruleID.IsUnknown() || ruleID.IsNull() {
+			continue
+		}
+
+		var rule RuleAPIModel
+		httpResponse, err := r.ProviderData.Client.R().
+			SetContext(ctx).
+			SetPathParam("rule_id", ruleID.ValueString()).
+			SetResult(&rule).
+			Get(r.ProviderData.Endpoint(RuleEndpoint))
+
+		// Missing rules and API errors are reported by create/update; the check is best effort
+		if err != nil || httpResponse.IsError() {
+			tflog.Debug(ctx, "Unable to fetch rule for disabled rule check", map[string]interface{}{
+				"rule_id": ruleID.ValueString(),
+			})
+			continue
+		}
+
+		if rule.Enabled == nil || *rule.Enabled {
+			continue
+		}
+
+		summary := "Policy References Disabled Rule"
+		detail := fmt.Sprintf("The policy is enabled but references rule '%s' (ID '%s'), which is disabled. "+
+			"The policy will not enforce anything until the rule is enabled.", rule.Name, ruleID.ValueString())
+		if plan.FailOnDisabledRule.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("rule_ids"), summary, detail)
+		} else {
+			resp.Diagnostics.AddAttributeWarning(path.Root("rule_ids"), summary, detail)
+		}
+	}
+}
+
+// applyDefaultPolicyMode plans the provider default_policy_mode for a policy that does not set mode. The value is
+// set on every plan, not only on create, so that changing the provider default updates the policies relying on it.
+func applyDefaultPolicyMode(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var configMode types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("mode"), &configMode)...)
+	if resp.Diagnostics.HasError() || !configMode.IsNull() {
+		return
+	}
+
+	if providerData.DefaultPolicyMode == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("mode"),
+			"Missing Policy Mode",
+			"mode is not set and the provider has no default_policy_mode. Set mode on the resource or default_policy_mode on the provider.",
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Using provider default policy mode", map[string]interface{}{
+		"mode": providerData.DefaultPolicyMode,
+	})
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("mode"), types.StringValue(providerData.DefaultPolicyMode))...)
+}
+
+// applyDisableAllPolicies plans effective_enabled, the enabled value sent to the API: enabled, or false while the
+// provider sets disable_all_policies. enabled itself is required and keeps its configured value, so that the policy
+// returns to it when disable_all_policies is unset.
+func applyDisableAllPolicies(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var enabled types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("enabled"), &enabled)...)
+	if resp.Diagnostics.HasError() || enabled.IsUnknown() {
+		return
+	}
+
+	if providerData.DisableAllPolicies && enabled.ValueBool() {
+		var name types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("enabled"),
+			"Lifecycle Policy Disabled by Provider",
+			fmt.Sprintf("The provider sets disable_all_policies, so lifecycle policy '%s' is disabled on the platform although it is "+
+				"configured with enabled = true. Its enforcement stays off until disable_all_policies is unset.", name.ValueString()),
+		)
+		enabled = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_enabled"), enabled)...)
+}
+
+// checkLifecycleGate validates the action stage gate against the lifecycle gates the backend supports.
+func checkLifecycleGate(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	gatePath := path.Root("action").AtName("stage").AtName("gate")
+	var gate types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, gatePath, &gate)...)
+	if resp.Diagnostics.HasError() || gate.IsNull() || gate.IsUnknown() {
+		return
+	}
+
+	if message := unifiedpolicy.ValidateLifecycleGate(providerData.ValidLifecycleGates(), gate.ValueString()); message != "" {
+		resp.Diagnostics.AddAttributeError(gatePath, "Invalid Lifecycle Gate", message)
+	}
+}
+
+// checkRequiredStageGate enforces the provider required_stage_gates on the planned action stage.
+func checkRequiredStageGate(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if len(providerData.RequiredStageGates) == 0 {
+		return
+	}
+
+	stagePath := path.Root("action").AtName("stage")
+	var stageKey, gate types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, stagePath.AtName("key"), &stageKey)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, stagePath.AtName("gate"), &gate)...)
+	if resp.Diagnostics.HasError() || stageKey.IsNull() || stageKey.IsUnknown() || gate.IsNull() || gate.IsUnknown() {
+		return
+	}
+
+	if message := ValidateStageGate(providerData.RequiredStageGates, stageKey.ValueString(), gate.ValueString()); message != "" {
+		resp.Diagnostics.AddAttributeError(stagePath.AtName("gate"), "Invalid Stage Gate", message)
+	}
+}
+
+// checkRuleIDCount enforces the provider max_rules_per_policy on the planned rule_ids. The limit is a provider
+// setting, so it cannot be a schema validator.
+func checkRuleIDCount(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if providerData.MaxRulesPerPolicy <= 0 {
+		return
+	}
+
+	var ruleIDs types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rule_ids"), &ruleIDs)...)
+	if resp.Diagnostics.HasError() || ruleIDs.IsNull() || ruleIDs.IsUnknown() {
+		return
+	}
+
+	if count := len(ruleIDs.Elements()); count > providerData.MaxRulesPerPolicy {
+		resp.Diagnostics.AddAttributeError(path.Root("rule_ids"), "Invalid Rule IDs", ruleIDCountError(count, providerData.MaxRulesPerPolicy))
+	}
+}
+
+// ReconcileRuleIDOrder returns the rule IDs from the API response in the planned or prior order, followed by any rule
+// IDs that only exist in the response, in the order the API returned them.
+// This function is exported for testing purposes.
+func ReconcileRuleIDOrder(configured, returned []string) []string {
+	return reorderToConfigured(configured, returned, func(ruleID string) string { return ruleID })
+}
+
+// ruleIDCountError describes rule_ids exceeding the provider max_rules_per_policy.
+func ruleIDCountError(count, maxRuleIDs int) string {
+	return fmt.Sprintf("rule_ids must contain at most %d item(s), got %d. Raise the provider attribute max_rules_per_policy "+
+		"if your Unified Policy version accepts more rules per policy.", maxRuleIDs, count)
+}
+
+// checkApplicationLabelKeys enforces the provider application_label_key_pattern on the configured scope labels.
+func checkApplicationLabelKeys(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if providerData.ApplicationLabelKeyPattern == nil {
+		return
+	}
+
+	labelsPath := path.Root("scope").AtName("application_labels")
+	var labels types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, labelsPath, &labels)...)
+	if resp.Diagnostics.HasError() || labels.IsNull() || labels.IsUnknown() {
+		return
+	}
+
+	for i, element := range labels.Elements() {
+		label, ok := element.(types.Object)
+		if !ok || label.IsNull() || label.IsUnknown() {
+			continue
+		}
+		key, ok := label.Attributes()["key"].(types.String)
+		if !ok || key.IsNull() || key.IsUnknown() {
+			continue
+		}
+		if message := ValidateApplicationLabelKey(key.ValueString(), providerData.ApplicationLabelKeyPattern); message != "" {
+			resp.Diagnostics.AddAttributeError(labelsPath.AtListIndex(i).AtName("key"), "Invalid Application Label Key", message)
+		}
+	}
+}
+
+// checkScopeKeys checks the planned scope project and application keys, including provider defaults, against those on
+// the platform when the provider sets validate_scope_keys. The keys are only listed for scopes that set them.
+func checkScopeKeys(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, resp *resource.ModifyPlanResponse) {
+	if !providerData.ValidateScopeKeys {
+		return
+	}
+
+	scopePath := path.Root("scope")
+	scopeKeys := []struct {
+		attribute string
+		kind      string
+		read      func(context.Context, unifiedpolicy.ProviderMetadata) ([]string, diag.Diagnostics)
+	}{
+		{attribute: "project_keys", kind: "project", read: unifiedpolicy.ReadAvailableProjectKeys},
+		{attribute: "application_keys", kind: "application", read: unifiedpolicy.ReadAvailableApplicationKeys},
+	}
+
+	for _, scopeKey := range scopeKeys {
+		keysPath := scopePath.AtName(scopeKey.attribute)
+		var keysValue types.List
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, keysPath, &keysValue)...)
+		if resp.Diagnostics.HasError() || keysValue.IsNull() || keysValue.IsUnknown() {
+			continue
+		}
+
+		var keys []types.String
+		resp.Diagnostics.Append(keysValue.ElementsAs(ctx, &keys, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		knownKeys := make([]string, 0, len(keys))
+		for _, key := range keys {
+			if !key.IsNull() && !key.IsUnknown() {
+				knownKeys = append(knownKeys, key.ValueString())
+			}
+		}
+		if len(knownKeys) == 0 {
+			continue
+		}
+
+		available, diags := scopeKey.read(ctx, providerData)
+		for _, d := range diags {
+			resp.Diagnostics.AddAttributeError(keysPath, d.Summary(), d.Detail()+
+				"\n\nUnset validate_scope_keys on the provider to plan without checking scope keys.")
+		}
+		if diags.HasError() {
+			return
+		}
+
+		if message := unifiedpolicy.ValidateScopeKeys(scopeKey.kind, available, knownKeys); message != "" {
+			resp.Diagnostics.AddAttributeError(keysPath, "Invalid Scope Key", message)
+		}
+	}
+}
+
+// checkDataSourceCompatibility warns when the data_source_type of the template of a referenced rule is not compatible
+// with the planned scope and action types, following the data_source_compatibility table. Rules and templates that
+// cannot be read are skipped; the check is best effort.
+func checkDataSourceCompatibility(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan LifecyclePolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.DataSourceCompatibility.IsNull() || plan.DataSourceCompatibility.IsUnknown() ||
+		plan.RuleIDs.IsNull() || plan.RuleIDs.IsUnknown() {
+		return
+	}
+
+	table, diags := dataSourceCompatibilityTable(ctx, plan.DataSourceCompatibility)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || table == nil {
+		return
+	}
+
+	var scopeType, actionType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("scope").AtName("type"), &scopeType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("action").AtName("type"), &actionType)...)
+	if resp.Diagnostics.HasError() || scopeType.IsUnknown() || actionType.IsUnknown() {
+		return
+	}
+
+	var ruleIDs []types.String
+	resp.Diagnostics.Append(plan.RuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, ruleID := range ruleIDs {
+		// Rules created in the same apply are not known yet
+		if ruleID.IsUnknown() || ruleID.IsNull() {
+			continue
+		}
+
+		var rule RuleAPIModel
+		httpResponse, err := providerData.Client.R().
+			SetContext(ctx).
+			SetPathParam("rule_id", ruleID.ValueString()).
+			SetResult(&rule).
+			Get(providerData.Endpoint(RuleEndpoint))
+		if err != nil || httpResponse.IsError() || rule.TemplateID == "" {
+			tflog.Debug(ctx, "Unable to fetch rule for data source compatibility check", map[string]interface{}{
+				"rule_id": ruleID.ValueString(),
+			})
+			continue
+		}
+
+		var template TemplateAPIModel
+		httpResponse, err = providerData.Client.R().
+			SetContext(ctx).
+			SetPathParam("templateId", rule.TemplateID).
+			SetResult(&template).
+			Get(providerData.Endpoint(TemplateEndpoint))
+		if err != nil || httpResponse.IsError() {
+			tflog.Debug(ctx, "Unable to fetch template for data source compatibility check", map[string]interface{}{
+				"rule_id":     ruleID.ValueString(),
+				"template_id": rule.TemplateID,
+			})
+			continue
+		}
+
+		if message := ValidateDataSourceCompatibility(table, template.DataSourceType, scopeType.ValueString(), actionType.ValueString()); message != "" {
+			resp.Diagnostics.AddAttributeWarning(path.Root("rule_ids"), "Incompatible Rule Data Source",
+				fmt.Sprintf("Rule '%s' (ID '%s') uses template '%s'. %s", rule.Name, ruleID.ValueString(), template.Name, message))
+		}
+	}
+}
+
+// dataSourceCompatibilityTable converts the data_source_compatibility attribute. It returns nil when an entry is not
+// fully known yet.
+func dataSourceCompatibilityTable(ctx context.Context, value types.Map) (map[string]DataSourceCompatibility, diag.Diagnostics) {
+	var entries map[string]DataSourceCompatibilityModel
+	diags := value.ElementsAs(ctx, &entries, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	table := make(map[string]DataSourceCompatibility, len(entries))
+	for dataSourceType, entry := range entries {
+		if entry.ScopeTypes.IsUnknown() || entry.ActionTypes.IsUnknown() {
+			return nil, diags
+		}
+		var compatibility DataSourceCompatibility
+		if !entry.ScopeTypes.IsNull() {
+			compatibility.ScopeTypes = []string{}
+			diags.Append(entry.ScopeTypes.ElementsAs(ctx, &compatibility.ScopeTypes, false)...)
+		}
+		if !entry.ActionTypes.IsNull() {
+			compatibility.ActionTypes = []string{}
+			diags.Append(entry.ActionTypes.ElementsAs(ctx, &compatibility.ActionTypes, false)...)
+		}
+		if diags.HasError() {
+			return nil, diags
+		}
+		table[dataSourceType] = compatibility
+	}
+	return table, diags
+}
+
+// ValidateDataSourceCompatibility checks a scope and action type against the entry of table for dataSourceType. It
+// returns an empty string when they are compatible, when the data source type has no entry, or for an empty type.
+// This function is exported for testing purposes.
+func ValidateDataSourceCompatibility(table map[string]DataSourceCompatibility, dataSourceType, scopeType, actionType string) string {
+	compatibility, ok := table[dataSourceType]
+	if !ok {
+		return ""
+	}
+
+	var incompatible []string
+	if scopeType != "" && compatibility.ScopeTypes != nil && !slices.Contains(compatibility.ScopeTypes, scopeType) {
+		incompatible = append(incompatible, fmt.Sprintf("scope type '%s' (compatible: '%s')", scopeType, strings.Join(compatibility.ScopeTypes, "', '")))
+	}
+	if actionType != "" && compatibility.ActionTypes != nil && !slices.Contains(compatibility.ActionTypes, actionType) {
+		incompatible = append(incompatible, fmt.Sprintf("action type '%s' (compatible: '%s')", actionType, strings.Join(compatibility.ActionTypes, "', '")))
+	}
+	if len(incompatible) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Its data source type '%s' is not compatible with the policy %s, according to data_source_compatibility.",
+		dataSourceType, strings.Join(incompatible, " and "))
+}
+
+// ValidateApplicationLabelKey checks an application label key against the provider application_label_key_pattern.
+// It returns an empty string when the key matches.
+// This function is exported for testing purposes.
+func ValidateApplicationLabelKey(key string, pattern *regexp.Regexp) string {
+	if pattern.MatchString(key) {
+		return ""
+	}
+	return fmt.Sprintf("Application label key '%s' does not match the provider application_label_key_pattern '%s'. "+
+		"A label with this key never matches an application.", key, pattern.String())
+}
+
+// applyDefaultScope plans the scope keys left unset in the configuration: the provider default_project_key and
+// default_application_keys for the matching scope type, null otherwise. Keys set in the configuration are kept.
+func applyDefaultScope(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	scopePath := path.Root("scope")
+	var configScope types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, scopePath, &configScope)...)
+	if resp.Diagnostics.HasError() || configScope.IsNull() || configScope.IsUnknown() {
+		return
+	}
+
+	var scopeType types.String
+	var projectKeys, applicationKeys, applicationLabels types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, scopePath.AtName("type"), &scopeType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, scopePath.AtName("project_keys"), &projectKeys)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, scopePath.AtName("application_keys"), &applicationKeys)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, scopePath.AtName("application_labels"), &applicationLabels)...)
+	if resp.Diagnostics.HasError() || scopeType.IsUnknown() || projectKeys.IsUnknown() || applicationKeys.IsUnknown() || applicationLabels.IsUnknown() {
+		return
+	}
+
+	scope := LifecycleScope{Type: scopeType.ValueString()}
+	resp.Diagnostics.Append(projectKeys.ElementsAs(ctx, &scope.ProjectKeys, false)...)
+	resp.Diagnostics.Append(applicationKeys.ElementsAs(ctx, &scope.ApplicationKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Only the number of labels matters for the defaults
+	scope.ApplicationLabels = make([]ApplicationLabel, len(applicationLabels.Elements()))
+	scope = ApplyDefaultScope(scope, providerData.DefaultProjectKey, providerData.DefaultApplicationKeys)
+
+	if projectKeys.IsNull() {
+		tflog.Debug(ctx, "Planning default scope project keys", map[string]interface{}{
+			"project_keys": scope.ProjectKeys,
+		})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, scopePath.AtName("project_keys"), scopeKeysValue(scope.ProjectKeys))...)
+	}
+	if applicationKeys.IsNull() {
+		tflog.Debug(ctx, "Planning default scope application keys", map[string]interface{}{
+			"application_keys": scope.ApplicationKeys,
+		})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, scopePath.AtName("application_keys"), scopeKeysValue(scope.ApplicationKeys))...)
+	}
 }
 
-// toAPIModel converts the Terraform resource model to the API request model.
-func (m *LifecyclePolicyResourceModel) toAPIModel(ctx context.Context) (LifecyclePolicyAPIModel, diag.Diagnostics) {
+// planScopeDescription plans scope_description from the planned scope, including the default scope keys, so it is
+// known at plan time and unchanged while the scope is.
+func planScopeDescription(ctx context.Context, resp *resource.ModifyPlanResponse) {
+	var scope types.Object
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("scope"), &scope)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	description := types.StringUnknown()
+	if lifecycleScope, ok := lifecycleScopeFromObject(ctx, scope); ok {
+		description = types.StringValue(ScopeDescription(lifecycleScope))
+	} else if scope.IsNull() {
+		description = types.StringNull()
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("scope_description"), description)...)
+}
+
+// lifecycleScopeFromObject converts a scope value. ok is false when the scope is null or not fully known.
+func lifecycleScopeFromObject(ctx context.Context, scope types.Object) (LifecycleScope, bool) {
+	if scope.IsNull() || scope.IsUnknown() {
+		return LifecycleScope{}, false
+	}
+	value, err := scope.ToTerraformValue(ctx)
+	if err != nil || !value.IsFullyKnown() {
+		return LifecycleScope{}, false
+	}
+
+	var model LifecycleScopeModel
+	if diags := scope.As(ctx, &model, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return LifecycleScope{}, false
+	}
+	result := LifecycleScope{Type: model.Type.ValueString()}
+	var labels []ApplicationLabelModel
+	diags := model.ProjectKeys.ElementsAs(ctx, &result.ProjectKeys, false)
+	diags.Append(model.ApplicationKeys.ElementsAs(ctx, &result.ApplicationKeys, false)...)
+	diags.Append(model.ApplicationLabels.ElementsAs(ctx, &labels, false)...)
+	if diags.HasError() {
+		return LifecycleScope{}, false
+	}
+	for _, label := range labels {
+		result.ApplicationLabels = append(result.ApplicationLabels, ApplicationLabel{Key: label.Key.ValueString(), Value: label.Value.ValueString()})
+	}
+	return result, true
+}
+
+func scopeKeysValue(keys []string) types.List {
+	if len(keys) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	values := make([]attr.Value, len(keys))
+	for i, key := range keys {
+		values[i] = types.StringValue(key)
+	}
+	return types.ListValueMust(types.StringType, values)
+}
+
+// ApplyDefaultScope fills the scope keys from the provider defaults: project scopes without project keys get
+// defaultProjectKey, and application scopes with neither application keys nor labels get defaultApplicationKeys.
+// Keys that are set, and defaults for the other scope type, are left alone.
+// This function is exported for testing purposes.
+func ApplyDefaultScope(scope LifecycleScope, defaultProjectKey string, defaultApplicationKeys []string) LifecycleScope {
+	switch scope.Type {
+	case "project":
+		if len(scope.ProjectKeys) == 0 && defaultProjectKey != "" {
+			scope.ProjectKeys = []string{defaultProjectKey}
+		}
+	case "application":
+		if len(scope.ApplicationKeys) == 0 && len(scope.ApplicationLabels) == 0 && len(defaultApplicationKeys) > 0 {
+			scope.ApplicationKeys = append([]string{}, defaultApplicationKeys...)
+		}
+	}
+	return scope
+}
+
+// toAPIModel converts the Terraform resource model to the API request model. rule_ids may hold at most maxRuleIDs
+// rule IDs; no limit is applied when maxRuleIDs is not positive.
+func (m *LifecyclePolicyResourceModel) toAPIModel(ctx context.Context, namePrefix, descriptionPrefix string, maxRuleIDs int) (LifecyclePolicyAPIModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// API requires these on Create and Update (full body); validate before sending
@@ -293,16 +1106,20 @@ func (m *LifecyclePolicyResourceModel) toAPIModel(ctx context.Context) (Lifecycl
 	}
 
 	apiModel := LifecyclePolicyAPIModel{
-		Name:    m.Name.ValueString(),
+		Name:    unifiedpolicy.PrefixName(namePrefix, m.Name.ValueString()),
 		Enabled: m.Enabled.ValueBool(),
 		Mode:    m.Mode.ValueString(),
 	}
+	// effective_enabled is false while the provider disables all policies
+	if !m.EffectiveEnabled.IsNull() && !m.EffectiveEnabled.IsUnknown() {
+		apiModel.Enabled = m.EffectiveEnabled.ValueBool()
+	}
 
 	if !m.Description.IsNull() && !m.Description.IsUnknown() {
 		descriptionValue := m.Description.ValueString()
 		// Only include description if it's not empty (empty string should be treated as null/omitted)
 		if descriptionValue != "" {
-			apiModel.Description = descriptionValue
+			apiModel.Description = unifiedpolicy.PrefixDescription(descriptionPrefix, descriptionValue)
 		}
 		// If description is empty string, don't include it in the request (treat as null)
 	}
@@ -323,52 +1140,51 @@ func (m *LifecyclePolicyResourceModel) toAPIModel(ctx context.Context) (Lifecycl
 			Type: typeValue.ValueString(),
 		}
 
-		// Extract stage (nested block) - Required by API when action is present
+		// Extract stage (nested block) - whether it is required depends on the action type
 		stageAttr, stageExists := actionAttrs["stage"]
-		if !stageExists || stageAttr.IsNull() {
-			diags.AddError(
-				"Missing Required Field",
-				"action.stage is required when action is specified. Both stage.key and stage.gate must be provided.",
-			)
-			return apiModel, diags
-		}
-
-		stageObj, ok := stageAttr.(types.Object)
-		if !ok {
-			diags.AddError(
-				"Invalid Stage Configuration",
-				"action.stage must be an object with 'key' and 'gate' attributes.",
-			)
+		hasStage := stageExists && !stageAttr.IsNull()
+		if message := ValidateActionStage(typeValue.ValueString(), hasStage); message != "" {
+			diags.AddError("Invalid Action Stage", message)
 			return apiModel, diags
 		}
+		if hasStage {
+			stageObj, ok := stageAttr.(types.Object)
+			if !ok {
+				diags.AddError(
+					"Invalid Stage Configuration",
+					"action.stage must be an object with 'key' and 'gate' attributes.",
+				)
+				return apiModel, diags
+			}
 
-		stageAttrs := stageObj.Attributes()
-		keyValue := types.StringNull()
-		gateValue := types.StringNull()
+			stageAttrs := stageObj.Attributes()
+			keyValue := types.StringNull()
+			gateValue := types.StringNull()
 
-		if keyAttr, ok := stageAttrs["key"]; ok {
-			if kv, ok := keyAttr.(types.String); ok {
-				keyValue = kv
+			if keyAttr, ok := stageAttrs["key"]; ok {
+				if kv, ok := keyAttr.(types.String); ok {
+					keyValue = kv
+				}
 			}
-		}
-		if gateAttr, ok := stageAttrs["gate"]; ok {
-			if gv, ok := gateAttr.(types.String); ok {
-				gateValue = gv
+			if gateAttr, ok := stageAttrs["gate"]; ok {
+				if gv, ok := gateAttr.(types.String); ok {
+					gateValue = gv
+				}
 			}
-		}
 
-		// Validate that both key and gate are provided (required by API)
-		if keyValue.IsNull() || gateValue.IsNull() {
-			diags.AddError(
-				"Missing Required Stage Fields",
-				"action.stage.key and action.stage.gate are both required when action is specified.",
-			)
-			return apiModel, diags
-		}
+			// Validate that both key and gate are provided (required by API)
+			if keyValue.IsNull() || gateValue.IsNull() {
+				diags.AddError(
+					"Missing Required Stage Fields",
+					"action.stage.key and action.stage.gate are both required when action.stage is specified.",
+				)
+				return apiModel, diags
+			}
 
-		apiModel.Action.Stage = &LifecycleStage{
-			Key:  keyValue.ValueString(),
-			Gate: gateValue.ValueString(),
+			apiModel.Action.Stage = &LifecycleStage{
+				Key:  keyValue.ValueString(),
+				Gate: gateValue.ValueString(),
+			}
 		}
 	}
 
@@ -395,27 +1211,23 @@ func (m *LifecyclePolicyResourceModel) toAPIModel(ctx context.Context) (Lifecycl
 		}
 
 		// Convert project_keys
-		var hasProjectKeys bool
 		if projectKeysAttr, ok := scopeAttrs["project_keys"]; ok && !projectKeysAttr.IsNull() {
 			if projectKeysList, ok := projectKeysAttr.(types.List); ok {
 				var projectKeys []string
 				diags.Append(projectKeysList.ElementsAs(ctx, &projectKeys, false)...)
 				if !diags.HasError() && len(projectKeys) > 0 {
 					apiModel.Scope.ProjectKeys = projectKeys
-					hasProjectKeys = true
 				}
 			}
 		}
 
 		// Convert application_keys
-		var hasApplicationKeys bool
 		if applicationKeysAttr, ok := scopeAttrs["application_keys"]; ok && !applicationKeysAttr.IsNull() {
 			if applicationKeysList, ok := applicationKeysAttr.(types.List); ok {
 				var applicationKeys []string
 				diags.Append(applicationKeysList.ElementsAs(ctx, &applicationKeys, false)...)
 				if !diags.HasError() && len(applicationKeys) > 0 {
 					apiModel.Scope.ApplicationKeys = applicationKeys
-					hasApplicationKeys = true
 				}
 			}
 		}
@@ -457,26 +1269,8 @@ func (m *LifecyclePolicyResourceModel) toAPIModel(ctx context.Context) (Lifecycl
 			}
 		}
 
-		// Validate scope requirements per API: project scope requires exactly one project key; application scope may use application_keys and/or application_labels
-		if scopeType == "project" && !hasProjectKeys {
-			diags.AddError(
-				"Invalid Scope Configuration",
-				"Scope type 'project' requires project_keys with exactly one project key.",
-			)
-			return apiModel, diags
-		}
-		if scopeType == "project" && hasProjectKeys && len(apiModel.Scope.ProjectKeys) != 1 {
-			diags.AddError(
-				"Invalid Scope Configuration",
-				"project_keys must contain exactly one project key (API validation).",
-			)
-			return apiModel, diags
-		}
-		if scopeType == "application" && !hasApplicationKeys && len(apiModel.Scope.ApplicationLabels) == 0 {
-			diags.AddError(
-				"Invalid Scope Configuration",
-				"Scope type 'application' requires application_keys and/or application_labels.",
-			)
+		if msg := ValidateLifecycleScope(*apiModel.Scope); msg != "" {
+			diags.AddError("Invalid Scope Configuration", msg)
 			return apiModel, diags
 		}
 	}
@@ -496,7 +1290,7 @@ func (m *LifecyclePolicyResourceModel) toAPIModel(ctx context.Context) (Lifecycl
 		return apiModel, diags
 	}
 
-	// Validate: API requires at least one and at most one rule ID per policy
+	// Validate: API requires at least one rule ID per policy, and at most max_rules_per_policy
 	if len(ruleIDs) == 0 {
 		diags.AddError(
 			"Invalid Rule IDs",
@@ -504,19 +1298,194 @@ func (m *LifecyclePolicyResourceModel) toAPIModel(ctx context.Context) (Lifecycl
 		)
 		return apiModel, diags
 	}
-	if len(ruleIDs) > 1 {
+	if maxRuleIDs > 0 && len(ruleIDs) > maxRuleIDs {
 		diags.AddError(
 			"Invalid Rule IDs",
-			"rule_ids must contain maximum 1 item (API allows only one rule per policy).",
+			ruleIDCountError(len(ruleIDs), maxRuleIDs),
 		)
 		return apiModel, diags
 	}
 
 	apiModel.RuleIDs = ruleIDs
 
+	if !m.Priority.IsNull() && !m.Priority.IsUnknown() {
+		apiModel.Priority = m.Priority.ValueInt64Pointer()
+	}
+
 	return apiModel, diags
 }
 
+// ValidateActionStage checks whether an action.stage block is present as LifecycleActionTypes requires for the
+// action type. It returns an empty string when the combination is valid; unknown action types are left to the
+// schema validator.
+// This function is exported for testing purposes.
+func ValidateActionStage(actionType string, hasStage bool) string {
+	switch LifecycleActionTypes[actionType] {
+	case StageRequired:
+		if !hasStage {
+			return fmt.Sprintf("action.stage is required for action type '%s'. Both stage.key and stage.gate must be provided.", actionType)
+		}
+	case StageForbidden:
+		if hasStage {
+			return fmt.Sprintf("action.stage is not allowed for action type '%s'. Remove the stage block.", actionType)
+		}
+	}
+	return ""
+}
+
+// ValidateStageGate checks a stage key and gate against requiredGates, which maps stage keys to the only gate
+// allowed on them. It returns an empty string when the stage has no required gate or uses it.
+// This function is exported for testing purposes.
+func ValidateStageGate(requiredGates map[string]string, stageKey, gate string) string {
+	requiredGate, ok := requiredGates[stageKey]
+	if !ok || gate == requiredGate {
+		return ""
+	}
+	return fmt.Sprintf("Stage '%s' requires gate '%s' (provider required_stage_gates), got '%s'.", stageKey, requiredGate, gate)
+}
+
+// ValidateLifecycleScope checks a scope against the API requirements: project scope requires exactly one
+// project key; application scope requires application_keys and/or application_labels. It returns an empty
+// string when the scope is valid.
+// This function is exported for testing purposes.
+func ValidateLifecycleScope(scope LifecycleScope) string {
+	switch scope.Type {
+	case "project":
+		if len(scope.ProjectKeys) == 0 {
+			return "Scope type 'project' requires project_keys with exactly one project key."
+		}
+		if len(scope.ProjectKeys) != 1 {
+			return "project_keys must contain exactly one project key (API validation)."
+		}
+	case "application":
+		if len(scope.ApplicationKeys) == 0 && len(scope.ApplicationLabels) == 0 {
+			return "Scope type 'application' requires application_keys and/or application_labels."
+		}
+	}
+	return ""
+}
+
+// validateBeforeEnforce checks, when the provider sets validate_before_enforce and the policy is sent enabled in block
+// mode, that each referenced rule and its template exist and that the template Rego code stored on the backend is
+// valid, so enforcement is never activated on a broken rule. It runs at apply, right before the policy is sent, since
+// rules and templates created in the same apply cannot be read at plan time.
+func (r *LifecyclePolicyResource) validateBeforeEnforce(ctx context.Context, policy LifecyclePolicyAPIModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !r.ProviderData.ValidateBeforeEnforce || !policy.Enabled || policy.Mode != unifiedpolicy.PolicyModeBlock {
+		return diags
+	}
+
+	rulesPath := path.Root("rule_ids")
+	for _, ruleID := range policy.RuleIDs {
+		var rule RuleAPIModel
+		httpResponse, err := r.ProviderData.Client.R().
+			SetContext(ctx).
+			SetPathParam("rule_id", ruleID).
+			SetResult(&rule).
+			Get(r.ProviderData.Endpoint(RuleEndpoint))
+		if err != nil {
+			diags.AddAttributeError(rulesPath, "Unable to Validate Policy Rule",
+				fmt.Sprintf("An error occurred while reading rule '%s': %s", ruleID, err.Error()))
+			continue
+		}
+		if r.ProviderData.IsNotFoundStatus(httpResponse.StatusCode()) {
+			diags.AddAttributeError(rulesPath, "Policy Rule Not Found",
+				fmt.Sprintf("Rule '%s' does not exist, so the policy cannot be enforced in block mode. "+
+					"The policy was not sent because the provider sets validate_before_enforce.", ruleID))
+			continue
+		}
+		if httpResponse.IsError() {
+			diags.Append(unifiedpolicy.HandleAPIErrorWithType(httpResponse, "read", "rule")...)
+			continue
+		}
+
+		var template TemplateAPIModel
+		httpResponse, err = r.ProviderData.Client.R().
+			SetContext(ctx).
+			SetPathParam("templateId", rule.TemplateID).
+			SetResult(&template).
+			Get(r.ProviderData.Endpoint(TemplateEndpoint))
+		if err != nil {
+			diags.AddAttributeError(rulesPath, "Unable to Validate Policy Rule",
+				fmt.Sprintf("An error occurred while reading template '%s' of rule '%s' (ID '%s'): %s", rule.TemplateID, rule.Name, ruleID, err.Error()))
+			continue
+		}
+		if r.ProviderData.IsNotFoundStatus(httpResponse.StatusCode()) {
+			diags.AddAttributeError(rulesPath, "Policy Rule Template Not Found",
+				fmt.Sprintf("Template '%s' of rule '%s' (ID '%s') does not exist, so the policy cannot be enforced in block mode. "+
+					"The policy was not sent because the provider sets validate_before_enforce.", rule.TemplateID, rule.Name, ruleID))
+			continue
+		}
+		if httpResponse.IsError() {
+			diags.Append(unifiedpolicy.HandleAPIErrorWithType(httpResponse, "read", "template")...)
+			continue
+		}
+
+		if problems := StoredRegoProblems(template.Rego, r.ProviderData.ExtraAllowedRegoOperations); len(problems) > 0 {
+			diags.AddAttributeError(rulesPath, "Invalid Policy Rule Template",
+				fmt.Sprintf("The Rego code of template '%s' (ID '%s'), used by rule '%s' (ID '%s'), is not valid, so the policy cannot be "+
+					"enforced in block mode. The policy was not sent because the provider sets validate_before_enforce.\n- %s",
+					template.Name, rule.TemplateID, rule.Name, ruleID, strings.Join(problems, "\n- ")))
+		}
+	}
+	return diags
+}
+
+// StoredRegoProblems validates Rego code read from the backend, which does not store its Rego version: the code is
+// valid when it parses as Rego v0 or v1 and uses only allowed operations. It returns nil when the code is valid.
+// This function is exported for testing purposes.
+func StoredRegoProblems(regoCode string, extraOps []string) []string {
+	result := ValidateRegoCode(regoCode, false, 0, RegoVersionV0, extraOps)
+	if result.Error != "" {
+		if v1 := ValidateRegoCode(regoCode, false, 0, RegoVersionV1, extraOps); v1.Error == "" {
+			result = v1
+		}
+	}
+	return result.Problems()
+}
+
+// ScopeDescription renders a scope in plain English, e.g. "project PROD-1", "applications app-1, app-2" or
+// "applications labeled environment=production and tier=web" (a policy applies to applications with every label).
+// Keys and labels are listed in scope order. Shared by the lifecycle policy resource and datasources.
+func ScopeDescription(scope LifecycleScope) string {
+	switch scope.Type {
+	case "project":
+		return describeScopeKeys("project", "projects", scope.ProjectKeys)
+	case "application":
+		var parts []string
+		if len(scope.ApplicationKeys) > 0 {
+			parts = append(parts, describeScopeKeys("application", "applications", scope.ApplicationKeys))
+		}
+		if len(scope.ApplicationLabels) > 0 {
+			labels := make([]string, len(scope.ApplicationLabels))
+			for i, label := range scope.ApplicationLabels {
+				labels[i] = label.Key + "=" + label.Value
+			}
+			parts = append(parts, "applications labeled "+strings.Join(labels, " and "))
+		}
+		if len(parts) == 0 {
+			return "application scope"
+		}
+		return strings.Join(parts, " and ")
+	case "":
+		return ""
+	default:
+		return scope.Type + " scope"
+	}
+}
+
+// describeScopeKeys lists scope keys after the singular or plural kind.
+func describeScopeKeys(singular, plural string, keys []string) string {
+	switch len(keys) {
+	case 0:
+		return singular + " scope"
+	case 1:
+		return singular + " " + keys[0]
+	default:
+		return plural + " " + strings.Join(keys, ", ")
+	}
+}
+
 func (r *LifecyclePolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
@@ -526,12 +1495,20 @@ func (r *LifecyclePolicyResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	apiModel, diags := plan.toAPIModel(ctx)
+	if plan.EffectiveEnabled.IsUnknown() {
+		plan.EffectiveEnabled = types.BoolValue(plan.Enabled.ValueBool() && !r.ProviderData.DisableAllPolicies)
+	}
+	apiModel, diags := plan.toAPIModel(ctx, r.ProviderData.NamePrefix, r.ProviderData.DescriptionPrefix, r.ProviderData.MaxRulesPerPolicy)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.validateBeforeEnforce(ctx, apiModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Log the API model for debugging
 	apiModelJSON, _ := json.Marshal(apiModel)
 	tflog.Debug(ctx, "API request details", map[string]interface{}{
@@ -546,7 +1523,7 @@ func (r *LifecyclePolicyResource) Create(ctx context.Context, req resource.Creat
 		SetContext(ctx).
 		SetBody(apiModel).
 		SetResult(&apiResponse).
-		Post(PoliciesEndpoint)
+		Post(r.ProviderData.Endpoint(PoliciesEndpoint))
 
 	if err != nil {
 		tflog.Error(ctx, "Failed to send create request", map[string]interface{}{
@@ -557,15 +1534,28 @@ func (r *LifecyclePolicyResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	// API returns 201 Created on success
-	if httpResponse.StatusCode() != http.StatusCreated {
+	if httpResponse.StatusCode() == http.StatusConflict && adoptOnConflict(r.ProviderData) {
+		httpResponse, diags = ResolveCreateConflict(ctx, r.ProviderData, CreateConflict{
+			Kind:         "policy",
+			Title:        "Policy",
+			Name:         apiModel.Name,
+			ListEndpoint: PoliciesEndpoint,
+			ItemEndpoint: PolicyEndpoint,
+			PathParam:    "policyId",
+		}, apiModel, &apiResponse)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if httpResponse.StatusCode() != http.StatusCreated {
+		// API returns 201 Created on success
 		if httpResponse.StatusCode() == http.StatusConflict {
 			tflog.Warn(ctx, "Policy already exists", map[string]interface{}{
 				"name": plan.Name.ValueString(),
 			})
 			resp.Diagnostics.AddError(
 				"Policy Already Exists",
-				fmt.Sprintf("A policy with name '%s' already exists. Please use a different name.", plan.Name.ValueString()),
+				fmt.Sprintf("A policy with name '%s' already exists. Please use a different name.", apiModel.Name),
 			)
 			return
 		}
@@ -587,7 +1577,7 @@ func (r *LifecyclePolicyResource) Create(ctx context.Context, req resource.Creat
 		"status_code": httpResponse.StatusCode(),
 	})
 
-	diags = plan.fromAPIModel(ctx, apiResponse, &plan)
+	diags = plan.fromAPIModel(ctx, apiResponse, &plan, r.ProviderData.NamePrefix, r.ProviderData.DescriptionPrefix)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -596,24 +1586,35 @@ func (r *LifecyclePolicyResource) Create(ctx context.Context, req resource.Creat
 	// Ensure ID is set
 	plan.ID = types.StringValue(apiResponse.ID)
 
+	resp.Diagnostics.Append(unifiedpolicy.SetPrivateExtraFields(ctx, resp.Private, apiResponse.ExtraFields)...)
+	if r.ProviderData.UseETags {
+		resp.Diagnostics.Append(unifiedpolicy.SetPrivateETag(ctx, resp.Private, httpResponse)...)
+	}
+	plan.ChangeSummary = resolveChangeSummary(plan.ChangeSummary)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // fromAPIModel converts the API response model to the Terraform resource model.
 // labelsFallback: when the API does not return application_labels (known limitation), use this model's scope.application_labels so state stays consistent after Create/Update/Read.
-func (m *LifecyclePolicyResourceModel) fromAPIModel(ctx context.Context, apiModel LifecyclePolicyAPIModel, labelsFallback *LifecyclePolicyResourceModel) diag.Diagnostics {
+// Its enabled value is also kept while the policy is disabled only because of the provider disable_all_policies.
+func (m *LifecyclePolicyResourceModel) fromAPIModel(ctx context.Context, apiModel LifecyclePolicyAPIModel, labelsFallback *LifecyclePolicyResourceModel, namePrefix, descriptionPrefix string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	// Set basic fields
 	m.ID = types.StringValue(apiModel.ID)
-	m.Name = types.StringValue(apiModel.Name)
+	m.Name = types.StringValue(unifiedpolicy.StripNamePrefix(namePrefix, apiModel.Name))
 	m.Enabled = types.BoolValue(apiModel.Enabled)
+	m.EffectiveEnabled = types.BoolValue(apiModel.Enabled)
+	if !apiModel.Enabled && labelsFallback != nil && labelsFallback.Enabled.ValueBool() &&
+		!labelsFallback.EffectiveEnabled.IsNull() && !labelsFallback.EffectiveEnabled.IsUnknown() && !labelsFallback.EffectiveEnabled.ValueBool() {
+		m.Enabled = labelsFallback.Enabled
+	}
 	m.Mode = types.StringValue(apiModel.Mode)
 
 	// Handle description: API may return empty string or omit it entirely.
 	// When API returns "", preserve the fallback (plan/state) value so that explicit description = "" stays "" in state.
-	if apiModel.Description != "" {
-		m.Description = types.StringValue(apiModel.Description)
+	if description := unifiedpolicy.StripDescriptionPrefix(descriptionPrefix, apiModel.Description); description != "" {
+		m.Description = types.StringValue(description)
 	} else if labelsFallback != nil && !labelsFallback.Description.IsNull() {
 		m.Description = labelsFallback.Description
 	} else {
@@ -758,7 +1759,13 @@ func (m *LifecyclePolicyResourceModel) fromAPIModel(ctx context.Context, apiMode
 			},
 		)
 		m.Scope = scopeValue
+		// Derived from the stored scope, which may keep labels from labelsFallback, so it matches the plan
+		m.ScopeDescription = types.StringNull()
+		if scope, ok := lifecycleScopeFromObject(ctx, m.Scope); ok {
+			m.ScopeDescription = types.StringValue(ScopeDescription(scope))
+		}
 	} else {
+		m.ScopeDescription = types.StringNull()
 		m.Scope = types.ObjectNull(map[string]attr.Type{
 			"type":             types.StringType,
 			"project_keys":     types.ListType{ElemType: types.StringType},
@@ -774,7 +1781,14 @@ func (m *LifecyclePolicyResourceModel) fromAPIModel(ctx context.Context, apiMode
 		})
 	}
 
-	// Convert rule_ids (API returns rule_ids on Create/Get/Update/List)
+	// Convert rule_ids (API returns rule_ids on Create/Get/Update/List), keeping the planned or prior order of
+	// multiple rule IDs when the API returns them reordered
+	if !m.RuleIDs.IsNull() && !m.RuleIDs.IsUnknown() {
+		var configuredRuleIDs []string
+		if d := m.RuleIDs.ElementsAs(ctx, &configuredRuleIDs, false); !d.HasError() {
+			apiModel.RuleIDs = ReconcileRuleIDOrder(configuredRuleIDs, apiModel.RuleIDs)
+		}
+	}
 	if len(apiModel.RuleIDs) > 0 {
 		ruleIDValues := make([]attr.Value, len(apiModel.RuleIDs))
 		for i, ruleID := range apiModel.RuleIDs {
@@ -785,6 +1799,8 @@ func (m *LifecyclePolicyResourceModel) fromAPIModel(ctx context.Context, apiMode
 		m.RuleIDs = types.ListNull(types.StringType)
 	}
 
+	m.Priority = types.Int64PointerValue(apiModel.Priority)
+
 	return diags
 }
 
@@ -821,7 +1837,7 @@ func (r *LifecyclePolicyResource) Read(ctx context.Context, req resource.ReadReq
 		SetContext(ctx).
 		SetPathParam("policyId", policyID).
 		SetResult(&apiResponse).
-		Get(PolicyEndpoint)
+		Get(r.ProviderData.Endpoint(PolicyEndpoint))
 
 	if err != nil {
 		tflog.Error(ctx, "Failed to send read request", map[string]interface{}{
@@ -834,7 +1850,7 @@ func (r *LifecyclePolicyResource) Read(ctx context.Context, req resource.ReadReq
 
 	// API returns 200 OK on successful read
 	if httpResponse.StatusCode() != http.StatusOK {
-		if httpResponse.StatusCode() == http.StatusNotFound {
+		if r.ProviderData.IsNotFoundStatus(httpResponse.StatusCode()) {
 			tflog.Warn(ctx, "Policy not found, removing from state", map[string]interface{}{
 				"policy_id": policyID,
 			})
@@ -858,7 +1874,7 @@ func (r *LifecyclePolicyResource) Read(ctx context.Context, req resource.ReadReq
 		"status_code": httpResponse.StatusCode(),
 	})
 
-	diags := state.fromAPIModel(ctx, apiResponse, &state)
+	diags := state.fromAPIModel(ctx, apiResponse, &state, r.ProviderData.NamePrefix, r.ProviderData.DescriptionPrefix)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -867,6 +1883,12 @@ func (r *LifecyclePolicyResource) Read(ctx context.Context, req resource.ReadReq
 	// Ensure ID is set
 	state.ID = types.StringValue(apiResponse.ID)
 
+	resp.Diagnostics.Append(unifiedpolicy.SetPrivateExtraFields(ctx, resp.Private, apiResponse.ExtraFields)...)
+	if r.ProviderData.UseETags {
+		resp.Diagnostics.Append(unifiedpolicy.SetPrivateETag(ctx, resp.Private, httpResponse)...)
+	}
+	// change_summary only describes a plan, see planChangeSummary
+	state.ChangeSummary = types.StringNull()
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -890,29 +1912,61 @@ func (r *LifecyclePolicyResource) Update(ctx context.Context, req resource.Updat
 		policyID = state.ID.ValueString()
 	}
 
-	apiModel, diags := plan.toAPIModel(ctx)
+	if plan.EffectiveEnabled.IsUnknown() {
+		plan.EffectiveEnabled = types.BoolValue(plan.Enabled.ValueBool() && !r.ProviderData.DisableAllPolicies)
+	}
+	apiModel, diags := plan.toAPIModel(ctx, r.ProviderData.NamePrefix, r.ProviderData.DescriptionPrefix, r.ProviderData.MaxRulesPerPolicy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validateBeforeEnforce(ctx, apiModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Send back API fields from the last read that the provider does not model
+	extraFields, diags := unifiedpolicy.GetPrivateExtraFields(ctx, req.Private)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	apiModel.ExtraFields = extraFields
 
 	tflog.Info(ctx, "Updating lifecycle policy", map[string]interface{}{
 		"policy_id": policyID,
 	})
 
+	request := r.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParam("policyId", policyID).
+		SetBody(apiModel)
+
+	// Optimistic concurrency: only update the policy as it was at the last read
+	var etag string
+	if r.ProviderData.UseETags {
+		etag, diags = unifiedpolicy.GetPrivateETag(ctx, req.Private)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if etag != "" {
+			request.SetHeader("If-Match", etag)
+		}
+	}
+
 	tflog.Debug(ctx, "API request details", map[string]interface{}{
 		"endpoint":  PolicyEndpoint,
 		"method":    "PUT",
 		"policy_id": policyID,
+		"if_match":  etag,
 	})
 
 	var apiResponse LifecyclePolicyAPIModel
-	httpResponse, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("policyId", policyID).
-		SetBody(apiModel).
+	httpResponse, err := request.
 		SetResult(&apiResponse).
-		Put(PolicyEndpoint)
+		Put(r.ProviderData.Endpoint(PolicyEndpoint))
 
 	if err != nil {
 		tflog.Error(ctx, "Failed to send update request", map[string]interface{}{
@@ -935,6 +1989,18 @@ func (r *LifecyclePolicyResource) Update(ctx context.Context, req resource.Updat
 			)
 			return
 		}
+		if httpResponse.StatusCode() == http.StatusPreconditionFailed {
+			tflog.Warn(ctx, "Policy changed since last read during update", map[string]interface{}{
+				"policy_id": policyID,
+				"if_match":  etag,
+			})
+			resp.Diagnostics.AddError(
+				"Policy Update Conflict",
+				fmt.Sprintf("Policy with ID '%s' was modified outside of this Terraform run since it was last read, so the update was rejected "+
+					"to avoid overwriting those changes. Run 'terraform apply' again to refresh the policy and review the resulting plan.", policyID),
+			)
+			return
+		}
 		// Log full response for debugging
 		responseBody := string(httpResponse.Body())
 		tflog.Error(ctx, "API returned error during update", map[string]interface{}{
@@ -952,7 +2018,7 @@ func (r *LifecyclePolicyResource) Update(ctx context.Context, req resource.Updat
 		"status_code": httpResponse.StatusCode(),
 	})
 
-	diags = plan.fromAPIModel(ctx, apiResponse, &plan)
+	diags = plan.fromAPIModel(ctx, apiResponse, &plan, r.ProviderData.NamePrefix, r.ProviderData.DescriptionPrefix)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -961,6 +2027,11 @@ func (r *LifecyclePolicyResource) Update(ctx context.Context, req resource.Updat
 	// Ensure ID is set
 	plan.ID = types.StringValue(apiResponse.ID)
 
+	resp.Diagnostics.Append(unifiedpolicy.SetPrivateExtraFields(ctx, resp.Private, apiResponse.ExtraFields)...)
+	if r.ProviderData.UseETags {
+		resp.Diagnostics.Append(unifiedpolicy.SetPrivateETag(ctx, resp.Private, httpResponse)...)
+	}
+	plan.ChangeSummary = resolveChangeSummary(plan.ChangeSummary)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -975,6 +2046,14 @@ func (r *LifecyclePolicyResource) Delete(ctx context.Context, req resource.Delet
 
 	policyID := state.ID.ValueString()
 
+	if state.DisableBeforeDelete.ValueBool() && state.Enabled.ValueBool() {
+		found, diags := r.disableBeforeDelete(ctx, state, req.Private)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() || !found {
+			return
+		}
+	}
+
 	tflog.Info(ctx, "Deleting lifecycle policy", map[string]interface{}{
 		"policy_id": policyID,
 	})
@@ -988,7 +2067,7 @@ func (r *LifecyclePolicyResource) Delete(ctx context.Context, req resource.Delet
 	httpResponse, err := r.ProviderData.Client.R().
 		SetContext(ctx).
 		SetPathParam("policyId", policyID).
-		Delete(PolicyEndpoint)
+		Delete(r.ProviderData.Endpoint(PolicyEndpoint))
 
 	if err != nil {
 		tflog.Error(ctx, "Failed to send delete request", map[string]interface{}{
@@ -1000,7 +2079,14 @@ func (r *LifecyclePolicyResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	// API returns 204 No Content on successful delete
-	if httpResponse.StatusCode() == http.StatusNoContent {
+	if unifiedpolicy.IsDeleteSuccess(httpResponse) {
+		if httpResponse.StatusCode() == http.StatusNotFound {
+			// Resource already deleted (or deleted by an earlier attempt of a retried request), nothing to do
+			tflog.Warn(ctx, "Policy not found during delete, assuming already deleted", map[string]interface{}{
+				"policy_id": policyID,
+			})
+			return
+		}
 		tflog.Debug(ctx, "Lifecycle policy deleted successfully", map[string]interface{}{
 			"policy_id":   policyID,
 			"status_code": httpResponse.StatusCode(),
@@ -1008,14 +2094,6 @@ func (r *LifecyclePolicyResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	if httpResponse.StatusCode() == http.StatusNotFound {
-		tflog.Warn(ctx, "Policy not found during delete, assuming already deleted", map[string]interface{}{
-			"policy_id": policyID,
-		})
-		// Resource already deleted, nothing to do
-		return
-	}
-
 	// Handle other error status codes
 	responseBody := string(httpResponse.Body())
 	tflog.Error(ctx, "API returned error during delete", map[string]interface{}{
@@ -1027,6 +2105,106 @@ func (r *LifecyclePolicyResource) Delete(ctx context.Context, req resource.Delet
 	resp.Diagnostics.Append(errorDiags...)
 }
 
+// disableBeforeDelete disables the policy and waits for the configured grace period so that
+// promotions evaluated against it can complete before it is deleted. It returns false if the
+// policy no longer exists.
+func (r *LifecyclePolicyResource) disableBeforeDelete(ctx context.Context, state LifecyclePolicyResourceModel, private unifiedpolicy.PrivateStateGetter) (bool, diag.Diagnostics) {
+	policyID := state.ID.ValueString()
+
+	// The rule IDs in state were accepted by the API, so they are sent back without checking max_rules_per_policy
+	apiModel, diags := state.toAPIModel(ctx, r.ProviderData.NamePrefix, r.ProviderData.DescriptionPrefix, 0)
+	if diags.HasError() {
+		return false, diags
+	}
+	apiModel.Enabled = false
+
+	// Send back API fields from the last read that the provider does not model, as Update does
+	extraFields, extraDiags := unifiedpolicy.GetPrivateExtraFields(ctx, private)
+	diags.Append(extraDiags...)
+	if diags.HasError() {
+		return false, diags
+	}
+	apiModel.ExtraFields = extraFields
+
+	tflog.Info(ctx, "Disabling lifecycle policy before delete", map[string]interface{}{
+		"policy_id": policyID,
+	})
+
+	request := r.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParam("policyId", policyID).
+		SetBody(apiModel)
+
+	// Optimistic concurrency: only disable the policy as it was at the last read
+	var etag string
+	if r.ProviderData.UseETags {
+		var etagDiags diag.Diagnostics
+		etag, etagDiags = unifiedpolicy.GetPrivateETag(ctx, private)
+		diags.Append(etagDiags...)
+		if diags.HasError() {
+			return false, diags
+		}
+		if etag != "" {
+			request.SetHeader("If-Match", etag)
+		}
+	}
+
+	httpResponse, err := request.Put(r.ProviderData.Endpoint(PolicyEndpoint))
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Disable Policy",
+			fmt.Sprintf("An error occurred while disabling policy '%s' before delete: %s", policyID, err.Error()),
+		)
+		return false, diags
+	}
+
+	if httpResponse.StatusCode() == http.StatusNotFound {
+		tflog.Warn(ctx, "Policy not found while disabling before delete, assuming already deleted", map[string]interface{}{
+			"policy_id": policyID,
+		})
+		return false, diags
+	}
+
+	if httpResponse.StatusCode() == http.StatusPreconditionFailed {
+		tflog.Warn(ctx, "Policy changed since last read while disabling before delete", map[string]interface{}{
+			"policy_id": policyID,
+			"if_match":  etag,
+		})
+		diags.AddError(
+			"Policy Update Conflict",
+			fmt.Sprintf("Policy with ID '%s' was modified outside of this Terraform run since it was last read, so disabling it before delete was rejected "+
+				"to avoid overwriting those changes. Run 'terraform apply' again to refresh the policy and review the resulting plan.", policyID),
+		)
+		return false, diags
+	}
+
+	if httpResponse.StatusCode() != http.StatusOK {
+		diags.Append(unifiedpolicy.HandleAPIError(httpResponse, "update")...)
+		return false, diags
+	}
+
+	gracePeriod := time.Duration(state.DeleteGracePeriodSeconds.ValueInt64()) * time.Second
+	tflog.Info(ctx, "Lifecycle policy disabled, waiting for grace period before delete", map[string]interface{}{
+		"policy_id":    policyID,
+		"grace_period": gracePeriod.String(),
+	})
+
+	if gracePeriod > 0 {
+		select {
+		case <-time.After(gracePeriod):
+		case <-ctx.Done():
+			diags.AddError(
+				"Policy Delete Interrupted",
+				fmt.Sprintf("Policy '%s' was disabled but not deleted because the operation was cancelled during the grace period: %s", policyID, ctx.Err()),
+			)
+			return false, diags
+		}
+	}
+
+	return true, diags
+}
+
 func (r *LifecyclePolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -15,19 +15,1147 @@
 package resource_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
 )
 
 const policyEndpoint = "unifiedpolicy/api/v1/policies"
 
+// dataSourceCompatibilityNull is an unset data_source_compatibility attribute of a lifecycle policy.
+var dataSourceCompatibilityNull = types.MapNull(types.ObjectType{AttrTypes: map[string]attr.Type{
+	"scope_types":  types.ListType{ElemType: types.StringType},
+	"action_types": types.ListType{ElemType: types.StringType},
+}})
+
+func TestLifecyclePolicyModifyPlanDefaultPolicyMode(t *testing.T) {
+	ctx := context.Background()
+
+	r := &unifiedpolicyresource.LifecyclePolicyResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	policySchema := schemaResp.Schema
+
+	set := func(mode types.String) tftypes.Value {
+		m := unifiedpolicyresource.LifecyclePolicyResourceModel{
+			ID:                       types.StringUnknown(),
+			Name:                     types.StringValue("policy"),
+			Description:              types.StringNull(),
+			Enabled:                  types.BoolValue(false),
+			Mode:                     mode,
+			Action:                   types.ObjectNull(policySchema.Blocks["action"].Type().(types.ObjectType).AttrTypes),
+			Scope:                    types.ObjectNull(policySchema.Blocks["scope"].Type().(types.ObjectType).AttrTypes),
+			RuleIDs:                  types.ListNull(types.StringType),
+			Priority:                 types.Int64Null(),
+			DisableBeforeDelete:      types.BoolNull(),
+			DeleteGracePeriodSeconds: types.Int64Null(),
+			FailOnDisabledRule:       types.BoolNull(),
+			DataSourceCompatibility:  dataSourceCompatibilityNull,
+		}
+		state := tfsdk.State{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)}
+		if diags := state.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return state.Raw
+	}
+
+	tests := []struct {
+		name        string
+		defaultMode string
+		configMode  types.String
+		wantMode    string
+		wantErr     bool
+	}{
+		{name: "default used when mode omitted", defaultMode: "warning", configMode: types.StringNull(), wantMode: "warning"},
+		{name: "resource mode overrides default", defaultMode: "warning", configMode: types.StringValue("block"), wantMode: "block"},
+		{name: "no mode and no default", configMode: types.StringNull(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r.ProviderData = unifiedpolicy.ProviderMetadata{
+				ProviderMetadata:  util.ProviderMetadata{Client: resty.New()},
+				DefaultPolicyMode: tt.defaultMode,
+			}
+
+			planMode := tt.configMode
+			if planMode.IsNull() {
+				planMode = types.StringUnknown()
+			}
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: policySchema, Raw: set(tt.configMode)},
+				Plan:   tfsdk.Plan{Schema: policySchema, Raw: set(planMode)},
+				State:  tfsdk.State{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if tt.wantErr {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected an error when neither mode nor default_policy_mode is set")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var mode types.String
+			resp.Plan.GetAttribute(ctx, path.Root("mode"), &mode)
+			if mode.ValueString() != tt.wantMode {
+				t.Errorf("expected planned mode %q, got %q", tt.wantMode, mode.ValueString())
+			}
+		})
+	}
+}
+
+func TestLifecyclePolicyModifyPlanDisableAllPolicies(t *testing.T) {
+	ctx := context.Background()
+
+	r := &unifiedpolicyresource.LifecyclePolicyResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	policySchema := schemaResp.Schema
+
+	set := func(enabled bool, effectiveEnabled types.Bool) tftypes.Value {
+		m := unifiedpolicyresource.LifecyclePolicyResourceModel{
+			ID:                       types.StringValue("4001"),
+			Name:                     types.StringValue("policy"),
+			Description:              types.StringNull(),
+			Enabled:                  types.BoolValue(enabled),
+			Mode:                     types.StringValue("block"),
+			Action:                   types.ObjectNull(policySchema.Blocks["action"].Type().(types.ObjectType).AttrTypes),
+			Scope:                    types.ObjectNull(policySchema.Blocks["scope"].Type().(types.ObjectType).AttrTypes),
+			RuleIDs:                  types.ListNull(types.StringType),
+			Priority:                 types.Int64Null(),
+			DisableBeforeDelete:      types.BoolNull(),
+			DeleteGracePeriodSeconds: types.Int64Null(),
+			FailOnDisabledRule:       types.BoolNull(),
+			DataSourceCompatibility:  dataSourceCompatibilityNull,
+			EffectiveEnabled:         effectiveEnabled,
+		}
+		state := tfsdk.State{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)}
+		if diags := state.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return state.Raw
+	}
+
+	tests := []struct {
+		name          string
+		disableAll    bool
+		enabled       bool
+		stateEnabled  bool
+		wantEffective bool
+		wantWarning   bool
+	}{
+		{name: "kill switch disables an enabled policy", disableAll: true, enabled: true, stateEnabled: true, wantEffective: false, wantWarning: true},
+		{name: "kill switch keeps a disabled policy disabled", disableAll: true, enabled: false, stateEnabled: false, wantEffective: false},
+		{name: "configured value restored without kill switch", disableAll: false, enabled: true, stateEnabled: false, wantEffective: true},
+		{name: "configured value without kill switch", disableAll: false, enabled: false, stateEnabled: false, wantEffective: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r.ProviderData = unifiedpolicy.ProviderMetadata{
+				ProviderMetadata:   util.ProviderMetadata{Client: resty.New()},
+				DisableAllPolicies: tt.disableAll,
+			}
+
+			config := set(tt.enabled, types.BoolNull())
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: policySchema, Raw: config},
+				Plan:   tfsdk.Plan{Schema: policySchema, Raw: set(tt.enabled, types.BoolUnknown())},
+				State:  tfsdk.State{Schema: policySchema, Raw: set(tt.enabled, types.BoolValue(tt.stateEnabled))},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var enabled, effectiveEnabled types.Bool
+			resp.Plan.GetAttribute(ctx, path.Root("enabled"), &enabled)
+			resp.Plan.GetAttribute(ctx, path.Root("effective_enabled"), &effectiveEnabled)
+			if enabled.ValueBool() != tt.enabled {
+				t.Errorf("expected planned enabled to keep the configured value %v, got %v", tt.enabled, enabled)
+			}
+			if effectiveEnabled.IsUnknown() || effectiveEnabled.ValueBool() != tt.wantEffective {
+				t.Errorf("expected planned effective_enabled %v, got %v", tt.wantEffective, effectiveEnabled)
+			}
+			if hasWarning := resp.Diagnostics.WarningsCount() > 0; hasWarning != tt.wantWarning {
+				t.Errorf("expected warning %v, got diagnostics: %v", tt.wantWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestLifecyclePolicyModifyPlanDefaultScope(t *testing.T) {
+	ctx := context.Background()
+
+	r := &unifiedpolicyresource.LifecyclePolicyResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata:       util.ProviderMetadata{Client: resty.New()},
+			DefaultProjectKey:      "default-proj",
+			DefaultApplicationKeys: []string{"app-1"},
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	policySchema := schemaResp.Schema
+	scopeAttrTypes := policySchema.Blocks["scope"].Type().(types.ObjectType).AttrTypes
+	labelsType := scopeAttrTypes["application_labels"].(types.ListType)
+
+	keys := func(values ...string) types.List {
+		elements := make([]attr.Value, len(values))
+		for i, value := range values {
+			elements[i] = types.StringValue(value)
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+	set := func(scopeType string, projectKeys, applicationKeys types.List) tftypes.Value {
+		m := unifiedpolicyresource.LifecyclePolicyResourceModel{
+			ID:          types.StringUnknown(),
+			Name:        types.StringValue("policy"),
+			Description: types.StringNull(),
+			Enabled:     types.BoolValue(false),
+			Mode:        types.StringValue("block"),
+			Action:      types.ObjectNull(policySchema.Blocks["action"].Type().(types.ObjectType).AttrTypes),
+			Scope: types.ObjectValueMust(scopeAttrTypes, map[string]attr.Value{
+				"type":               types.StringValue(scopeType),
+				"project_keys":       projectKeys,
+				"application_keys":   applicationKeys,
+				"application_labels": types.ListValueMust(labelsType.ElemType, []attr.Value{}),
+			}),
+			RuleIDs:                  types.ListNull(types.StringType),
+			Priority:                 types.Int64Null(),
+			DisableBeforeDelete:      types.BoolNull(),
+			DeleteGracePeriodSeconds: types.Int64Null(),
+			FailOnDisabledRule:       types.BoolNull(),
+			DataSourceCompatibility:  dataSourceCompatibilityNull,
+		}
+		state := tfsdk.State{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)}
+		if diags := state.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return state.Raw
+	}
+
+	tests := []struct {
+		name                string
+		scopeType           string
+		projectKeys         types.List
+		applicationKeys     types.List
+		wantProjectKeys     types.List
+		wantApplicationKeys types.List
+		wantDescription     string
+	}{
+		{
+			name:                "default project key",
+			scopeType:           "project",
+			projectKeys:         types.ListNull(types.StringType),
+			applicationKeys:     types.ListNull(types.StringType),
+			wantProjectKeys:     keys("default-proj"),
+			wantApplicationKeys: types.ListNull(types.StringType),
+			wantDescription:     "project default-proj",
+		},
+		{
+			name:                "resource project key overrides default",
+			scopeType:           "project",
+			projectKeys:         keys("proj"),
+			applicationKeys:     types.ListNull(types.StringType),
+			wantProjectKeys:     keys("proj"),
+			wantApplicationKeys: types.ListNull(types.StringType),
+			wantDescription:     "project proj",
+		},
+		{
+			name:                "default application keys",
+			scopeType:           "application",
+			projectKeys:         types.ListNull(types.StringType),
+			applicationKeys:     types.ListNull(types.StringType),
+			wantProjectKeys:     types.ListNull(types.StringType),
+			wantApplicationKeys: keys("app-1"),
+			wantDescription:     "application app-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planProjectKeys, planApplicationKeys := tt.projectKeys, tt.applicationKeys
+			if planProjectKeys.IsNull() {
+				planProjectKeys = types.ListUnknown(types.StringType)
+			}
+			if planApplicationKeys.IsNull() {
+				planApplicationKeys = types.ListUnknown(types.StringType)
+			}
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: policySchema, Raw: set(tt.scopeType, tt.projectKeys, tt.applicationKeys)},
+				Plan:   tfsdk.Plan{Schema: policySchema, Raw: set(tt.scopeType, planProjectKeys, planApplicationKeys)},
+				State:  tfsdk.State{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var projectKeys, applicationKeys types.List
+			resp.Plan.GetAttribute(ctx, path.Root("scope").AtName("project_keys"), &projectKeys)
+			resp.Plan.GetAttribute(ctx, path.Root("scope").AtName("application_keys"), &applicationKeys)
+			if !projectKeys.Equal(tt.wantProjectKeys) {
+				t.Errorf("expected planned project_keys %s, got %s", tt.wantProjectKeys, projectKeys)
+			}
+			if !applicationKeys.Equal(tt.wantApplicationKeys) {
+				t.Errorf("expected planned application_keys %s, got %s", tt.wantApplicationKeys, applicationKeys)
+			}
+			// scope_description is planned from the scope including the default keys
+			var scopeDescription types.String
+			resp.Plan.GetAttribute(ctx, path.Root("scope_description"), &scopeDescription)
+			if scopeDescription.ValueString() != tt.wantDescription {
+				t.Errorf("expected planned scope_description %q, got %s", tt.wantDescription, scopeDescription)
+			}
+		})
+	}
+}
+
+func TestLifecyclePolicyModifyPlanApplicationLabelKeys(t *testing.T) {
+	ctx := context.Background()
+
+	r := &unifiedpolicyresource.LifecyclePolicyResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata:           util.ProviderMetadata{Client: resty.New()},
+			ApplicationLabelKeyPattern: regexp.MustCompile(`^[a-z0-9_.-]+$`),
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	policySchema := schemaResp.Schema
+	scopeAttrTypes := policySchema.Blocks["scope"].Type().(types.ObjectType).AttrTypes
+	labelType := scopeAttrTypes["application_labels"].(types.ListType).ElemType.(types.ObjectType)
+
+	labels := make([]attr.Value, 0, 3)
+	for _, key := range []string{"env", "Team Name", "tier"} {
+		labels = append(labels, types.ObjectValueMust(labelType.AttrTypes, map[string]attr.Value{
+			"key":   types.StringValue(key),
+			"value": types.StringValue("v"),
+		}))
+	}
+	m := unifiedpolicyresource.LifecyclePolicyResourceModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("policy"),
+		Description: types.StringNull(),
+		Enabled:     types.BoolValue(false),
+		Mode:        types.StringValue("block"),
+		Action:      types.ObjectNull(policySchema.Blocks["action"].Type().(types.ObjectType).AttrTypes),
+		Scope: types.ObjectValueMust(scopeAttrTypes, map[string]attr.Value{
+			"type":               types.StringValue("application"),
+			"project_keys":       types.ListNull(types.StringType),
+			"application_keys":   types.ListNull(types.StringType),
+			"application_labels": types.ListValueMust(labelType, labels),
+		}),
+		RuleIDs:                  types.ListNull(types.StringType),
+		Priority:                 types.Int64Null(),
+		DisableBeforeDelete:      types.BoolNull(),
+		DeleteGracePeriodSeconds: types.Int64Null(),
+		FailOnDisabledRule:       types.BoolNull(),
+		DataSourceCompatibility:  dataSourceCompatibilityNull,
+	}
+	state := tfsdk.State{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &m); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	req := fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: policySchema, Raw: state.Raw},
+		Plan:   tfsdk.Plan{Schema: policySchema, Raw: state.Raw},
+		State:  tfsdk.State{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)},
+	}
+	resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+	r.ModifyPlan(ctx, req, resp)
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 1 {
+		t.Fatalf("expected one error for the non-conforming key, got %v", resp.Diagnostics)
+	}
+	wantPath := path.Root("scope").AtName("application_labels").AtListIndex(1).AtName("key")
+	withPath, ok := errs[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(wantPath) {
+		t.Errorf("expected error at %s, got %v", wantPath, errs[0])
+	}
+	if !regexp.MustCompile(`'Team Name'`).MatchString(errs[0].Detail()) {
+		t.Errorf("expected the error to name the key, got %q", errs[0].Detail())
+	}
+}
+
+func TestLifecyclePolicyModifyPlanMaxRulesPerPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	r := &unifiedpolicyresource.LifecyclePolicyResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	policySchema := schemaResp.Schema
+	scopeAttrTypes := policySchema.Blocks["scope"].Type().(types.ObjectType).AttrTypes
+
+	plan := func(ruleIDs ...string) tftypes.Value {
+		ruleIDValues := make([]attr.Value, len(ruleIDs))
+		for i, ruleID := range ruleIDs {
+			ruleIDValues[i] = types.StringValue(ruleID)
+		}
+		m := unifiedpolicyresource.LifecyclePolicyResourceModel{
+			ID:          types.StringUnknown(),
+			Name:        types.StringValue("policy"),
+			Description: types.StringNull(),
+			Enabled:     types.BoolValue(false),
+			Mode:        types.StringValue("block"),
+			Action:      types.ObjectNull(policySchema.Blocks["action"].Type().(types.ObjectType).AttrTypes),
+			Scope: types.ObjectValueMust(scopeAttrTypes, map[string]attr.Value{
+				"type":               types.StringValue("project"),
+				"project_keys":       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("proj")}),
+				"application_keys":   types.ListNull(types.StringType),
+				"application_labels": types.ListNull(scopeAttrTypes["application_labels"].(types.ListType).ElemType),
+			}),
+			RuleIDs:                  types.ListValueMust(types.StringType, ruleIDValues),
+			Priority:                 types.Int64Null(),
+			DisableBeforeDelete:      types.BoolNull(),
+			DeleteGracePeriodSeconds: types.Int64Null(),
+			FailOnDisabledRule:       types.BoolNull(),
+			DataSourceCompatibility:  dataSourceCompatibilityNull,
+		}
+		state := tfsdk.State{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)}
+		if diags := state.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return state.Raw
+	}
+
+	tests := []struct {
+		name        string
+		maxRules    int
+		ruleIDs     []string
+		expectError bool
+	}{
+		{name: "single rule by default", maxRules: unifiedpolicy.DefaultMaxRulesPerPolicy, ruleIDs: []string{"rule-1"}},
+		{name: "several rules by default", maxRules: unifiedpolicy.DefaultMaxRulesPerPolicy, ruleIDs: []string{"rule-1", "rule-2"}, expectError: true},
+		{name: "several rules within raised limit", maxRules: 3, ruleIDs: []string{"rule-1", "rule-2", "rule-3"}},
+		{name: "several rules above raised limit", maxRules: 2, ruleIDs: []string{"rule-1", "rule-2", "rule-3"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r.ProviderData = unifiedpolicy.ProviderMetadata{
+				ProviderMetadata:  util.ProviderMetadata{Client: resty.New()},
+				MaxRulesPerPolicy: tt.maxRules,
+			}
+			raw := plan(tt.ruleIDs...)
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: policySchema, Raw: raw},
+				Plan:   tfsdk.Plan{Schema: policySchema, Raw: raw},
+				State:  tfsdk.State{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if !tt.expectError {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != 1 {
+				t.Fatalf("expected one error for too many rule IDs, got %v", resp.Diagnostics)
+			}
+			withPath, ok := errs[0].(diag.DiagnosticWithPath)
+			if !ok || !withPath.Path().Equal(path.Root("rule_ids")) {
+				t.Errorf("expected error at rule_ids, got %v", errs[0])
+			}
+		})
+	}
+}
+
+func TestReconcileRuleIDOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured []string
+		returned   []string
+		expected   []string
+	}{
+		{name: "single rule", configured: []string{"rule-1"}, returned: []string{"rule-1"}, expected: []string{"rule-1"}},
+		{name: "reordered by the API", configured: []string{"rule-1", "rule-2", "rule-3"}, returned: []string{"rule-3", "rule-1", "rule-2"}, expected: []string{"rule-1", "rule-2", "rule-3"}},
+		{name: "rule added outside Terraform", configured: []string{"rule-1", "rule-2"}, returned: []string{"rule-2", "rule-3", "rule-1"}, expected: []string{"rule-1", "rule-2", "rule-3"}},
+		{name: "rule removed outside Terraform", configured: []string{"rule-1", "rule-2"}, returned: []string{"rule-2"}, expected: []string{"rule-2"}},
+		{name: "nothing configured", returned: []string{"rule-2", "rule-1"}, expected: []string{"rule-2", "rule-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unifiedpolicyresource.ReconcileRuleIDOrder(tt.configured, tt.returned); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestLifecyclePolicyModifyPlanScopeKeys(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/" + unifiedpolicy.AvailableProjectsEndpoint:
+			_, _ = w.Write([]byte(`[{"project_key":"proj"},{"project_key":"other"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &unifiedpolicyresource.LifecyclePolicyResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata:  util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+			ValidateScopeKeys: true,
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	policySchema := schemaResp.Schema
+	scopeAttrTypes := policySchema.Blocks["scope"].Type().(types.ObjectType).AttrTypes
+	labelsType := scopeAttrTypes["application_labels"].(types.ListType)
+
+	set := func(scopeType, attribute, key string) tftypes.Value {
+		scope := map[string]attr.Value{
+			"type":               types.StringValue(scopeType),
+			"project_keys":       types.ListNull(types.StringType),
+			"application_keys":   types.ListNull(types.StringType),
+			"application_labels": types.ListValueMust(labelsType.ElemType, []attr.Value{}),
+		}
+		scope[attribute] = types.ListValueMust(types.StringType, []attr.Value{types.StringValue(key)})
+		m := unifiedpolicyresource.LifecyclePolicyResourceModel{
+			ID:                       types.StringUnknown(),
+			Name:                     types.StringValue("policy"),
+			Description:              types.StringNull(),
+			Enabled:                  types.BoolValue(false),
+			Mode:                     types.StringValue("block"),
+			Action:                   types.ObjectNull(policySchema.Blocks["action"].Type().(types.ObjectType).AttrTypes),
+			Scope:                    types.ObjectValueMust(scopeAttrTypes, scope),
+			RuleIDs:                  types.ListNull(types.StringType),
+			Priority:                 types.Int64Null(),
+			DisableBeforeDelete:      types.BoolNull(),
+			DeleteGracePeriodSeconds: types.Int64Null(),
+			FailOnDisabledRule:       types.BoolNull(),
+			DataSourceCompatibility:  dataSourceCompatibilityNull,
+		}
+		state := tfsdk.State{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)}
+		if diags := state.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return state.Raw
+	}
+
+	tests := []struct {
+		name        string
+		scopeType   string
+		attribute   string
+		key         string
+		wantSummary string
+	}{
+		{name: "known project key", scopeType: "project", attribute: "project_keys", key: "proj"},
+		{name: "project key typo", scopeType: "project", attribute: "project_keys", key: "prj", wantSummary: "Invalid Scope Key"},
+		{name: "applications endpoint not available", scopeType: "application", attribute: "application_keys", key: "app", wantSummary: "Applications List Not Available"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := set(tt.scopeType, tt.attribute, tt.key)
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: policySchema, Raw: raw},
+				Plan:   tfsdk.Plan{Schema: policySchema, Raw: raw},
+				State:  tfsdk.State{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			errs := resp.Diagnostics.Errors()
+			if tt.wantSummary == "" {
+				if len(errs) > 0 {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Summary() != tt.wantSummary {
+				t.Fatalf("expected one %q error, got %v", tt.wantSummary, resp.Diagnostics)
+			}
+			wantPath := path.Root("scope").AtName(tt.attribute)
+			if withPath, ok := errs[0].(diag.DiagnosticWithPath); !ok || !withPath.Path().Equal(wantPath) {
+				t.Errorf("expected error at %s, got %v", wantPath, errs[0])
+			}
+		})
+	}
+}
+
+// enabledPolicyModel returns an enabled project-scoped policy with a single rule, as planned for create.
+func enabledPolicyModel(policySchema schema.Schema, mode string, ruleID string) unifiedpolicyresource.LifecyclePolicyResourceModel {
+	actionAttrTypes := policySchema.Blocks["action"].Type().(types.ObjectType).AttrTypes
+	stageAttrTypes := actionAttrTypes["stage"].(types.ObjectType).AttrTypes
+	scopeAttrTypes := policySchema.Blocks["scope"].Type().(types.ObjectType).AttrTypes
+
+	return unifiedpolicyresource.LifecyclePolicyResourceModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("policy"),
+		Description: types.StringNull(),
+		Enabled:     types.BoolValue(true),
+		Mode:        types.StringValue(mode),
+		Action: types.ObjectValueMust(actionAttrTypes, map[string]attr.Value{
+			"type": types.StringValue("certify_to_gate"),
+			"stage": types.ObjectValueMust(stageAttrTypes, map[string]attr.Value{
+				"key":  types.StringValue("qa"),
+				"gate": types.StringValue("entry"),
+			}),
+		}),
+		Scope: types.ObjectValueMust(scopeAttrTypes, map[string]attr.Value{
+			"type":               types.StringValue("project"),
+			"project_keys":       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("proj")}),
+			"application_keys":   types.ListNull(types.StringType),
+			"application_labels": types.ListNull(scopeAttrTypes["application_labels"].(types.ListType).ElemType),
+		}),
+		RuleIDs:                  types.ListValueMust(types.StringType, []attr.Value{types.StringValue(ruleID)}),
+		Priority:                 types.Int64Null(),
+		DisableBeforeDelete:      types.BoolNull(),
+		DeleteGracePeriodSeconds: types.Int64Null(),
+		FailOnDisabledRule:       types.BoolNull(),
+		DataSourceCompatibility:  dataSourceCompatibilityNull,
+		EffectiveEnabled:         types.BoolUnknown(),
+		ScopeDescription:         types.StringUnknown(),
+		ChangeSummary:            types.StringNull(),
+	}
+}
+
+func TestLifecyclePolicyCreate_validateBeforeEnforce(t *testing.T) {
+	ctx := context.Background()
+
+	rules := map[string]string{
+		"rule-ok":       `{"id":"rule-ok","name":"ok","template_id":"tpl-ok"}`,
+		"rule-orphan":   `{"id":"rule-orphan","name":"orphan","template_id":"tpl-missing"}`,
+		"rule-http":     `{"id":"rule-http","name":"http","template_id":"tpl-http"}`,
+		"rule-broken":   `{"id":"rule-broken","name":"broken","template_id":"tpl-broken"}`,
+		"rule-v1-rego":  `{"id":"rule-v1-rego","name":"v1","template_id":"tpl-v1"}`,
+		"rule-disabled": `{"id":"rule-disabled","name":"disabled","template_id":"tpl-ok"}`,
+	}
+	templates := map[string]string{
+		"tpl-ok":     `{"id":"tpl-ok","name":"ok","rego":"package curation.policies\n\ndefault allow = false\n\nallow {\n  input.x == 1\n}\n"}`,
+		"tpl-http":   `{"id":"tpl-http","name":"http","rego":"package curation.policies\n\nallow {\n  http.send({\"method\": \"GET\", \"url\": \"https://example.com\"})\n}\n"}`,
+		"tpl-broken": `{"id":"tpl-broken","name":"broken","rego":"package curation.policies\n\nallow {\n"}`,
+		"tpl-v1":     `{"id":"tpl-v1","name":"v1","rego":"package curation.policies\n\ndefault allow := false\n\nallow if input.x == 1\n"}`,
+	}
+	var posted bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, policyEndpoint):
+			posted = true
+			w.WriteHeader(http.StatusInternalServerError)
+		case strings.Contains(r.URL.Path, "/rules/") && rules[name] != "":
+			_, _ = w.Write([]byte(rules[name]))
+		case strings.Contains(r.URL.Path, "/templates/") && templates[name] != "":
+			_, _ = w.Write([]byte(templates[name]))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &unifiedpolicyresource.LifecyclePolicyResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata:      util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+			ValidateBeforeEnforce: true,
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	policySchema := schemaResp.Schema
+	plan := func(mode string, ruleID string) tfsdk.Plan {
+		m := enabledPolicyModel(policySchema, mode, ruleID)
+		p := tfsdk.Plan{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)}
+		if diags := p.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return p
+	}
+
+	tests := []struct {
+		name          string
+		mode          string
+		ruleID        string
+		expectedError string
+	}{
+		{name: "valid rule", mode: "block", ruleID: "rule-ok"},
+		{name: "valid rego v1 rule", mode: "block", ruleID: "rule-v1-rego"},
+		{name: "missing rule", mode: "block", ruleID: "rule-missing", expectedError: "Policy Rule Not Found"},
+		{name: "missing template", mode: "block", ruleID: "rule-orphan", expectedError: "Policy Rule Template Not Found"},
+		{name: "disallowed operation", mode: "block", ruleID: "rule-http", expectedError: "Invalid Policy Rule Template"},
+		{name: "rego syntax error", mode: "block", ruleID: "rule-broken", expectedError: "Invalid Policy Rule Template"},
+		{name: "warning mode is not checked", mode: "warning", ruleID: "rule-missing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			posted = false
+			p := plan(tt.mode, tt.ruleID)
+			resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: policySchema, Raw: p.Raw}}
+			r.Create(ctx, fwresource.CreateRequest{Plan: p}, resp)
+
+			if tt.expectedError == "" {
+				// The policy is sent; the test server then fails the create
+				if !posted {
+					t.Errorf("expected the policy to be sent, got diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if posted {
+				t.Error("the policy must not be sent when the rule is broken")
+			}
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != 1 || errs[0].Summary() != tt.expectedError {
+				t.Fatalf("expected error %q, got diagnostics: %v", tt.expectedError, resp.Diagnostics)
+			}
+			withPath, ok := errs[0].(diag.DiagnosticWithPath)
+			if !ok || !withPath.Path().Equal(path.Root("rule_ids")) {
+				t.Errorf("expected error at rule_ids, got %v", errs[0])
+			}
+		})
+	}
+}
+
+func TestLifecyclePolicyDelete_disableBeforeDeleteConflict(t *testing.T) {
+	ctx := context.Background()
+
+	var deleted bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, policyEndpoint+"/1001") {
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			w.WriteHeader(http.StatusPreconditionFailed)
+		case http.MethodDelete:
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	r := &unifiedpolicyresource.LifecyclePolicyResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+			UseETags:         true,
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	policySchema := schemaResp.Schema
+
+	m := enabledPolicyModel(policySchema, "block", "rule-1")
+	m.ID = types.StringValue("1001")
+	m.DisableBeforeDelete = types.BoolValue(true)
+	m.DeleteGracePeriodSeconds = types.Int64Value(0)
+	m.EffectiveEnabled = types.BoolValue(true)
+	m.ScopeDescription = types.StringValue("project proj")
+	state := tfsdk.State{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &m); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(ctx, fwresource.DeleteRequest{State: state}, resp)
+
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 1 || errs[0].Summary() != "Policy Update Conflict" {
+		t.Fatalf("expected a policy update conflict, got diagnostics: %v", resp.Diagnostics)
+	}
+	if deleted {
+		t.Error("the policy must not be deleted when disabling it was rejected")
+	}
+}
+
+func TestStoredRegoProblems(t *testing.T) {
+	tests := []struct {
+		name          string
+		regoCode      string
+		expectProblem string
+	}{
+		{name: "rego v0", regoCode: "package p\n\nallow {\n  input.x == 1\n}\n"},
+		{name: "rego v1", regoCode: "package p\n\nallow if input.x == 1\n"},
+		{name: "disallowed operation", regoCode: "package p\n\nallow {\n  http.send({})\n}\n", expectProblem: "disallowed operations: http.send"},
+		{name: "empty", regoCode: "", expectProblem: "no content was found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := unifiedpolicyresource.StoredRegoProblems(tt.regoCode, nil)
+			if tt.expectProblem == "" {
+				if len(problems) > 0 {
+					t.Errorf("unexpected problems: %v", problems)
+				}
+				return
+			}
+			if len(problems) != 1 || problems[0] != tt.expectProblem {
+				t.Errorf("expected problem %q, got %v", tt.expectProblem, problems)
+			}
+		})
+	}
+}
+
+func TestScopeDescription(t *testing.T) {
+	tests := []struct {
+		name     string
+		scope    unifiedpolicyresource.LifecycleScope
+		expected string
+	}{
+		{
+			name:     "project",
+			scope:    unifiedpolicyresource.LifecycleScope{Type: "project", ProjectKeys: []string{"PROD-1"}},
+			expected: "project PROD-1",
+		},
+		{
+			name:     "several applications",
+			scope:    unifiedpolicyresource.LifecycleScope{Type: "application", ApplicationKeys: []string{"app-1", "app-2"}},
+			expected: "applications app-1, app-2",
+		},
+		{
+			name: "application labels",
+			scope: unifiedpolicyresource.LifecycleScope{Type: "application", ApplicationLabels: []unifiedpolicyresource.ApplicationLabel{
+				{Key: "environment", Value: "production"}, {Key: "tier", Value: "web"},
+			}},
+			expected: "applications labeled environment=production and tier=web",
+		},
+		{
+			name: "application keys and labels",
+			scope: unifiedpolicyresource.LifecycleScope{
+				Type:              "application",
+				ApplicationKeys:   []string{"app-1"},
+				ApplicationLabels: []unifiedpolicyresource.ApplicationLabel{{Key: "environment", Value: "production"}},
+			},
+			expected: "application app-1 and applications labeled environment=production",
+		},
+		{
+			name:     "no keys",
+			scope:    unifiedpolicyresource.LifecycleScope{Type: "project"},
+			expected: "project scope",
+		},
+		{
+			name:     "other scope type",
+			scope:    unifiedpolicyresource.LifecycleScope{Type: "repository"},
+			expected: "repository scope",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unifiedpolicyresource.ScopeDescription(tt.scope); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateApplicationLabelKey(t *testing.T) {
+	lowercase := regexp.MustCompile(`^[a-z0-9_.-]+$`)
+	permissive := regexp.MustCompile(unifiedpolicy.DefaultApplicationLabelKeyPattern)
+
+	tests := []struct {
+		name    string
+		key     string
+		pattern *regexp.Regexp
+		wantErr bool
+	}{
+		{name: "conforming key", key: "team.owner", pattern: lowercase},
+		{name: "uppercase key", key: "Team", pattern: lowercase, wantErr: true},
+		{name: "key with space", key: "team owner", pattern: lowercase, wantErr: true},
+		{name: "default pattern", key: "Team Owner", pattern: permissive},
+		{name: "default pattern empty key", key: "", pattern: permissive, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := unifiedpolicyresource.ValidateApplicationLabelKey(tt.key, tt.pattern)
+			if (msg != "") != tt.wantErr {
+				t.Errorf("expected error %v, got %q", tt.wantErr, msg)
+			}
+		})
+	}
+}
+
+func TestValidateActionStage(t *testing.T) {
+	// Register stage-less action types for the duration of the test
+	unifiedpolicyresource.LifecycleActionTypes["test_optional"] = unifiedpolicyresource.StageOptional
+	unifiedpolicyresource.LifecycleActionTypes["test_forbidden"] = unifiedpolicyresource.StageForbidden
+	defer delete(unifiedpolicyresource.LifecycleActionTypes, "test_optional")
+	defer delete(unifiedpolicyresource.LifecycleActionTypes, "test_forbidden")
+
+	tests := []struct {
+		actionType string
+		hasStage   bool
+		wantErr    string
+	}{
+		{actionType: "certify_to_gate", hasStage: true},
+		{actionType: "certify_to_gate", hasStage: false, wantErr: "action.stage is required for action type 'certify_to_gate'"},
+		{actionType: "test_optional", hasStage: true},
+		{actionType: "test_optional", hasStage: false},
+		{actionType: "test_forbidden", hasStage: false},
+		{actionType: "test_forbidden", hasStage: true, wantErr: "action.stage is not allowed for action type 'test_forbidden'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/stage=%t", tt.actionType, tt.hasStage), func(t *testing.T) {
+			msg := unifiedpolicyresource.ValidateActionStage(tt.actionType, tt.hasStage)
+			if tt.wantErr == "" && msg != "" {
+				t.Errorf("expected no error, got %q", msg)
+			}
+			if tt.wantErr != "" && !regexp.MustCompile(regexp.QuoteMeta(tt.wantErr)).MatchString(msg) {
+				t.Errorf("expected error containing %q, got %q", tt.wantErr, msg)
+			}
+		})
+	}
+}
+
+func TestValidateStageGate(t *testing.T) {
+	requiredGates := map[string]string{"production": "release"}
+
+	tests := []struct {
+		name          string
+		requiredGates map[string]string
+		stageKey      string
+		gate          string
+		wantErr       string
+	}{
+		{name: "required gate", requiredGates: requiredGates, stageKey: "production", gate: "release"},
+		{name: "other gate", requiredGates: requiredGates, stageKey: "production", gate: "entry", wantErr: "Stage 'production' requires gate 'release'"},
+		{name: "unconstrained stage", requiredGates: requiredGates, stageKey: "qa", gate: "entry"},
+		{name: "no constraints", requiredGates: nil, stageKey: "production", gate: "entry"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := unifiedpolicyresource.ValidateStageGate(tt.requiredGates, tt.stageKey, tt.gate)
+			if tt.wantErr == "" && msg != "" {
+				t.Errorf("expected no error, got %q", msg)
+			}
+			if tt.wantErr != "" && !regexp.MustCompile(regexp.QuoteMeta(tt.wantErr)).MatchString(msg) {
+				t.Errorf("expected error containing %q, got %q", tt.wantErr, msg)
+			}
+		})
+	}
+}
+
+func TestValidateDataSourceCompatibility(t *testing.T) {
+	table := map[string]unifiedpolicyresource.DataSourceCompatibility{
+		"evidence": {ScopeTypes: []string{"application"}},
+		"noop":     {ActionTypes: []string{}},
+	}
+
+	tests := []struct {
+		name           string
+		dataSourceType string
+		scopeType      string
+		wantErr        string
+	}{
+		{name: "compatible scope", dataSourceType: "evidence", scopeType: "application"},
+		{name: "incompatible scope", dataSourceType: "evidence", scopeType: "project", wantErr: "scope type 'project' (compatible: 'application')"},
+		{name: "no action type compatible", dataSourceType: "noop", scopeType: "project", wantErr: "action type 'certify_to_gate'"},
+		{name: "no entry", dataSourceType: "xray", scopeType: "project"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := unifiedpolicyresource.ValidateDataSourceCompatibility(table, tt.dataSourceType, tt.scopeType, "certify_to_gate")
+			if tt.wantErr == "" && msg != "" {
+				t.Errorf("expected no error, got %q", msg)
+			}
+			if tt.wantErr != "" && !regexp.MustCompile(regexp.QuoteMeta(tt.wantErr)).MatchString(msg) {
+				t.Errorf("expected error containing %q, got %q", tt.wantErr, msg)
+			}
+		})
+	}
+}
+
+func TestLifecyclePolicyModifyPlanDataSourceCompatibility(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/unifiedpolicy/api/v1/rules/rule-1":
+			_, _ = w.Write([]byte(`{"id":"rule-1","name":"needs-evidence","template_id":"tpl-1"}`))
+		case "/unifiedpolicy/api/v1/templates/tpl-1":
+			_, _ = w.Write([]byte(`{"id":"tpl-1","name":"evidence-template","data_source_type":"evidence"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &unifiedpolicyresource.LifecyclePolicyResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	policySchema := schemaResp.Schema
+	scopeAttrTypes := policySchema.Blocks["scope"].Type().(types.ObjectType).AttrTypes
+	labelsType := scopeAttrTypes["application_labels"].(types.ListType)
+	compatibilityType := dataSourceCompatibilityNull.ElementType(ctx).(types.ObjectType)
+
+	set := func(compatibility types.Map) tftypes.Value {
+		m := unifiedpolicyresource.LifecyclePolicyResourceModel{
+			ID:          types.StringUnknown(),
+			Name:        types.StringValue("policy"),
+			Description: types.StringNull(),
+			Enabled:     types.BoolValue(false),
+			Mode:        types.StringValue("block"),
+			Action:      types.ObjectNull(policySchema.Blocks["action"].Type().(types.ObjectType).AttrTypes),
+			Scope: types.ObjectValueMust(scopeAttrTypes, map[string]attr.Value{
+				"type":               types.StringValue("project"),
+				"project_keys":       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("proj")}),
+				"application_keys":   types.ListNull(types.StringType),
+				"application_labels": types.ListValueMust(labelsType.ElemType, []attr.Value{}),
+			}),
+			RuleIDs:                  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("rule-1")}),
+			Priority:                 types.Int64Null(),
+			DisableBeforeDelete:      types.BoolNull(),
+			DeleteGracePeriodSeconds: types.Int64Null(),
+			FailOnDisabledRule:       types.BoolNull(),
+			DataSourceCompatibility:  compatibility,
+		}
+		state := tfsdk.State{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)}
+		if diags := state.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return state.Raw
+	}
+
+	table := func(scopeType string) types.Map {
+		return types.MapValueMust(compatibilityType, map[string]attr.Value{
+			"evidence": types.ObjectValueMust(compatibilityType.AttrTypes, map[string]attr.Value{
+				"scope_types":  types.ListValueMust(types.StringType, []attr.Value{types.StringValue(scopeType)}),
+				"action_types": types.ListNull(types.StringType),
+			}),
+		})
+	}
+
+	tests := []struct {
+		name          string
+		compatibility types.Map
+		wantWarning   bool
+	}{
+		{name: "not set", compatibility: dataSourceCompatibilityNull},
+		{name: "compatible", compatibility: table("project")},
+		{name: "incompatible", compatibility: table("application"), wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := set(tt.compatibility)
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: policySchema, Raw: raw},
+				Plan:   tfsdk.Plan{Schema: policySchema, Raw: raw},
+				State:  tfsdk.State{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			warnings := resp.Diagnostics.Warnings()
+			if !tt.wantWarning {
+				if len(warnings) > 0 {
+					t.Fatalf("unexpected warnings: %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || warnings[0].Summary() != "Incompatible Rule Data Source" {
+				t.Fatalf("expected one data source compatibility warning, got %v", resp.Diagnostics)
+			}
+			if !regexp.MustCompile(`needs-evidence.*evidence-template.*scope type 'project'`).MatchString(warnings[0].Detail()) {
+				t.Errorf("unexpected warning detail: %s", warnings[0].Detail())
+			}
+		})
+	}
+}
+
+func TestValidateLifecycleScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		scope   unifiedpolicyresource.LifecycleScope
+		wantErr string
+	}{
+		{
+			name:  "project with one key",
+			scope: unifiedpolicyresource.LifecycleScope{Type: "project", ProjectKeys: []string{"proj"}},
+		},
+		{
+			name:    "project without keys",
+			scope:   unifiedpolicyresource.LifecycleScope{Type: "project"},
+			wantErr: "requires project_keys",
+		},
+		{
+			name:    "project with two keys",
+			scope:   unifiedpolicyresource.LifecycleScope{Type: "project", ProjectKeys: []string{"a", "b"}},
+			wantErr: "exactly one project key",
+		},
+		{
+			name: "application with labels",
+			scope: unifiedpolicyresource.LifecycleScope{
+				Type:              "application",
+				ApplicationLabels: []unifiedpolicyresource.ApplicationLabel{{Key: "env", Value: "prod"}},
+			},
+		},
+		{
+			name:    "application without keys or labels",
+			scope:   unifiedpolicyresource.LifecycleScope{Type: "application"},
+			wantErr: "requires application_keys and/or application_labels",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := unifiedpolicyresource.ValidateLifecycleScope(tt.scope)
+			if tt.wantErr == "" && msg != "" {
+				t.Errorf("expected no error, got %q", msg)
+			}
+			if tt.wantErr != "" && !regexp.MustCompile(regexp.QuoteMeta(tt.wantErr)).MatchString(msg) {
+				t.Errorf("expected error containing %q, got %q", tt.wantErr, msg)
+			}
+		})
+	}
+}
+
 func TestAccLifecyclePolicy_basic(t *testing.T) {
 	acctest.SkipIfNotAcc(t)
 	acctest.PreCheck(t)
@@ -106,6 +1234,75 @@ func TestAccLifecyclePolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccLifecyclePolicy_disableBeforeDelete(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, fqrn, name := testutil.MkNames("test-policy-", "unifiedpolicy_lifecycle_policy")
+	resourceName := fmt.Sprintf("unifiedpolicy_lifecycle_policy.%s", name)
+
+	_, _, templateName := testutil.MkNames("test-template-", "template")
+	_, _, ruleName := testutil.MkNames("test-rule-", "unifiedpolicy_rule")
+	regoPath := acctest.RegoFixturePath(t, "basic_policy.rego")
+
+	config := fmt.Sprintf(`
+		resource "unifiedpolicy_template" "test" {
+			name             = "%s"
+			version          = "1.0.0"
+			category         = "security"
+			data_source_type = "evidence"
+			rego             = %q
+			parameters = []
+		}
+
+		resource "unifiedpolicy_rule" "test" {
+			name        = "%s"
+			template_id = unifiedpolicy_template.test.id
+			parameters  = []
+		}
+
+		resource "unifiedpolicy_lifecycle_policy" "%s" {
+			name    = "%s"
+			enabled = true
+			mode    = "block"
+
+			disable_before_delete       = true
+			delete_grace_period_seconds = 1
+
+			action {
+				type = "certify_to_gate"
+				stage {
+					key  = "PROD"
+					gate = "release"
+				}
+			}
+
+			scope {
+				type         = "project"
+				project_keys = ["%s"]
+			}
+
+			rule_ids = [unifiedpolicy_rule.test.id]
+		}
+	`, templateName, regoPath, ruleName, name, name, acctest.LifecyclePolicyProjectKey1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             testAccCheckLifecyclePolicyDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "disable_before_delete", "true"),
+					resource.TestCheckResourceAttr(resourceName, "delete_grace_period_seconds", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLifecyclePolicy_withApplicationScope(t *testing.T) {
 	acctest.SkipIfNotAcc(t)
 	acctest.PreCheck(t)
@@ -514,9 +1711,10 @@ func TestAccLifecyclePolicy_import(t *testing.T) {
 				Config: config,
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"change_summary"}, // only describes the plan that created the resource
 			},
 		},
 	})
@@ -747,3 +1945,109 @@ func testAccCheckLifecyclePolicyDestroy(fqrn string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestAccLifecyclePolicy_missingBlocks(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, _, name := testutil.MkNames("test-policy-missing-", "unifiedpolicy_lifecycle_policy")
+
+	missingScope := fmt.Sprintf(`
+		resource "unifiedpolicy_lifecycle_policy" "%s" {
+			name    = "%s"
+			enabled = false
+			mode    = "warning"
+
+			action {
+				type = "certify_to_gate"
+				stage {
+					key  = "PROD"
+					gate = "release"
+				}
+			}
+		}
+	`, name, name)
+
+	missingAction := fmt.Sprintf(`
+		resource "unifiedpolicy_lifecycle_policy" "%s" {
+			name    = "%s"
+			enabled = false
+			mode    = "warning"
+
+			scope {
+				type         = "project"
+				project_keys = ["%s"]
+			}
+		}
+	`, name, name, acctest.LifecyclePolicyProjectKey1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      missingScope,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The scope block is required`),
+			},
+			{
+				Config:      missingAction,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The action block is required`),
+			},
+		},
+	})
+}
+
+func TestApplyDefaultScope(t *testing.T) {
+	defaultApplicationKeys := []string{"app-1", "app-2"}
+	labels := []unifiedpolicyresource.ApplicationLabel{{Key: "env", Value: "prod"}}
+
+	tests := []struct {
+		name  string
+		scope unifiedpolicyresource.LifecycleScope
+		want  unifiedpolicyresource.LifecycleScope
+	}{
+		{
+			name:  "project without keys",
+			scope: unifiedpolicyresource.LifecycleScope{Type: "project"},
+			want:  unifiedpolicyresource.LifecycleScope{Type: "project", ProjectKeys: []string{"default-proj"}},
+		},
+		{
+			name:  "project with keys",
+			scope: unifiedpolicyresource.LifecycleScope{Type: "project", ProjectKeys: []string{"proj"}},
+			want:  unifiedpolicyresource.LifecycleScope{Type: "project", ProjectKeys: []string{"proj"}},
+		},
+		{
+			name:  "application without keys or labels",
+			scope: unifiedpolicyresource.LifecycleScope{Type: "application"},
+			want:  unifiedpolicyresource.LifecycleScope{Type: "application", ApplicationKeys: defaultApplicationKeys},
+		},
+		{
+			name:  "application with keys",
+			scope: unifiedpolicyresource.LifecycleScope{Type: "application", ApplicationKeys: []string{"app"}},
+			want:  unifiedpolicyresource.LifecycleScope{Type: "application", ApplicationKeys: []string{"app"}},
+		},
+		{
+			name:  "application with labels",
+			scope: unifiedpolicyresource.LifecycleScope{Type: "application", ApplicationLabels: labels},
+			want:  unifiedpolicyresource.LifecycleScope{Type: "application", ApplicationLabels: labels},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := unifiedpolicyresource.ApplyDefaultScope(tt.scope, "default-proj", defaultApplicationKeys)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("no defaults", func(t *testing.T) {
+		scope := unifiedpolicyresource.LifecycleScope{Type: "project"}
+		if got := unifiedpolicyresource.ApplyDefaultScope(scope, "", nil); !reflect.DeepEqual(got, scope) {
+			t.Errorf("expected scope to be unchanged, got %+v", got)
+		}
+	})
+}
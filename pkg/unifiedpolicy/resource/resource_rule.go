@@ -15,27 +15,40 @@
 package resource
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 const (
-	RulesEndpoint = "unifiedpolicy/api/v1/rules"
+	RulesEndpoint = unifiedpolicy.DefaultAPIPathPrefix + "/rules"
 	RuleEndpoint  = RulesEndpoint + "/{rule_id}"
 )
 
@@ -48,6 +61,7 @@ type RulesListAPIModel struct {
 }
 
 var _ resource.Resource = &RuleResource{}
+var _ resource.ResourceWithModifyPlan = &RuleResource{}
 
 func NewRuleResource() resource.Resource {
 	return &RuleResource{
@@ -56,22 +70,42 @@ func NewRuleResource() resource.Resource {
 }
 
 type RuleResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData unifiedpolicy.ProviderMetadata
 	TypeName     string
 }
 
 type RuleResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	IsCustom    types.Bool   `tfsdk:"is_custom"`
-	TemplateID  types.String `tfsdk:"template_id"`
-	Parameters  types.List   `tfsdk:"parameters"`
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	IsCustom       types.Bool   `tfsdk:"is_custom"`
+	TemplateID     types.String `tfsdk:"template_id"`
+	Parameters     types.List   `tfsdk:"parameters"`
+	ParametersFile types.String `tfsdk:"parameters_file"`
+	ParametersJSON types.String `tfsdk:"parameters_json"`
+
+	TemplateParameters  types.List `tfsdk:"template_parameters"`
+	CarryOverParameters types.Bool `tfsdk:"carry_over_parameters"`
+
+	IncludeParameterTypes types.Bool `tfsdk:"include_parameter_types"`
+	ParameterTypes        types.Map  `tfsdk:"parameter_types"`
+
+	ChangeSummary types.String `tfsdk:"change_summary"`
 }
 
 type RuleParameterModel struct {
-	Name  types.String `tfsdk:"name"`
-	Value types.String `tfsdk:"value"`
+	Name           types.String `tfsdk:"name"`
+	Value          types.String `tfsdk:"value"`
+	Sensitive      types.Bool   `tfsdk:"sensitive"`
+	SensitiveValue types.String `tfsdk:"sensitive_value"`
+}
+
+// apiValue returns the parameter value sent to the API, taken from sensitive_value for sensitive parameters.
+func (p RuleParameterModel) apiValue() types.String {
+	if p.Sensitive.ValueBool() {
+		return p.SensitiveValue
+	}
+	return p.Value
 }
 
 type RuleAPIModel struct {
@@ -81,10 +115,33 @@ type RuleAPIModel struct {
 	IsCustom    bool                    `json:"is_custom,omitempty"` // read-only in API; do not set in Create/Update
 	TemplateID  string                  `json:"template_id"`
 	Parameters  []RuleParameterAPIModel `json:"parameters"`
+	Enabled     *bool                   `json:"enabled,omitempty"` // read-only; only returned by backends that support disabling rules
 	CreatedAt   string                  `json:"created_at,omitempty"`
 	CreatedBy   string                  `json:"created_by,omitempty"`
 	UpdatedAt   string                  `json:"updated_at,omitempty"`
 	UpdatedBy   string                  `json:"updated_by,omitempty"`
+
+	// ExtraFields holds response fields unknown to the provider so they survive read/update round-trips.
+	ExtraFields unifiedpolicy.ExtraFields `json:"-"`
+}
+
+// UnmarshalJSON keeps fields the provider does not know about in ExtraFields.
+func (m *RuleAPIModel) UnmarshalJSON(data []byte) error {
+	type alias RuleAPIModel
+	var a alias
+	extra, err := unifiedpolicy.UnmarshalWithExtra(data, &a)
+	if err != nil {
+		return err
+	}
+	*m = RuleAPIModel(a)
+	m.ExtraFields = extra
+	return nil
+}
+
+// MarshalJSON sends ExtraFields back alongside the known fields.
+func (m RuleAPIModel) MarshalJSON() ([]byte, error) {
+	type alias RuleAPIModel
+	return unifiedpolicy.MarshalWithExtra(alias(m), m.ExtraFields)
 }
 
 type RuleParameterAPIModel struct {
@@ -94,8 +151,10 @@ type RuleParameterAPIModel struct {
 
 var ruleParameterObjectType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
-		"name":  types.StringType,
-		"value": types.StringType,
+		"name":            types.StringType,
+		"value":           types.StringType,
+		"sensitive":       types.BoolType,
+		"sensitive_value": types.StringType,
 	},
 }
 
@@ -108,6 +167,7 @@ func (r *RuleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 		MarkdownDescription: "Provides a Unified Policy rule resource. This resource allows you to create, update, and delete rules. " +
 			"Rules define the specific parameter values for policy evaluation and are based on rule templates.",
 		Attributes: map[string]schema.Attribute{
+			"change_summary": changeSummaryAttribute,
 			"id": schema.StringAttribute{
 				Description: "The ID of the rule. This is computed and assigned by the API.",
 				Computed:    true,
@@ -116,13 +176,20 @@ func (r *RuleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the rule to create. Must be unique.",
+				Description: "The name of the rule to create. Must be unique. 1-" + strconv.Itoa(unifiedpolicy.MaxNameLength) + " characters.",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, unifiedpolicy.MaxNameLength),
+				},
 			},
 			"description": schema.StringAttribute{
-				Description: "Free-text description of the rule purpose. Omitted or empty is stored as returned by the API.",
-				Optional:    true,
-				Computed:    true,
+				Description: "Free-text description of the rule purpose. Omitted or empty is stored as returned by the API. " +
+					"Up to " + strconv.Itoa(unifiedpolicy.MaxDescriptionLength) + " characters.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(unifiedpolicy.MaxDescriptionLength),
+				},
 			},
 			"is_custom": schema.BoolAttribute{
 				Description: "Indicates if the rule is user-defined (true) or predefined (false). This is computed by the API based on how the rule was created.",
@@ -134,23 +201,114 @@ func (r *RuleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Required:    true,
 			},
 			"parameters": schema.ListNestedAttribute{
-				Description: "Array of parameter name/value pairs that match the template definition. Optional; defaults to empty if omitted.",
-				Optional:    true,
-				Computed:    true,
-				Default:     listdefault.StaticValue(types.ListValueMust(ruleParameterObjectType, []attr.Value{})),
+				Description: "Array of parameter name/value pairs that match the template definition. Optional; defaults to empty if omitted. " +
+					"Maximum " + strconv.Itoa(unifiedpolicy.MaxParameters) + " parameters allowed. " +
+					"Template parameters with a `default` may be omitted; the template default then applies.",
+				Optional: true,
+				Computed: true,
+				Default:  listdefault.StaticValue(types.ListValueMust(ruleParameterObjectType, []attr.Value{})),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(unifiedpolicy.MaxParameters),
+				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
 							Description: "Name of the template parameter.",
 							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, unifiedpolicy.MaxParameterNameLength),
+							},
 						},
 						"value": schema.StringAttribute{
-							Description: "The value assigned to the parameter.",
+							Description: "The value assigned to the parameter. Required unless `sensitive` is true. " +
+								"Values of `bool` template parameters must be booleans; spellings such as `True`, `1` or `f` are sent as `true` or `false` and kept as configured in state.",
+							Optional: true,
+						},
+						"sensitive": schema.BoolAttribute{
+							Description: "Marks the parameter value as a secret. The value is then set in `sensitive_value`, which Terraform redacts " +
+								"in plan output and logs, and it is left out of `parameters_json`. Provider-side metadata only; it is not sent to the API. " +
+								"Values are still stored in plain text in the state file. Default: `false`.",
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"sensitive_value": schema.StringAttribute{
+							Description: "The value assigned to the parameter when `sensitive` is true.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+					Validators: []validator.Object{
+						ruleParameterValueValidator{},
+					},
+				},
+			},
+			"parameters_file": schema.StringAttribute{
+				Description: "Absolute path to a JSON file with the rule parameters, either an object mapping parameter name to value " +
+					"(e.g. `{\"severity\": \"high\"}`) or a list of `{\"name\": ..., \"value\": ...}` objects. Non-string values are " +
+					"set as their JSON text (e.g. `5`, `true`). The file is read at plan time into `parameters`. Conflicts with `parameters`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("parameters")),
+				},
+			},
+			"parameters_json": schema.StringAttribute{
+				Description: "The parameters serialized as a JSON object mapping parameter name to value (e.g. `{\"severity\":\"high\"}`), " +
+					"for use with `jsondecode()` or external tools. Keys are sorted so the value is stable.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					parametersJSONPlanModifier{},
+				},
+			},
+			"template_parameters": schema.ListNestedAttribute{
+				Description: "The parameters of the referenced template, for a template managed in the same configuration: set it to the " +
+					"template's `parameters` (e.g. `unifiedpolicy_template.example.parameters`). Parameter names, count and values are then " +
+					"validated against it at plan time without reading the template, also when the template is created in the same apply: " +
+					"every template parameter must be set and no other parameter may be set. When not set, or not known at plan time, " +
+					"the template is read from the API instead: parameter names must be declared by the template and values are validated, but template parameters may be left unset. Provider-side only; it is not sent to the API.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Parameter name.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Parameter type: string, bool, int, float or object.",
 							Required:    true,
 						},
+						"schema": schema.StringAttribute{
+							Description: "JSON schema that values of an `object` parameter must match.",
+							Optional:    true,
+						},
+						"default": schema.StringAttribute{
+							Description: "Default value of the parameter; a parameter with a default may be omitted.",
+							Optional:    true,
+						},
 					},
 				},
 			},
+			"carry_over_parameters": schema.BoolAttribute{
+				Description: "When true and `parameters` and `parameters_file` are not set, the parameters are kept from state instead of " +
+					"being cleared. When `template_id` changes, for example to migrate to a new template version, the parameters " +
+					"the new template declares are carried over and the others are dropped with a warning; carried-over values " +
+					"are validated against the parameter types of the new template. Provider-side only; it is not sent to the API. Defaults to false.",
+				Optional: true,
+			},
+			"include_parameter_types": schema.BoolAttribute{
+				Description: "When true, the parameter definitions of the referenced template are read to populate `parameter_types`. " +
+					"This costs one extra API call per read. Defaults to false.",
+				Optional: true,
+			},
+			"parameter_types": schema.MapAttribute{
+				Description: "Parameter types by parameter name (e.g. `{severity = \"string\", max_count = \"int\"}`), as defined by the referenced template, " +
+					"to interpret the string `value` of each parameter. Null unless `include_parameter_types` is true.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					parameterTypesPlanModifier{},
+				},
+			},
 		},
 	}
 }
@@ -159,19 +317,310 @@ func (r *RuleResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+// ModifyPlan applies the provider attributes ignore_description_changes, sort_parameters_by_name and
+// rule_parameters_in_template_order, after reading parameters_file into the planned parameters or carrying them over
+// from state. change_summary is set last, see planChangeSummary.
+func (r *RuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer planChangeSummary(ctx, req, resp)
+
+	ignoreDescriptionOnlyChange(ctx, r.ProviderData, req, resp)
+
+	if !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(planParametersFile(ctx, &resp.Plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !req.Plan.Raw.IsNull() && !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(r.planCarriedOverParameters(ctx, req, &resp.Plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(checkPrefixedNameLength(ctx, resp.Plan, r.ProviderData.NamePrefix)...)
+	}
+
+	if r.ProviderData.SortParametersByName && !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(checkParametersSortedByName(ctx, resp.Plan)...)
+	}
+
+	if r.ProviderData.RuleParametersInTemplateOrder && !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(r.checkParametersInTemplateOrder(ctx, resp.Plan)...)
+	}
+
+	if !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(r.checkParameterValues(ctx, resp.Plan)...)
+	}
+}
+
+// planParametersFile reads parameters_file, when set, into the planned parameters and parameters_json, so the
+// parameters from the file are validated like configured ones and shown in the plan.
+func planParametersFile(ctx context.Context, plan *tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var parametersFile types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("parameters_file"), &parametersFile)...)
+	if diags.HasError() || parametersFile.IsNull() {
+		return diags
+	}
+	if parametersFile.IsUnknown() {
+		diags.Append(plan.SetAttribute(ctx, path.Root("parameters"), types.ListUnknown(ruleParameterObjectType))...)
+		diags.Append(plan.SetAttribute(ctx, path.Root("parameters_json"), types.StringUnknown())...)
+		return diags
+	}
+
+	apiParams, err := ReadRuleParametersFile(parametersFile.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("parameters_file"),
+			"Invalid Parameters File",
+			err.Error(),
+		)
+		return diags
+	}
+	if len(apiParams) > unifiedpolicy.MaxParameters {
+		diags.AddAttributeError(
+			path.Root("parameters_file"),
+			"Invalid Parameters File",
+			fmt.Sprintf("%s sets %d parameters, at most %d are allowed.", parametersFile.ValueString(), len(apiParams), unifiedpolicy.MaxParameters),
+		)
+		return diags
+	}
+
+	params := make([]attr.Value, len(apiParams))
+	for i, p := range apiParams {
+		params[i] = types.ObjectValueMust(ruleParameterObjectType.AttrTypes, map[string]attr.Value{
+			"name":            types.StringValue(p.Name),
+			"value":           types.StringValue(p.Value),
+			"sensitive":       types.BoolValue(false),
+			"sensitive_value": types.StringNull(),
+		})
+	}
+	parametersJSON, err := RuleParametersJSON(apiParams)
+	if err != nil {
+		diags.AddAttributeError(path.Root("parameters_file"), "Invalid Parameters File", err.Error())
+		return diags
+	}
+
+	diags.Append(plan.SetAttribute(ctx, path.Root("parameters"), types.ListValueMust(ruleParameterObjectType, params))...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("parameters_json"), types.StringValue(parametersJSON))...)
+	return diags
+}
+
+// planCarriedOverParameters applies carry_over_parameters while parameters and parameters_file are not configured:
+// the planned parameters are kept from state, and when template_id changes only the parameters the new template
+// declares are carried over. They are then checked against the new template like configured parameters. When the new
+// template cannot be read at plan time (e.g. it is created in the same apply) the parameters are planned unknown and
+// Update carries them over.
+func (r *RuleResource) planCarriedOverParameters(ctx context.Context, req resource.ModifyPlanRequest, plan *tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var carryOver types.Bool
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("carry_over_parameters"), &carryOver)...)
+	var parameters types.List
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	var parametersFile types.String
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("parameters_file"), &parametersFile)...)
+	if diags.HasError() || !carryOver.ValueBool() || !parameters.IsNull() || !parametersFile.IsNull() {
+		return diags
+	}
+
+	var state RuleResourceModel
+	diags.Append(req.State.Get(ctx, &state)...)
+	var templateID types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("template_id"), &templateID)...)
+	var templateParameters types.List
+	diags.Append(plan.GetAttribute(ctx, path.Root("template_parameters"), &templateParameters)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if templateID.Equal(state.TemplateID) {
+		diags.Append(plan.SetAttribute(ctx, path.Root("parameters"), state.Parameters)...)
+		diags.Append(plan.SetAttribute(ctx, path.Root("parameters_json"), state.ParametersJSON)...)
+		return diags
+	}
+
+	templateParams, local, d := LocalTemplateParameters(ctx, templateParameters)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	if !local {
+		if r.ProviderData.Client == nil || templateID.IsUnknown() {
+			diags.Append(plan.SetAttribute(ctx, path.Root("parameters"), types.ListUnknown(ruleParameterObjectType))...)
+			diags.Append(plan.SetAttribute(ctx, path.Root("parameters_json"), types.StringUnknown())...)
+			return diags
+		}
+		templateParams, d = readTemplateParameters(ctx, r.ProviderData, templateID.ValueString())
+		if d.HasError() {
+			tflog.Debug(ctx, "Unable to read template to carry over rule parameters", map[string]interface{}{
+				"template_id": templateID.ValueString(),
+			})
+			diags.Append(plan.SetAttribute(ctx, path.Root("parameters"), types.ListUnknown(ruleParameterObjectType))...)
+			diags.Append(plan.SetAttribute(ctx, path.Root("parameters_json"), types.StringUnknown())...)
+			return diags
+		}
+	}
+
+	carried, d := carryOverParameters(ctx, state, templateParams, ruleTemplateName(templateID, local))
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("parameters"), carried)...)
+	parametersJSON := types.StringUnknown()
+	if value, ok := plannedParametersJSON(ctx, carried); ok {
+		parametersJSON = types.StringValue(value)
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("parameters_json"), parametersJSON)...)
+	return diags
+}
+
+// carryOverParameters returns the parameters of the rule in state that templateParams declares, and warns about the
+// parameters it drops.
+func carryOverParameters(ctx context.Context, state RuleResourceModel, templateParams []TemplateParameterAPIModel, templateName string) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var params []RuleParameterModel
+	if !state.Parameters.IsNull() && !state.Parameters.IsUnknown() {
+		diags.Append(state.Parameters.ElementsAs(ctx, &params, false)...)
+		if diags.HasError() {
+			return types.ListNull(ruleParameterObjectType), diags
+		}
+	}
+
+	carried, dropped := CarryOverRuleParameters(params, templateParams)
+	if len(dropped) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("parameters"),
+			"Rule Parameters Dropped",
+			fmt.Sprintf("Parameters %s of template '%s' are not declared by %s and are not carried over.",
+				strings.Join(dropped, ", "), state.TemplateID.ValueString(), templateName),
+		)
+	}
+
+	list, d := types.ListValueFrom(ctx, ruleParameterObjectType, carried)
+	diags.Append(d...)
+	return list, diags
+}
+
+// CarryOverRuleParameters returns the rule parameters whose names templateParams declares, in their order, and the
+// names of the other parameters.
+// This function is exported for testing purposes.
+func CarryOverRuleParameters(params []RuleParameterModel, templateParams []TemplateParameterAPIModel) ([]RuleParameterModel, []string) {
+	declared := make(map[string]bool, len(templateParams))
+	for _, p := range templateParams {
+		declared[p.Name] = true
+	}
+
+	carried := make([]RuleParameterModel, 0, len(params))
+	var dropped []string
+	for _, p := range params {
+		if declared[p.Name.ValueString()] {
+			carried = append(carried, p)
+		} else {
+			dropped = append(dropped, p.Name.ValueString())
+		}
+	}
+	return carried, dropped
 }
 
-func (m *RuleResourceModel) toAPIModel(ctx context.Context) (RuleAPIModel, diag.Diagnostics) {
+// ReadRuleParametersFile reads rule parameters from a JSON file at an absolute path. The file holds either an object
+// mapping parameter name to value, read in name order, or a list of {"name": ..., "value": ...} objects. String values
+// are used as is, other values as their JSON text.
+// This function is exported for testing purposes.
+func ReadRuleParametersFile(filePath string) ([]RuleParameterAPIModel, error) {
+	if !filepath.IsAbs(filePath) {
+		return nil, fmt.Errorf("parameters file path must be absolute: %s", filePath)
+	}
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read parameters file: %w", err)
+	}
+
+	trimmed := bytes.TrimSpace(content)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var entries []struct {
+			Name  *string         `json:"name"`
+			Value json.RawMessage `json:"value"`
+		}
+		if err := json.Unmarshal(trimmed, &entries); err != nil {
+			return nil, fmt.Errorf("%s is not a valid JSON list of parameters: %w", filePath, err)
+		}
+		params := make([]RuleParameterAPIModel, 0, len(entries))
+		seen := make(map[string]bool, len(entries))
+		for i, entry := range entries {
+			if entry.Name == nil || *entry.Name == "" {
+				return nil, fmt.Errorf("%s: parameter %d has no name", filePath, i)
+			}
+			if seen[*entry.Name] {
+				return nil, fmt.Errorf("%s: parameter %q is set more than once", filePath, *entry.Name)
+			}
+			seen[*entry.Name] = true
+			value, err := parameterFileValue(entry.Value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: parameter %q: %w", filePath, *entry.Name, err)
+			}
+			params = append(params, RuleParameterAPIModel{Name: *entry.Name, Value: value})
+		}
+		return params, nil
+	}
+
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(trimmed, &values); err != nil {
+		return nil, fmt.Errorf("%s is not a valid JSON object or list of parameters: %w", filePath, err)
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	params := make([]RuleParameterAPIModel, 0, len(names))
+	for _, name := range names {
+		value, err := parameterFileValue(values[name])
+		if err != nil {
+			return nil, fmt.Errorf("%s: parameter %q: %w", filePath, name, err)
+		}
+		params = append(params, RuleParameterAPIModel{Name: name, Value: value})
+	}
+	return params, nil
+}
+
+// parameterFileValue returns the string value of a parameter in a parameters file.
+func parameterFileValue(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", fmt.Errorf("value is missing or null")
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, raw); err != nil {
+		return "", err
+	}
+	return compact.String(), nil
+}
+
+// toAPIModel converts the resource model to the API rule. Values of parameters that parameterTypes (the template's
+// declared types, may be nil) marks as bool are sent as canonical "true" or "false".
+func (m *RuleResourceModel) toAPIModel(ctx context.Context, sortParametersByName bool, parameterTypes map[string]string, namePrefix, descriptionPrefix string) (RuleAPIModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	apiModel := RuleAPIModel{
-		Name:       m.Name.ValueString(),
+		Name:       unifiedpolicy.PrefixName(namePrefix, m.Name.ValueString()),
 		TemplateID: m.TemplateID.ValueString(),
 	}
 
 	if !m.Description.IsNull() {
-		apiModel.Description = m.Description.ValueString()
+		apiModel.Description = unifiedpolicy.PrefixDescription(descriptionPrefix, m.Description.ValueString())
 	}
 
 	// is_custom is read-only per API spec; do not send in Create/Update (omitempty leaves it out)
@@ -187,9 +636,19 @@ func (m *RuleResourceModel) toAPIModel(ctx context.Context) (RuleAPIModel, diag.
 			for i, p := range parameters {
 				apiParameters[i] = RuleParameterAPIModel{
 					Name:  p.Name.ValueString(),
-					Value: p.Value.ValueString(),
+					Value: p.apiValue().ValueString(),
 				}
 			}
+			apiParameters, invalid := NormalizeBooleanParameters(apiParameters, parameterTypes)
+			for _, name := range invalid {
+				diags.AddError(
+					"Invalid Rule Parameter Value",
+					fmt.Sprintf("Parameter '%s' is a bool parameter of the template; its value must be true or false.", name),
+				)
+			}
+			if sortParametersByName {
+				apiParameters = SortRuleParametersByName(apiParameters)
+			}
 			apiModel.Parameters = apiParameters
 		} else {
 			// If there's an error, default to empty list
@@ -212,7 +671,13 @@ func (r *RuleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	apiModel, diags := plan.toAPIModel(ctx)
+	// Check the values against the template again before the POST, including values that were unknown at plan time
+	resp.Diagnostics.Append(r.checkParameterValues(ctx, req.Plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiModel, diags := plan.toAPIModel(ctx, r.ProviderData.SortParametersByName, r.templateParameterTypes(ctx, plan), r.ProviderData.NamePrefix, r.ProviderData.DescriptionPrefix)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -223,7 +688,7 @@ func (r *RuleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		SetContext(ctx).
 		SetBody(apiModel).
 		SetResult(&result).
-		Post(RulesEndpoint)
+		Post(r.ProviderData.Endpoint(RulesEndpoint))
 
 	if err != nil {
 		utilfw.UnableToCreateResourceError(resp, err.Error())
@@ -231,63 +696,118 @@ func (r *RuleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	if httpResponse.IsError() {
-		if httpResponse.StatusCode() == http.StatusConflict {
-			resp.Diagnostics.AddError(
-				"Rule Already Exists",
-				fmt.Sprintf("A rule with name '%s' already exists. Please use a different name.", plan.Name.ValueString()),
-			)
+		// Backend may return 500 with "unique constraint" instead of 409; surface a clear message.
+		conflict := httpResponse.StatusCode() == http.StatusConflict ||
+			httpResponse.StatusCode() == http.StatusInternalServerError &&
+				strings.Contains(strings.ToLower(string(httpResponse.Body())), "unique constraint")
+		if !conflict {
+			errorDiags := unifiedpolicy.HandleAPIError(httpResponse, "create")
+			resp.Diagnostics.Append(errorDiags...)
 			return
 		}
-		// Backend may return 500 with "unique constraint" instead of 409; surface a clear message.
-		if httpResponse.StatusCode() == http.StatusInternalServerError &&
-			strings.Contains(strings.ToLower(string(httpResponse.Body())), "unique constraint") {
+		if !adoptOnConflict(r.ProviderData) {
 			resp.Diagnostics.AddError(
 				"Rule Already Exists",
-				fmt.Sprintf("A rule with name '%s' already exists. Please use a different name.", plan.Name.ValueString()),
+				fmt.Sprintf("A rule with name '%s' already exists. Please use a different name.", apiModel.Name),
 			)
 			return
 		}
-		errorDiags := unifiedpolicy.HandleAPIError(httpResponse, "create")
-		resp.Diagnostics.Append(errorDiags...)
+		_, diags = ResolveCreateConflict(ctx, r.ProviderData, CreateConflict{
+			Kind:         "rule",
+			Title:        "Rule",
+			Name:         apiModel.Name,
+			ListEndpoint: RulesEndpoint,
+			ItemEndpoint: RuleEndpoint,
+			PathParam:    "rule_id",
+		}, apiModel, &result)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	templateOrder, diags := r.templateParameterOrder(ctx, result.TemplateID, plan.TemplateParameters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	diags = plan.fromAPIModel(ctx, result)
+	diags = plan.fromAPIModel(ctx, result, r.ProviderData.SortParametersByName, templateOrder, r.ProviderData.NamePrefix, r.ProviderData.DescriptionPrefix)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.readParameterTypes(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(unifiedpolicy.SetPrivateExtraFields(ctx, resp.Private, result.ExtraFields)...)
+	plan.ChangeSummary = resolveChangeSummary(plan.ChangeSummary)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (m *RuleResourceModel) fromAPIModel(ctx context.Context, api RuleAPIModel) diag.Diagnostics {
+// fromAPIModel converts the API rule to the resource model. A non-nil templateOrder stores the parameters in the
+// template declaration order (provider attribute rule_parameters_in_template_order).
+func (m *RuleResourceModel) fromAPIModel(ctx context.Context, api RuleAPIModel, sortParametersByName bool, templateOrder []string, namePrefix, descriptionPrefix string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	m.ID = types.StringValue(api.ID)
-	m.Name = types.StringValue(api.Name)
+	m.Name = types.StringValue(unifiedpolicy.StripNamePrefix(namePrefix, api.Name))
 	m.TemplateID = types.StringValue(api.TemplateID)
 
 	// Store description as returned by API; use empty string when API returns "" so config description = "" matches state (no inconsistent result).
-	if api.Description != "" {
-		m.Description = types.StringValue(api.Description)
-	} else {
-		m.Description = types.StringValue("")
-	}
+	m.Description = types.StringValue(unifiedpolicy.StripDescriptionPrefix(descriptionPrefix, api.Description))
 
 	// Always set is_custom to match what the API returned
 	// This ensures consistency between plan and state
 	m.IsCustom = types.BoolValue(api.IsCustom)
 
+	// sensitive is not returned by the API; keep it from the plan or prior state, by parameter name
+	// The configured spelling of a boolean value (e.g. "True") is kept when the API returns the same boolean normalized
+	sensitiveParameters := map[string]bool{}
+	configuredValues := map[string]string{}
+	if !m.Parameters.IsNull() && !m.Parameters.IsUnknown() {
+		var parameters []RuleParameterModel
+		if d := m.Parameters.ElementsAs(ctx, &parameters, false); !d.HasError() {
+			for _, p := range parameters {
+				sensitiveParameters[p.Name.ValueString()] = p.Sensitive.ValueBool()
+				configuredValues[p.Name.ValueString()] = p.apiValue().ValueString()
+			}
+		}
+	}
+
+	// Parameters are stored in API order unless kept sorted by name (provider attribute sort_parameters_by_name)
+	// or in template order (provider attribute rule_parameters_in_template_order)
+	if sortParametersByName {
+		api.Parameters = SortRuleParametersByName(api.Parameters)
+	} else if templateOrder != nil {
+		api.Parameters = OrderRuleParametersByTemplate(api.Parameters, templateOrder)
+	}
+
 	// Convert parameters - always return a list, even if empty
 	// This ensures consistency: if user provides empty list [], it stays as empty list
 	parameterValues := make([]attr.Value, len(api.Parameters))
+	apiParameters := make([]RuleParameterAPIModel, 0, len(api.Parameters))
 	for i, p := range api.Parameters {
+		stateValue := p.Value
+		if configured, ok := configuredValues[p.Name]; ok {
+			stateValue = RuleParameterStateValue(configured, p.Value)
+		}
+		value, sensitiveValue := types.StringValue(stateValue), types.StringNull()
+		if sensitiveParameters[p.Name] {
+			value, sensitiveValue = types.StringNull(), types.StringValue(stateValue)
+		} else {
+			apiParameters = append(apiParameters, p)
+		}
 		paramObj := types.ObjectValueMust(
 			ruleParameterObjectType.AttrTypes,
 			map[string]attr.Value{
-				"name":  types.StringValue(p.Name),
-				"value": types.StringValue(p.Value),
+				"name":            types.StringValue(p.Name),
+				"value":           value,
+				"sensitive":       types.BoolValue(sensitiveParameters[p.Name]),
+				"sensitive_value": sensitiveValue,
 			},
 		)
 		parameterValues[i] = paramObj
@@ -301,6 +821,13 @@ func (m *RuleResourceModel) fromAPIModel(ctx context.Context, api RuleAPIModel)
 		m.Parameters = types.ListValueMust(ruleParameterObjectType, []attr.Value{})
 	}
 
+	parametersJSON, err := RuleParametersJSON(apiParameters)
+	if err != nil {
+		diags.AddError("Unable to Serialize Rule Parameters", err.Error())
+	} else {
+		m.ParametersJSON = types.StringValue(parametersJSON)
+	}
+
 	return diags
 }
 
@@ -318,14 +845,14 @@ func (r *RuleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		SetContext(ctx).
 		SetPathParam("rule_id", state.ID.ValueString()).
 		SetResult(&result).
-		Get(RuleEndpoint)
+		Get(r.ProviderData.Endpoint(RuleEndpoint))
 
 	if err != nil {
 		utilfw.UnableToRefreshResourceError(resp, err.Error())
 		return
 	}
 
-	if httpResponse.StatusCode() == http.StatusNotFound {
+	if r.ProviderData.IsNotFoundStatus(httpResponse.StatusCode()) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -336,12 +863,31 @@ func (r *RuleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	diags := state.fromAPIModel(ctx, result)
+	// template_parameters describe the template the rule was last planned with
+	templateParameters := state.TemplateParameters
+	if result.TemplateID != state.TemplateID.ValueString() {
+		templateParameters = types.ListNull(templateParameters.ElementType(ctx))
+	}
+	templateOrder, diags := r.templateParameterOrder(ctx, result.TemplateID, templateParameters)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	diags = state.fromAPIModel(ctx, result, r.ProviderData.SortParametersByName, templateOrder, r.ProviderData.NamePrefix, r.ProviderData.DescriptionPrefix)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readParameterTypes(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(unifiedpolicy.SetPrivateExtraFields(ctx, resp.Private, result.ExtraFields)...)
+	// change_summary only describes a plan, see planChangeSummary
+	state.ChangeSummary = types.StringNull()
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -354,11 +900,46 @@ func (r *RuleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	apiModel, diags := plan.toAPIModel(ctx)
+	// With carry_over_parameters the parameters are planned unknown when the new template could not be read at plan time
+	if plan.Parameters.IsUnknown() && plan.CarryOverParameters.ValueBool() {
+		var state RuleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		templateParams, diags := r.templateParameters(ctx, plan.TemplateID.ValueString(), plan.TemplateParameters)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		_, local, _ := LocalTemplateParameters(ctx, plan.TemplateParameters)
+		plan.Parameters, diags = carryOverParameters(ctx, state, templateParams, ruleTemplateName(plan.TemplateID, local))
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(req.Plan.SetAttribute(ctx, path.Root("parameters"), plan.Parameters)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Check the values against the template again before the PUT, including values that were unknown at plan time
+	resp.Diagnostics.Append(r.checkParameterValues(ctx, req.Plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiModel, diags := plan.toAPIModel(ctx, r.ProviderData.SortParametersByName, r.templateParameterTypes(ctx, plan), r.ProviderData.NamePrefix, r.ProviderData.DescriptionPrefix)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Send back API fields from the last read that the provider does not model
+	extraFields, diags := unifiedpolicy.GetPrivateExtraFields(ctx, req.Private)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	apiModel.ExtraFields = extraFields
 
 	var result RuleAPIModel
 	httpResponse, err := r.ProviderData.Client.R().
@@ -366,7 +947,7 @@ func (r *RuleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		SetPathParam("rule_id", plan.ID.ValueString()).
 		SetBody(apiModel).
 		SetResult(&result).
-		Put(RuleEndpoint)
+		Put(r.ProviderData.Endpoint(RuleEndpoint))
 
 	if err != nil {
 		utilfw.UnableToUpdateResourceError(resp, err.Error())
@@ -377,7 +958,7 @@ func (r *RuleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		if httpResponse.StatusCode() == http.StatusConflict {
 			resp.Diagnostics.AddError(
 				"Rule Name Conflict",
-				fmt.Sprintf("A rule with name '%s' already exists. Please use a different name.", plan.Name.ValueString()),
+				fmt.Sprintf("A rule with name '%s' already exists. Please use a different name.", apiModel.Name),
 			)
 			return
 		}
@@ -386,12 +967,25 @@ func (r *RuleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	diags = plan.fromAPIModel(ctx, result)
+	templateOrder, diags := r.templateParameterOrder(ctx, result.TemplateID, plan.TemplateParameters)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	diags = plan.fromAPIModel(ctx, result, r.ProviderData.SortParametersByName, templateOrder, r.ProviderData.NamePrefix, r.ProviderData.DescriptionPrefix)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readParameterTypes(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(unifiedpolicy.SetPrivateExtraFields(ctx, resp.Private, result.ExtraFields)...)
+	plan.ChangeSummary = resolveChangeSummary(plan.ChangeSummary)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -407,14 +1001,14 @@ func (r *RuleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	httpResponse, err := r.ProviderData.Client.R().
 		SetContext(ctx).
 		SetPathParam("rule_id", state.ID.ValueString()).
-		Delete(RuleEndpoint)
+		Delete(r.ProviderData.Endpoint(RuleEndpoint))
 
 	if err != nil {
 		utilfw.UnableToDeleteResourceError(resp, err.Error())
 		return
 	}
 
-	if httpResponse.StatusCode() != http.StatusNotFound && httpResponse.StatusCode() != http.StatusNoContent {
+	if !unifiedpolicy.IsDeleteSuccess(httpResponse) {
 		if httpResponse.StatusCode() == http.StatusConflict {
 			resp.Diagnostics.AddError(
 				"Rule In Use",
@@ -428,6 +1022,722 @@ func (r *RuleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+// readParameterTypes sets parameter_types from the referenced template when include_parameter_types is true.
+func (r *RuleResource) readParameterTypes(ctx context.Context, m *RuleResourceModel) diag.Diagnostics {
+	if !m.IncludeParameterTypes.ValueBool() {
+		m.ParameterTypes = types.MapNull(types.StringType)
+		return nil
+	}
+
+	parameterTypes, diags := ReadTemplateParameterTypes(ctx, r.ProviderData, m.TemplateID.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+
+	parameterTypesMap, d := types.MapValueFrom(ctx, types.StringType, parameterTypes)
+	diags.Append(d...)
+	m.ParameterTypes = parameterTypesMap
+	return diags
+}
+
 func (r *RuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// ReadTemplateParameterTypes returns the parameter types of a template by parameter name. Shared by the rule
+// resource and the rules datasource.
+func ReadTemplateParameterTypes(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, templateID string) (map[string]string, diag.Diagnostics) {
+	parameters, diags := readTemplateParameters(ctx, providerData, templateID)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	parameterTypes := make(map[string]string, len(parameters))
+	for _, p := range parameters {
+		parameterTypes[p.Name] = p.Type
+	}
+	return parameterTypes, diags
+}
+
+// readTemplateParameters returns the parameters a template declares, in declaration order.
+func readTemplateParameters(ctx context.Context, providerData unifiedpolicy.ProviderMetadata, templateID string) ([]TemplateParameterAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var template TemplateAPIModel
+	response, err := providerData.Client.R().
+		SetContext(ctx).
+		SetPathParam("templateId", templateID).
+		SetResult(&template).
+		Get(providerData.Endpoint(TemplateEndpoint))
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Template Parameters",
+			fmt.Sprintf("An error occurred while reading the parameters of template '%s': %s", templateID, err.Error()),
+		)
+		return nil, diags
+	}
+
+	if response.IsError() {
+		diags.Append(unifiedpolicy.HandleAPIErrorWithType(response, "read", "template")...)
+		return nil, diags
+	}
+
+	return template.Parameters, diags
+}
+
+// LocalTemplateParameters returns the template parameters set in template_parameters, and false when the attribute
+// is null or not fully known, in which case the template must be read from the API.
+// This function is exported for testing purposes.
+func LocalTemplateParameters(ctx context.Context, templateParameters types.List) ([]TemplateParameterAPIModel, bool, diag.Diagnostics) {
+	if templateParameters.IsNull() || templateParameters.IsUnknown() {
+		return nil, false, nil
+	}
+	// Nested values are unknown when they depend on a template attribute computed at apply time
+	value, err := templateParameters.ToTerraformValue(ctx)
+	if err != nil || !value.IsFullyKnown() {
+		return nil, false, nil
+	}
+
+	var models []TemplateParameterModel
+	diags := templateParameters.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, false, diags
+	}
+
+	parameters := make([]TemplateParameterAPIModel, len(models))
+	for i, m := range models {
+		parameters[i] = TemplateParameterAPIModel{
+			Name: m.Name.ValueString(),
+			Type: m.Type.ValueString(),
+		}
+		if m.Schema.ValueString() != "" {
+			parameters[i].Schema = json.RawMessage(m.Schema.ValueString())
+		}
+		parameters[i].Default = m.Default.ValueStringPointer()
+	}
+	return parameters, true, diags
+}
+
+// templateParameters returns the parameters of the rule's template from template_parameters when known, and reads
+// the template from the API otherwise.
+func (r *RuleResource) templateParameters(ctx context.Context, templateID string, templateParameters types.List) ([]TemplateParameterAPIModel, diag.Diagnostics) {
+	parameters, ok, diags := LocalTemplateParameters(ctx, templateParameters)
+	if ok || diags.HasError() {
+		return parameters, diags
+	}
+	return readTemplateParameters(ctx, r.ProviderData, templateID)
+}
+
+// templateParameterOrder returns the parameter names of a template in declaration order when the provider sets
+// rule_parameters_in_template_order, and nil otherwise.
+func (r *RuleResource) templateParameterOrder(ctx context.Context, templateID string, templateParameters types.List) ([]string, diag.Diagnostics) {
+	if !r.ProviderData.RuleParametersInTemplateOrder {
+		return nil, nil
+	}
+
+	parameters, diags := r.templateParameters(ctx, templateID, templateParameters)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	order := make([]string, len(parameters))
+	for i, p := range parameters {
+		order[i] = p.Name
+	}
+	return order, diags
+}
+
+// checkParametersInTemplateOrder reports an error when the planned rule parameters are not in the declaration order
+// of the template. With rule_parameters_in_template_order the provider stores parameters in that order, so a
+// configuration in another order would never converge.
+func (r *RuleResource) checkParametersInTemplateOrder(ctx context.Context, plan tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var templateID types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("template_id"), &templateID)...)
+	var parameters types.List
+	diags.Append(plan.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	var templateParameters types.List
+	diags.Append(plan.GetAttribute(ctx, path.Root("template_parameters"), &templateParameters)...)
+	if diags.HasError() || parameters.IsNull() || parameters.IsUnknown() {
+		return diags
+	}
+	_, local, _ := LocalTemplateParameters(ctx, templateParameters)
+	if !local && (templateID.IsUnknown() || templateID.IsNull()) {
+		return diags
+	}
+	templateName := ruleTemplateName(templateID, local)
+
+	params := make([]RuleParameterAPIModel, 0, len(parameters.Elements()))
+	for _, element := range parameters.Elements() {
+		param, ok := element.(types.Object)
+		if !ok || param.IsUnknown() {
+			return diags
+		}
+		name, ok := param.Attributes()["name"].(types.String)
+		if !ok || name.IsUnknown() {
+			return diags
+		}
+		params = append(params, RuleParameterAPIModel{Name: name.ValueString()})
+	}
+
+	order, d := r.templateParameterOrder(ctx, templateID.ValueString(), templateParameters)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ordered := OrderRuleParametersByTemplate(params, order)
+	if !slices.Equal(ruleParameterNames(params), ruleParameterNames(ordered)) {
+		diags.AddAttributeError(
+			path.Root("parameters"),
+			"Parameters Not In Template Order",
+			fmt.Sprintf("The provider attribute rule_parameters_in_template_order is set, so parameters must be listed in the order "+
+				"%s declares them, followed by any parameters the template does not declare. Expected order: %s.",
+				templateName, strings.Join(ruleParameterNames(ordered), ", ")),
+		)
+	}
+	return diags
+}
+
+// checkParameterValues validates the planned parameter values against the types their template declares: values of
+// bool parameters must be booleans, values of int, float and object parameters must parse as such, and values of object
+// parameters must match the JSON schema the template declares for them, if any. When template_parameters is known the
+// template is taken from it, so the check runs without a request and also for templates created in the same apply;
+// parameter names must then also match the template. Otherwise the template is read once per plan, but only when the
+// provider is configured (not during terraform validate); when it cannot be read (e.g. it is created in the same
+// apply) the check is skipped and create or update reports the problem. Update runs it again on values that were
+// unknown at plan time.
+func (r *RuleResource) checkParameterValues(ctx context.Context, plan tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var templateID types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("template_id"), &templateID)...)
+	var parameters types.List
+	diags.Append(plan.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	var templateParameters types.List
+	diags.Append(plan.GetAttribute(ctx, path.Root("template_parameters"), &templateParameters)...)
+	if diags.HasError() || parameters.IsUnknown() {
+		return diags
+	}
+
+	templateParams, local, d := LocalTemplateParameters(ctx, templateParameters)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	if !local {
+		if r.ProviderData.Client == nil || templateID.IsUnknown() || templateID.IsNull() || len(parameters.Elements()) == 0 {
+			return diags
+		}
+		templateParams, d = readTemplateParameters(ctx, r.ProviderData, templateID.ValueString())
+		if d.HasError() {
+			tflog.Debug(ctx, "Unable to read template for parameter value check", map[string]interface{}{
+				"template_id": templateID.ValueString(),
+			})
+			return diags
+		}
+	}
+	templateName := ruleTemplateName(templateID, local)
+
+	var params []RuleParameterModel
+	diags.Append(parameters.ElementsAs(ctx, &params, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	declared := make(map[string]TemplateParameterAPIModel, len(templateParams))
+	for _, p := range templateParams {
+		declared[p.Name] = p
+	}
+
+	diags.Append(checkParameterNames(params, templateParams, templateName, local)...)
+
+	for i, p := range params {
+		templateParam, ok := declared[p.Name.ValueString()]
+		value := p.apiValue()
+		if !ok || p.Sensitive.IsUnknown() || value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		valueAttribute := "value"
+		if p.Sensitive.ValueBool() {
+			valueAttribute = "sensitive_value"
+		}
+
+		switch {
+		case templateParam.Type == "bool":
+			if _, ok := NormalizeBooleanParameterValue(value.ValueString()); !ok {
+				diags.AddAttributeError(
+					path.Root("parameters").AtListIndex(i).AtName(valueAttribute),
+					"Invalid Rule Parameter Value",
+					fmt.Sprintf("Parameter '%s' is a bool parameter of %s; its value must be true or false (also accepted: 1, 0, t, f, in any case).",
+						p.Name.ValueString(), templateName),
+				)
+			}
+		case !parameterValueMatchesType(value.ValueString(), templateParam.Type):
+			diags.AddAttributeError(
+				path.Root("parameters").AtListIndex(i).AtName(valueAttribute),
+				"Invalid Rule Parameter Value",
+				fmt.Sprintf("Parameter '%s' of %s has type %s; its value is not a valid %s.",
+					p.Name.ValueString(), templateName, templateParam.Type, templateParam.Type),
+			)
+		case templateParam.Type == "object" && len(templateParam.Schema) > 0:
+			problems, err := ParameterSchemaErrors(string(templateParam.Schema), value.ValueString())
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("parameters").AtListIndex(i),
+					"Invalid Template Parameter Schema",
+					fmt.Sprintf("The JSON schema %s declares for parameter '%s' cannot be used: %s", templateName, p.Name.ValueString(), err.Error()),
+				)
+				continue
+			}
+			if len(problems) > 0 {
+				diags.AddAttributeError(
+					path.Root("parameters").AtListIndex(i).AtName(valueAttribute),
+					"Invalid Rule Parameter Value",
+					fmt.Sprintf("The value of parameter '%s' does not match the JSON schema declared by %s:\n- %s",
+						p.Name.ValueString(), templateName, strings.Join(problems, "\n- ")),
+				)
+			}
+		}
+	}
+	return diags
+}
+
+// checkParameterNames reports rule parameters the template does not declare and parameters set more than once. For the
+// template in template_parameters (local), template parameters without a default that are not set are reported too.
+func checkParameterNames(params []RuleParameterModel, templateParams []TemplateParameterAPIModel, templateName string, local bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	declared := make(map[string]bool, len(templateParams))
+	for _, p := range templateParams {
+		declared[p.Name] = true
+	}
+
+	set := make(map[string]bool, len(params))
+	for i, p := range params {
+		if p.Name.IsUnknown() {
+			// Missing parameters cannot be reported while a name is not known
+			return diags
+		}
+		name := p.Name.ValueString()
+		switch {
+		case !declared[name]:
+			diags.AddAttributeError(
+				path.Root("parameters").AtListIndex(i).AtName("name"),
+				"Unknown Rule Parameter",
+				fmt.Sprintf("Parameter '%s' is not declared by %s. Declared parameters: %s.", name, templateName, templateParameterNamesList(templateParams)),
+			)
+		case set[name]:
+			diags.AddAttributeError(
+				path.Root("parameters").AtListIndex(i).AtName("name"),
+				"Duplicate Rule Parameter",
+				fmt.Sprintf("Parameter '%s' is set more than once.", name),
+			)
+		}
+		set[name] = true
+	}
+
+	if !local {
+		return diags
+	}
+	var missing []string
+	for _, p := range templateParams {
+		if !set[p.Name] && p.Default == nil {
+			missing = append(missing, p.Name)
+		}
+	}
+	if len(missing) > 0 {
+		diags.AddAttributeError(
+			path.Root("parameters"),
+			"Missing Rule Parameters",
+			fmt.Sprintf("The template in template_parameters declares %d parameter(s); the rule sets %d. Missing: %s.",
+				len(templateParams), len(params), strings.Join(missing, ", ")),
+		)
+	}
+	return diags
+}
+
+// templateParameterNamesList returns the parameter names of a template for messages.
+func templateParameterNamesList(templateParams []TemplateParameterAPIModel) string {
+	if len(templateParams) == 0 {
+		return "none"
+	}
+	names := make([]string, len(templateParams))
+	for i, p := range templateParams {
+		names[i] = p.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// ruleTemplateName names the template of a rule in messages: by ID, or as template_parameters when the template was
+// taken from it and its ID may not be known yet.
+func ruleTemplateName(templateID types.String, local bool) string {
+	if local {
+		return "the template in template_parameters"
+	}
+	return "template '" + templateID.ValueString() + "'"
+}
+
+// templateParameterTypes returns the parameter types of the rule's template, used to normalize parameter values
+// before they are sent. It returns nil when the template cannot be read, so values are then sent as configured.
+func (r *RuleResource) templateParameterTypes(ctx context.Context, m RuleResourceModel) map[string]string {
+	parameters, diags := r.templateParameters(ctx, m.TemplateID.ValueString(), m.TemplateParameters)
+	if diags.HasError() {
+		tflog.Debug(ctx, "Unable to read template for parameter value normalization", map[string]interface{}{
+			"template_id": m.TemplateID.ValueString(),
+		})
+		return nil
+	}
+
+	parameterTypes := make(map[string]string, len(parameters))
+	for _, p := range parameters {
+		parameterTypes[p.Name] = p.Type
+	}
+	return parameterTypes
+}
+
+// NormalizeBooleanParameters returns the parameters with the values of bool parameters (per parameterTypes, the
+// template's declared types) replaced by canonical "true" or "false", and the names of bool parameters whose value
+// is not a boolean; those values are left unchanged.
+// This function is exported for testing purposes.
+func NormalizeBooleanParameters(params []RuleParameterAPIModel, parameterTypes map[string]string) ([]RuleParameterAPIModel, []string) {
+	var invalid []string
+	normalized := make([]RuleParameterAPIModel, len(params))
+	for i, p := range params {
+		normalized[i] = p
+		if parameterTypes[p.Name] != "bool" {
+			continue
+		}
+		value, ok := NormalizeBooleanParameterValue(p.Value)
+		if !ok {
+			invalid = append(invalid, p.Name)
+			continue
+		}
+		normalized[i].Value = value
+	}
+	return normalized, invalid
+}
+
+// RuleParameterStateValue returns the value to store in state for a parameter returned by the API: the configured
+// value when it is a different spelling of the same boolean (e.g. "True" for "true"), so backend normalization does
+// not show up as a diff, and the returned value otherwise.
+// This function is exported for testing purposes.
+func RuleParameterStateValue(configured, returned string) string {
+	if configured == returned {
+		return returned
+	}
+	if normalized, ok := NormalizeBooleanParameterValue(configured); ok && normalized == returned {
+		return configured
+	}
+	return returned
+}
+
+// NormalizeBooleanParameterValue returns the canonical "true" or "false" for a boolean parameter value. Spellings
+// accepted by strconv.ParseBool are recognized in any case (e.g. "True", "TRUE", "1", "f"); ok is false otherwise.
+// This function is exported for testing purposes.
+func NormalizeBooleanParameterValue(value string) (string, bool) {
+	b, err := strconv.ParseBool(strings.ToLower(strings.TrimSpace(value)))
+	if err != nil {
+		return value, false
+	}
+	return strconv.FormatBool(b), true
+}
+
+func ruleParameterNames(params []RuleParameterAPIModel) []string {
+	names := make([]string, len(params))
+	for i, p := range params {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// OrderRuleParametersByTemplate returns the parameters in the template declaration order given by templateOrder.
+// Parameters the template does not declare follow in their original order.
+// This function is exported for testing purposes.
+func OrderRuleParametersByTemplate(params []RuleParameterAPIModel, templateOrder []string) []RuleParameterAPIModel {
+	position := make(map[string]int, len(templateOrder))
+	for i, name := range templateOrder {
+		if _, ok := position[name]; !ok {
+			position[name] = i
+		}
+	}
+
+	ordered := slices.Clone(params)
+	slices.SortStableFunc(ordered, func(a, b RuleParameterAPIModel) int {
+		posA, okA := position[a.Name]
+		posB, okB := position[b.Name]
+		switch {
+		case okA && okB:
+			return posA - posB
+		case okA:
+			return -1
+		case okB:
+			return 1
+		}
+		return 0
+	})
+	return ordered
+}
+
+// RuleParametersJSON serializes rule parameters as a JSON object mapping parameter name to value.
+// encoding/json sorts map keys, so the result does not depend on parameter order.
+// This function is exported for testing purposes
+func RuleParametersJSON(params []RuleParameterAPIModel) (string, error) {
+	values := make(map[string]string, len(params))
+	for _, p := range params {
+		values[p.Name] = p.Value
+	}
+	out, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// ValidateRuleParameters checks rule parameters against the parameters a template defines: every template
+// parameter must be set, no other parameter may be set, values must parse as the parameter type
+// (bool, int, float or a JSON object; string accepts any value), and object values must match the JSON
+// schema the template declares for them, if any. It returns one message per problem.
+// This function is exported for testing purposes.
+func ValidateRuleParameters(templateParams []TemplateParameterAPIModel, ruleParams []RuleParameterAPIModel) []string {
+	var problems []string
+
+	paramTypes := make(map[string]string, len(templateParams))
+	paramSchemas := make(map[string]string, len(templateParams))
+	for _, p := range templateParams {
+		paramTypes[p.Name] = p.Type
+		if p.Type == "object" && len(p.Schema) > 0 {
+			paramSchemas[p.Name] = string(p.Schema)
+		}
+	}
+
+	set := make(map[string]bool, len(ruleParams))
+	for _, p := range ruleParams {
+		paramType, ok := paramTypes[p.Name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("Parameter '%s' is not defined by the template.", p.Name))
+			continue
+		}
+		if set[p.Name] {
+			problems = append(problems, fmt.Sprintf("Parameter '%s' is set more than once.", p.Name))
+			continue
+		}
+		set[p.Name] = true
+		if !parameterValueMatchesType(p.Value, paramType) {
+			problems = append(problems, fmt.Sprintf("Parameter '%s' value '%s' is not a valid %s.", p.Name, p.Value, paramType))
+			continue
+		}
+		if schema := paramSchemas[p.Name]; schema != "" {
+			schemaProblems, err := ParameterSchemaErrors(schema, p.Value)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("Parameter '%s' schema cannot be used: %s.", p.Name, err.Error()))
+			}
+			for _, problem := range schemaProblems {
+				problems = append(problems, fmt.Sprintf("Parameter '%s' does not match the template schema: %s.", p.Name, problem))
+			}
+		}
+	}
+
+	for _, p := range templateParams {
+		if !set[p.Name] {
+			problems = append(problems, fmt.Sprintf("Template parameter '%s' is not set.", p.Name))
+		}
+	}
+
+	return problems
+}
+
+func parameterValueMatchesType(value, paramType string) bool {
+	var err error
+	switch paramType {
+	case "bool":
+		if _, ok := NormalizeBooleanParameterValue(value); !ok {
+			return false
+		}
+	case "int":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case "float":
+		_, err = strconv.ParseFloat(value, 64)
+	case "object":
+		var obj map[string]interface{}
+		err = json.Unmarshal([]byte(value), &obj)
+	}
+	return err == nil
+}
+
+// ParameterSchemaErrors validates a JSON object parameter value against a JSON schema and returns one message per
+// violation, naming the offending field. It returns an error when the schema itself is invalid.
+// This function is exported for testing purposes.
+func ParameterSchemaErrors(schema, value string) ([]string, error) {
+	compiled, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(schema))
+	if err != nil {
+		return nil, err
+	}
+	result, err := compiled.Validate(gojsonschema.NewStringLoader(value))
+	if err != nil {
+		return []string{"the value is not valid JSON (" + err.Error() + ")"}, nil
+	}
+
+	var problems []string
+	for _, resultErr := range result.Errors() {
+		problems = append(problems, resultErr.String())
+	}
+	return problems, nil
+}
+
+// SortRuleParametersByName returns the parameters sorted by name. Parameters with the same name keep their order.
+// This function is exported for testing purposes.
+func SortRuleParametersByName(params []RuleParameterAPIModel) []RuleParameterAPIModel {
+	return sortByName(params, func(param RuleParameterAPIModel) string { return param.Name })
+}
+
+// parameterTypesPlanModifier keeps parameter_types from state while template_id and include_parameter_types are
+// unchanged, is null when include_parameter_types is not set, and is otherwise left unknown until apply.
+type parameterTypesPlanModifier struct{}
+
+func (m parameterTypesPlanModifier) Description(ctx context.Context) string {
+	return "Keeps parameter types from state unless the template or include_parameter_types changes."
+}
+
+func (m parameterTypesPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m parameterTypesPlanModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	// Nothing to compute on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var include types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("include_parameter_types"), &include)...)
+	if resp.Diagnostics.HasError() || include.IsUnknown() {
+		return
+	}
+	if !include.ValueBool() {
+		resp.PlanValue = types.MapNull(types.StringType)
+		return
+	}
+
+	if req.State.Raw.IsNull() || req.StateValue.IsNull() {
+		return
+	}
+
+	var planTemplateID, stateTemplateID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("template_id"), &planTemplateID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("template_id"), &stateTemplateID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if planTemplateID.Equal(stateTemplateID) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// ruleParameterValueValidator requires value for regular parameters and sensitive_value for sensitive ones.
+// Terraform sensitivity is set per schema attribute, so sensitive parameters need their own value attribute.
+type ruleParameterValueValidator struct{}
+
+func (v ruleParameterValueValidator) Description(ctx context.Context) string {
+	return "Requires value when sensitive is false and sensitive_value when sensitive is true."
+}
+
+func (v ruleParameterValueValidator) MarkdownDescription(ctx context.Context) string {
+	return "Requires `value` when `sensitive` is false and `sensitive_value` when `sensitive` is true."
+}
+
+func (v ruleParameterValueValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attrs := req.ConfigValue.Attributes()
+	sensitive, _ := attrs["sensitive"].(types.Bool)
+	value, _ := attrs["value"].(types.String)
+	sensitiveValue, _ := attrs["sensitive_value"].(types.String)
+	if sensitive.IsUnknown() {
+		return
+	}
+
+	if sensitive.ValueBool() {
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(req.Path.AtName("value"), "Invalid Rule Parameter",
+				"value cannot be set on a sensitive parameter. Set sensitive_value instead so the value is redacted in plan output.")
+		}
+		if sensitiveValue.IsNull() {
+			resp.Diagnostics.AddAttributeError(req.Path.AtName("sensitive_value"), "Invalid Rule Parameter",
+				"sensitive_value is required when sensitive is true.")
+		}
+		return
+	}
+
+	if !sensitiveValue.IsNull() {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName("sensitive_value"), "Invalid Rule Parameter",
+			"sensitive_value can only be set when sensitive is true.")
+	}
+	if value.IsNull() {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName("value"), "Invalid Rule Parameter",
+			"value is required when sensitive is false.")
+	}
+}
+
+// parametersJSONPlanModifier computes parameters_json from the planned parameters so that the plan shows
+// the final value instead of "known after apply" whenever the parameters are known.
+type parametersJSONPlanModifier struct{}
+
+func (m parametersJSONPlanModifier) Description(ctx context.Context) string {
+	return "Computes parameters_json from the planned parameters."
+}
+
+func (m parametersJSONPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m parametersJSONPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to compute on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var parameters types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if parametersJSON, ok := plannedParametersJSON(ctx, parameters); ok {
+		resp.PlanValue = types.StringValue(parametersJSON)
+	}
+}
+
+// plannedParametersJSON returns parameters_json for planned parameters, and false when they are not known yet.
+func plannedParametersJSON(ctx context.Context, parameters types.List) (string, bool) {
+	if parameters.IsUnknown() || parameters.IsNull() {
+		return "", false
+	}
+
+	var params []RuleParameterModel
+	if diags := parameters.ElementsAs(ctx, &params, false); diags.HasError() {
+		return "", false
+	}
+
+	apiParams := make([]RuleParameterAPIModel, 0, len(params))
+	for _, p := range params {
+		if p.Name.IsUnknown() || p.Value.IsUnknown() || p.Sensitive.IsUnknown() {
+			return "", false
+		}
+		// Sensitive values are left out of parameters_json
+		if p.Sensitive.ValueBool() {
+			continue
+		}
+		apiParams = append(apiParams, RuleParameterAPIModel{Name: p.Name.ValueString(), Value: p.Value.ValueString()})
+	}
+
+	parametersJSON, err := RuleParametersJSON(apiParams)
+	if err != nil {
+		return "", false
+	}
+	return parametersJSON, true
+}
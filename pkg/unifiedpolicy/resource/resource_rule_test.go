@@ -15,19 +15,43 @@
 package resource_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
+	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
 )
 
 const ruleEndpoint = "unifiedpolicy/api/v1/rules"
 
+// templateParametersNull is an unset template_parameters attribute of a rule.
+var templateParametersNull = types.ListNull(types.ObjectType{AttrTypes: map[string]attr.Type{
+	"name":    types.StringType,
+	"type":    types.StringType,
+	"schema":  types.StringType,
+	"default": types.StringType,
+}})
+
 func TestAccRule_basic(t *testing.T) {
 	acctest.SkipIfNotAcc(t)
 	acctest.PreCheck(t)
@@ -109,9 +133,10 @@ func TestAccRule_withParameters(t *testing.T) {
 		}
 
 		resource "unifiedpolicy_rule" "%s" {
-			name        = "%s"
-			description = "Test rule with parameters"
-			template_id = unifiedpolicy_template.test.id
+			name                    = "%s"
+			description             = "Test rule with parameters"
+			template_id             = unifiedpolicy_template.test.id
+			include_parameter_types = true
 			parameters = [
 				{
 					name  = "severity_threshold"
@@ -139,13 +164,1179 @@ func TestAccRule_withParameters(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "parameters.0.value", "high"),
 					resource.TestCheckResourceAttr(resourceName, "parameters.1.name", "max_count"),
 					resource.TestCheckResourceAttr(resourceName, "parameters.1.value", "10"),
+					resource.TestCheckResourceAttr(resourceName, "parameters_json", `{"max_count":"10","severity_threshold":"high"}`),
+					resource.TestCheckResourceAttr(resourceName, "parameter_types.severity_threshold", "string"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_types.max_count", "int"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRule_withSensitiveParameter(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, fqrn, name := testutil.MkNames("test-rule-sensitive-", "unifiedpolicy_rule")
+	resourceName := fmt.Sprintf("unifiedpolicy_rule.%s", name)
+
+	_, _, templateName := testutil.MkNames("test-template-", "template")
+	regoPath := acctest.RegoFixturePath(t, "params_severity_policy.rego")
+
+	config := fmt.Sprintf(`
+		resource "unifiedpolicy_template" "test" {
+			name             = "%s"
+			version          = "1.0.0"
+			description      = "Test template with parameters"
+			category         = "security"
+			data_source_type = "evidence"
+			rego             = %q
+
+			parameters = [
+				{
+					name = "severity_threshold"
+					type = "string"
+				},
+				{
+					name = "max_count"
+					type = "int"
+				}
+			]
+		}
+
+		resource "unifiedpolicy_rule" "%s" {
+			name        = "%s"
+			description = "Test rule with a sensitive parameter"
+			template_id = unifiedpolicy_template.test.id
+			parameters = [
+				{
+					name            = "severity_threshold"
+					sensitive       = true
+					sensitive_value = "high"
+				},
+				{
+					name  = "max_count"
+					value = "10"
+				}
+			]
+		}
+	`, templateName, regoPath, name, name)
+
+	invalidConfig := `
+		resource "unifiedpolicy_rule" "invalid" {
+			name        = "invalid"
+			template_id = "1"
+			parameters = [
+				{
+					name      = "severity_threshold"
+					value     = "high"
+					sensitive = true
+				}
+			]
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             testAccCheckRuleDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config:      invalidConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value cannot be set on a sensitive parameter`),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.sensitive", "true"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.sensitive_value", "high"),
+					resource.TestCheckNoResourceAttr(resourceName, "parameters.0.value"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.1.sensitive", "false"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.1.value", "10"),
+					resource.TestCheckResourceAttr(resourceName, "parameters_json", `{"max_count":"10"}`),
 				),
 			},
 		},
 	})
 }
 
+func TestRuleParametersJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   []unifiedpolicyresource.RuleParameterAPIModel
+		expected string
+	}{
+		{
+			name:     "no parameters",
+			params:   nil,
+			expected: `{}`,
+		},
+		{
+			name: "keys sorted regardless of parameter order",
+			params: []unifiedpolicyresource.RuleParameterAPIModel{
+				{Name: "severity_threshold", Value: "high"},
+				{Name: "max_count", Value: "10"},
+			},
+			expected: `{"max_count":"10","severity_threshold":"high"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := unifiedpolicyresource.RuleParametersJSON(tt.params)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestRuleAPIModelExtraFieldsRoundTrip(t *testing.T) {
+	response := `{"id":"r1","name":"rule","template_id":"t1","parameters":[],"revision":3,"labels":{"team":"sec"}}`
+
+	var rule unifiedpolicyresource.RuleAPIModel
+	if err := json.Unmarshal([]byte(response), &rule); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if rule.Name != "rule" || rule.TemplateID != "t1" {
+		t.Errorf("Known fields not decoded: %+v", rule)
+	}
+	if len(rule.ExtraFields) != 2 || string(rule.ExtraFields["revision"]) != "3" {
+		t.Fatalf("Expected revision and labels in extra fields, got %v", rule.ExtraFields)
+	}
+
+	rule.Name = "renamed"
+	data, err := json.Marshal(rule)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var sent map[string]json.RawMessage
+	if err := json.Unmarshal(data, &sent); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(sent["name"]) != `"renamed"` {
+		t.Errorf("Expected updated name, got %s", sent["name"])
+	}
+	if string(sent["revision"]) != "3" || string(sent["labels"]) != `{"team":"sec"}` {
+		t.Errorf("Extra fields not sent back: %s", data)
+	}
+	if _, ok := sent["ExtraFields"]; ok {
+		t.Errorf("ExtraFields must not be serialized as a field: %s", data)
+	}
+}
+
 // TestAccRule_withoutParameters tests that a rule can be created with only name and template_id; parameters defaults to empty.
+func TestRuleModifyPlanIgnoreDescriptionChanges(t *testing.T) {
+	ctx := context.Background()
+
+	r := &unifiedpolicyresource.RuleResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	ruleSchema := schemaResp.Schema
+	parameterType := ruleSchema.Attributes["parameters"].GetType().(types.ListType).ElemType
+
+	model := func(name, description string, computedUnknown bool) unifiedpolicyresource.RuleResourceModel {
+		m := unifiedpolicyresource.RuleResourceModel{
+			ID:                 types.StringValue("1001"),
+			Name:               types.StringValue(name),
+			Description:        types.StringValue(description),
+			IsCustom:           types.BoolValue(true),
+			TemplateID:         types.StringValue("2001"),
+			Parameters:         types.ListValueMust(parameterType, []attr.Value{}),
+			ParametersJSON:     types.StringValue("[]"),
+			ParameterTypes:     types.MapNull(types.StringType),
+			TemplateParameters: templateParametersNull,
+		}
+		if computedUnknown {
+			m.IsCustom = types.BoolUnknown()
+		}
+		return m
+	}
+
+	set := func(m unifiedpolicyresource.RuleResourceModel) tftypes.Value {
+		state := tfsdk.State{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+		if diags := state.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return state.Raw
+	}
+
+	tests := []struct {
+		name            string
+		ignore          bool
+		planName        string
+		wantDescription string
+	}{
+		{name: "description only, flag set", ignore: true, planName: "rule", wantDescription: "old"},
+		{name: "description and name, flag set", ignore: true, planName: "renamed", wantDescription: "new"},
+		{name: "description only, flag unset", ignore: false, planName: "rule", wantDescription: "new"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r.ProviderData = unifiedpolicy.ProviderMetadata{IgnoreDescriptionChanges: tt.ignore}
+
+			stateRaw := set(model("rule", "old", false))
+			planRaw := set(model(tt.planName, "new", true))
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: ruleSchema, Raw: planRaw},
+				Plan:   tfsdk.Plan{Schema: ruleSchema, Raw: planRaw},
+				State:  tfsdk.State{Schema: ruleSchema, Raw: stateRaw},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var description types.String
+			resp.Plan.GetAttribute(ctx, path.Root("description"), &description)
+			if description.ValueString() != tt.wantDescription {
+				t.Errorf("expected planned description %q, got %q", tt.wantDescription, description.ValueString())
+			}
+			if tt.wantDescription == "old" && !resp.Plan.Raw.Equal(stateRaw) {
+				t.Errorf("expected plan to equal prior state, got %s", resp.Plan.Raw)
+			}
+		})
+	}
+}
+
+func TestSortRuleParametersByName(t *testing.T) {
+	params := []unifiedpolicyresource.RuleParameterAPIModel{
+		{Name: "severity", Value: "high"},
+		{Name: "max_count", Value: "10"},
+		{Name: "enabled", Value: "true"},
+	}
+	expected := []unifiedpolicyresource.RuleParameterAPIModel{
+		{Name: "enabled", Value: "true"},
+		{Name: "max_count", Value: "10"},
+		{Name: "severity", Value: "high"},
+	}
+
+	result := unifiedpolicyresource.SortRuleParametersByName(params)
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestValidateRuleParameters(t *testing.T) {
+	templateParams := []unifiedpolicyresource.TemplateParameterAPIModel{
+		{Name: "severity", Type: "string"},
+		{Name: "max_count", Type: "int"},
+		{Name: "enabled", Type: "bool"},
+	}
+
+	tests := []struct {
+		name       string
+		ruleParams []unifiedpolicyresource.RuleParameterAPIModel
+		expected   []string
+	}{
+		{
+			name: "all parameters set",
+			ruleParams: []unifiedpolicyresource.RuleParameterAPIModel{
+				{Name: "severity", Value: "high"},
+				{Name: "max_count", Value: "10"},
+				{Name: "enabled", Value: "true"},
+			},
+		},
+		{
+			name: "unknown, invalid and missing parameters",
+			ruleParams: []unifiedpolicyresource.RuleParameterAPIModel{
+				{Name: "severity", Value: "high"},
+				{Name: "max_count", Value: "ten"},
+				{Name: "threshold", Value: "1"},
+			},
+			expected: []string{
+				"Parameter 'max_count' value 'ten' is not a valid int.",
+				"Parameter 'threshold' is not defined by the template.",
+				"Template parameter 'enabled' is not set.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := unifiedpolicyresource.ValidateRuleParameters(templateParams, tt.ruleParams)
+			if !reflect.DeepEqual(problems, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, problems)
+			}
+		})
+	}
+}
+
+func TestParameterSchemaErrors(t *testing.T) {
+	schema := `{"type":"object","properties":{"max_severity":{"type":"string","enum":["low","medium","high"]},"limit":{"type":"integer","minimum":1}},"required":["max_severity"]}`
+
+	tests := []struct {
+		name        string
+		schema      string
+		value       string
+		expected    []string
+		expectError bool
+	}{
+		{
+			name:   "matches schema",
+			schema: schema,
+			value:  `{"max_severity":"high","limit":5}`,
+		},
+		{
+			name:     "missing required field",
+			schema:   schema,
+			value:    `{"limit":5}`,
+			expected: []string{"(root): max_severity is required"},
+		},
+		{
+			name:     "wrong field type",
+			schema:   schema,
+			value:    `{"max_severity":"high","limit":"five"}`,
+			expected: []string{"limit: Invalid type. Expected: integer, given: string"},
+		},
+		{
+			name:     "value not JSON",
+			schema:   schema,
+			value:    `{"max_severity":`,
+			expected: []string{"the value is not valid JSON (unexpected EOF)"},
+		},
+		{
+			name:        "invalid schema",
+			schema:      `{"type":"no-such-type"}`,
+			value:       `{}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems, err := unifiedpolicyresource.ParameterSchemaErrors(tt.schema, tt.value)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("expected error %v, got %v", tt.expectError, err)
+			}
+			if !reflect.DeepEqual(problems, tt.expected) {
+				t.Errorf("expected %q, got %q", tt.expected, problems)
+			}
+		})
+	}
+}
+
+func TestRuleModifyPlanParameterSchemas(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"2001","parameters":[{"name":"severity","type":"string"},` +
+			`{"name":"thresholds","type":"object","schema":{"type":"object","properties":{"limit":{"type":"integer"}},"required":["limit"]}},` +
+			`{"name":"options","type":"object"}]}`))
+	}))
+	defer server.Close()
+
+	r := &unifiedpolicyresource.RuleResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	ruleSchema := schemaResp.Schema
+	parameterType := ruleSchema.Attributes["parameters"].GetType().(types.ListType).ElemType.(types.ObjectType)
+
+	plan := func(values map[string]string, sensitive bool) tfsdk.Plan {
+		params := []attr.Value{}
+		for _, name := range []string{"severity", "thresholds", "options"} {
+			value, sensitiveValue := types.StringValue(values[name]), types.StringNull()
+			if sensitive && name == "thresholds" {
+				value, sensitiveValue = types.StringNull(), types.StringValue(values[name])
+			}
+			params = append(params, types.ObjectValueMust(parameterType.AttrTypes, map[string]attr.Value{
+				"name":            types.StringValue(name),
+				"value":           value,
+				"sensitive":       types.BoolValue(sensitive && name == "thresholds"),
+				"sensitive_value": sensitiveValue,
+			}))
+		}
+		m := unifiedpolicyresource.RuleResourceModel{
+			ID:                 types.StringUnknown(),
+			Name:               types.StringValue("rule"),
+			Description:        types.StringUnknown(),
+			IsCustom:           types.BoolUnknown(),
+			TemplateID:         types.StringValue("2001"),
+			Parameters:         types.ListValueMust(parameterType, params),
+			ParametersJSON:     types.StringUnknown(),
+			ParameterTypes:     types.MapNull(types.StringType),
+			TemplateParameters: templateParametersNull,
+		}
+		p := tfsdk.Plan{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+		if diags := p.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return p
+	}
+
+	tests := []struct {
+		name      string
+		values    map[string]string
+		sensitive bool
+		// errorAttribute is the attribute of the thresholds parameter the error is reported on, if any
+		errorAttribute string
+	}{
+		{
+			name:   "matches schema",
+			values: map[string]string{"severity": "high", "thresholds": `{"limit":3}`, "options": `{"any":true}`},
+		},
+		{
+			name:           "does not match schema",
+			values:         map[string]string{"severity": "high", "thresholds": `{"limit":"three"}`, "options": `{}`},
+			errorAttribute: "value",
+		},
+		{
+			name:           "not an object",
+			values:         map[string]string{"severity": "high", "thresholds": "three", "options": `{}`},
+			errorAttribute: "value",
+		},
+		{
+			name:           "sensitive value does not match schema",
+			values:         map[string]string{"severity": "high", "thresholds": `{}`, "options": `{}`},
+			sensitive:      true,
+			errorAttribute: "sensitive_value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := fwresource.ModifyPlanRequest{
+				Plan:  plan(tt.values, tt.sensitive),
+				State: tfsdk.State{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if tt.errorAttribute == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("expected one error, got diagnostics: %v", resp.Diagnostics)
+			}
+			withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+			expectedPath := path.Root("parameters").AtListIndex(1).AtName(tt.errorAttribute)
+			if !ok || !withPath.Path().Equal(expectedPath) {
+				t.Errorf("expected error at %s, got %v", expectedPath, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestRuleModifyPlanTemplateParameters(t *testing.T) {
+	ctx := context.Background()
+
+	// No client: the template must be taken from template_parameters without a request
+	r := &unifiedpolicyresource.RuleResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	ruleSchema := schemaResp.Schema
+	parameterType := ruleSchema.Attributes["parameters"].GetType().(types.ListType).ElemType.(types.ObjectType)
+	templateParameterType := ruleSchema.Attributes["template_parameters"].GetType().(types.ListType).ElemType.(types.ObjectType)
+
+	templateParameters := types.ListValueMust(templateParameterType, []attr.Value{
+		types.ObjectValueMust(templateParameterType.AttrTypes, map[string]attr.Value{
+			"name":    types.StringValue("severity"),
+			"type":    types.StringValue("string"),
+			"schema":  types.StringNull(),
+			"default": types.StringNull(),
+		}),
+		types.ObjectValueMust(templateParameterType.AttrTypes, map[string]attr.Value{
+			"name":    types.StringValue("max_issues"),
+			"type":    types.StringValue("int"),
+			"schema":  types.StringNull(),
+			"default": types.StringNull(),
+		}),
+	})
+	templateParametersWithDefault := types.ListValueMust(templateParameterType, []attr.Value{
+		templateParameters.Elements()[0],
+		types.ObjectValueMust(templateParameterType.AttrTypes, map[string]attr.Value{
+			"name":    types.StringValue("max_issues"),
+			"type":    types.StringValue("int"),
+			"schema":  types.StringNull(),
+			"default": types.StringValue("5"),
+		}),
+	})
+
+	plan := func(templateParameters types.List, values ...string) tfsdk.Plan {
+		params := []attr.Value{}
+		for i := 0; i < len(values); i += 2 {
+			params = append(params, types.ObjectValueMust(parameterType.AttrTypes, map[string]attr.Value{
+				"name":            types.StringValue(values[i]),
+				"value":           types.StringValue(values[i+1]),
+				"sensitive":       types.BoolValue(false),
+				"sensitive_value": types.StringNull(),
+			}))
+		}
+		m := unifiedpolicyresource.RuleResourceModel{
+			ID:                 types.StringUnknown(),
+			Name:               types.StringValue("rule"),
+			Description:        types.StringUnknown(),
+			IsCustom:           types.BoolUnknown(),
+			TemplateID:         types.StringUnknown(), // created in the same apply
+			Parameters:         types.ListValueMust(parameterType, params),
+			ParametersJSON:     types.StringUnknown(),
+			ParameterTypes:     types.MapNull(types.StringType),
+			TemplateParameters: templateParameters,
+		}
+		p := tfsdk.Plan{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+		if diags := p.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return p
+	}
+
+	tests := []struct {
+		name string
+		plan tfsdk.Plan
+		// expectedErrors are the summaries and paths of the expected errors
+		expectedErrors map[string]path.Path
+	}{
+		{
+			name: "matches template",
+			plan: plan(templateParameters, "severity", "high", "max_issues", "3"),
+		},
+		{
+			name: "unknown parameter",
+			plan: plan(templateParameters, "severity", "high", "max_issues", "3", "owner", "team"),
+			expectedErrors: map[string]path.Path{
+				"Unknown Rule Parameter": path.Root("parameters").AtListIndex(2).AtName("name"),
+			},
+		},
+		{
+			name: "missing parameter",
+			plan: plan(templateParameters, "severity", "high"),
+			expectedErrors: map[string]path.Path{
+				"Missing Rule Parameters": path.Root("parameters"),
+			},
+		},
+		{
+			name: "missing parameter with default",
+			plan: plan(templateParametersWithDefault, "severity", "high"),
+		},
+		{
+			name: "invalid type",
+			plan: plan(templateParameters, "severity", "high", "max_issues", "three"),
+			expectedErrors: map[string]path.Path{
+				"Invalid Rule Parameter Value": path.Root("parameters").AtListIndex(1).AtName("value"),
+			},
+		},
+		{
+			name: "template parameters not known",
+			plan: plan(types.ListUnknown(templateParameterType), "owner", "team"),
+		},
+		{
+			name: "template parameters not set",
+			plan: plan(types.ListNull(templateParameterType), "owner", "team"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := fwresource.ModifyPlanRequest{
+				Plan:  tt.plan,
+				State: tfsdk.State{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.ErrorsCount() != len(tt.expectedErrors) {
+				t.Fatalf("expected %d error(s), got diagnostics: %v", len(tt.expectedErrors), resp.Diagnostics)
+			}
+			for _, d := range resp.Diagnostics.Errors() {
+				expectedPath, ok := tt.expectedErrors[d.Summary()]
+				withPath, hasPath := d.(diag.DiagnosticWithPath)
+				if !ok || !hasPath || !withPath.Path().Equal(expectedPath) {
+					t.Errorf("unexpected error %q: %v", d.Summary(), resp.Diagnostics)
+				}
+			}
+		})
+	}
+}
+
+func TestRuleModifyPlanUnknownParameter(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/templates/2001") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"2001","parameters":[{"name":"severity","type":"string"},{"name":"max_issues","type":"int"}]}`))
+	}))
+	defer server.Close()
+
+	r := &unifiedpolicyresource.RuleResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	ruleSchema := schemaResp.Schema
+	parameterType := ruleSchema.Attributes["parameters"].GetType().(types.ListType).ElemType.(types.ObjectType)
+
+	plan := func(names ...string) tfsdk.Plan {
+		params := make([]attr.Value, len(names))
+		for i, name := range names {
+			params[i] = types.ObjectValueMust(parameterType.AttrTypes, map[string]attr.Value{
+				"name":            types.StringValue(name),
+				"value":           types.StringValue("1"),
+				"sensitive":       types.BoolValue(false),
+				"sensitive_value": types.StringNull(),
+			})
+		}
+		p := tfsdk.Plan{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+		diags := p.Set(ctx, &unifiedpolicyresource.RuleResourceModel{
+			ID:                 types.StringUnknown(),
+			Name:               types.StringValue("rule"),
+			Description:        types.StringUnknown(),
+			IsCustom:           types.BoolUnknown(),
+			TemplateID:         types.StringValue("2001"),
+			Parameters:         types.ListValueMust(parameterType, params),
+			ParametersJSON:     types.StringUnknown(),
+			ParameterTypes:     types.MapNull(types.StringType),
+			TemplateParameters: templateParametersNull,
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return p
+	}
+
+	tests := []struct {
+		name          string
+		names         []string
+		expectedError string
+	}{
+		{name: "declared parameters", names: []string{"severity", "max_issues"}},
+		// Missing parameters are only reported with template_parameters
+		{name: "subset of declared parameters", names: []string{"severity"}},
+		{
+			name:          "typo",
+			names:         []string{"severity", "max_isues"},
+			expectedError: "Parameter 'max_isues' is not declared by template '2001'. Declared parameters: severity, max_issues.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := fwresource.ModifyPlanRequest{
+				Plan:  plan(tt.names...),
+				State: tfsdk.State{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if tt.expectedError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			errors := resp.Diagnostics.Errors()
+			if len(errors) != 1 || errors[0].Summary() != "Unknown Rule Parameter" || errors[0].Detail() != tt.expectedError {
+				t.Errorf("expected error %q, got diagnostics: %v", tt.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestRuleWrite_invalidParameterType(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		id     types.String
+		method string
+		write  func(r *unifiedpolicyresource.RuleResource, plan tfsdk.Plan) diag.Diagnostics
+	}{
+		{
+			name:   "create",
+			id:     types.StringUnknown(),
+			method: http.MethodPost,
+			write: func(r *unifiedpolicyresource.RuleResource, plan tfsdk.Plan) diag.Diagnostics {
+				resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+				r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+				return resp.Diagnostics
+			},
+		},
+		{
+			name:   "update",
+			id:     types.StringValue("3001"),
+			method: http.MethodPut,
+			write: func(r *unifiedpolicyresource.RuleResource, plan tfsdk.Plan) diag.Diagnostics {
+				resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+				r.Update(ctx, fwresource.UpdateRequest{Plan: plan}, resp)
+				return resp.Diagnostics
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == tt.method && strings.Contains(r.URL.Path, ruleEndpoint) {
+					t.Errorf("unexpected %s %s: the %s must fail before the rule is sent", r.Method, r.URL.Path, tt.name)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id":"2001","parameters":[{"name":"severity","type":"string"},{"name":"max_issues","type":"int"}]}`))
+			}))
+			defer server.Close()
+
+			r := &unifiedpolicyresource.RuleResource{
+				ProviderData: unifiedpolicy.ProviderMetadata{
+					ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+				},
+			}
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			ruleSchema := schemaResp.Schema
+			parameterType := ruleSchema.Attributes["parameters"].GetType().(types.ListType).ElemType.(types.ObjectType)
+
+			param := func(name, value string) attr.Value {
+				return types.ObjectValueMust(parameterType.AttrTypes, map[string]attr.Value{
+					"name":            types.StringValue(name),
+					"value":           types.StringValue(value),
+					"sensitive":       types.BoolValue(false),
+					"sensitive_value": types.StringNull(),
+				})
+			}
+			plan := tfsdk.Plan{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+			diags := plan.Set(ctx, &unifiedpolicyresource.RuleResourceModel{
+				ID:                    tt.id,
+				Name:                  types.StringValue("rule"),
+				Description:           types.StringNull(),
+				IsCustom:              types.BoolValue(true),
+				TemplateID:            types.StringValue("2001"),
+				Parameters:            types.ListValueMust(parameterType, []attr.Value{param("severity", "high"), param("max_issues", "high")}),
+				ParametersJSON:        types.StringUnknown(),
+				IncludeParameterTypes: types.BoolValue(false),
+				ParameterTypes:        types.MapNull(types.StringType),
+				TemplateParameters:    templateParametersNull,
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			diags = tt.write(r, plan)
+			if diags.ErrorsCount() != 1 {
+				t.Fatalf("expected one error, got diagnostics: %v", diags)
+			}
+			withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+			expectedPath := path.Root("parameters").AtListIndex(1).AtName("value")
+			if !ok || !withPath.Path().Equal(expectedPath) {
+				t.Errorf("expected error at %s, got %v", expectedPath, diags)
+			}
+		})
+	}
+}
+
+func TestRuleCreate_nameConflictWithPrefix(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, ruleEndpoint) {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"errors":[{"message":"name already exists"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"2001","parameters":[{"name":"severity","type":"string"}]}`))
+	}))
+	defer server.Close()
+
+	r := &unifiedpolicyresource.RuleResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+			NamePrefix:       "team-a-",
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	ruleSchema := schemaResp.Schema
+	parameterType := ruleSchema.Attributes["parameters"].GetType().(types.ListType).ElemType.(types.ObjectType)
+
+	plan := tfsdk.Plan{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+	diags := plan.Set(ctx, &unifiedpolicyresource.RuleResourceModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("rule"),
+		Description: types.StringNull(),
+		IsCustom:    types.BoolValue(true),
+		TemplateID:  types.StringValue("2001"),
+		Parameters: types.ListValueMust(parameterType, []attr.Value{types.ObjectValueMust(parameterType.AttrTypes, map[string]attr.Value{
+			"name":            types.StringValue("severity"),
+			"value":           types.StringValue("high"),
+			"sensitive":       types.BoolValue(false),
+			"sensitive_value": types.StringNull(),
+		})}),
+		ParametersJSON:        types.StringUnknown(),
+		IncludeParameterTypes: types.BoolValue(false),
+		ParameterTypes:        types.MapNull(types.StringType),
+		TemplateParameters:    templateParametersNull,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: ruleSchema, Raw: plan.Raw}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 1 || errs[0].Summary() != "Rule Already Exists" {
+		t.Fatalf("expected a name conflict, got diagnostics: %v", resp.Diagnostics)
+	}
+	if !strings.Contains(errs[0].Detail(), "'team-a-rule'") {
+		t.Errorf("expected the conflict to name the prefixed rule name, got %q", errs[0].Detail())
+	}
+}
+
+func TestRuleModifyPlanSortParametersByName(t *testing.T) {
+	ctx := context.Background()
+
+	r := &unifiedpolicyresource.RuleResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{SortParametersByName: true},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	ruleSchema := schemaResp.Schema
+	parameterType := ruleSchema.Attributes["parameters"].GetType().(types.ListType).ElemType.(types.ObjectType)
+
+	plan := func(names ...string) tfsdk.Plan {
+		params := make([]attr.Value, len(names))
+		for i, name := range names {
+			params[i] = types.ObjectValueMust(parameterType.AttrTypes, map[string]attr.Value{
+				"name":            types.StringValue(name),
+				"value":           types.StringValue("v"),
+				"sensitive":       types.BoolValue(false),
+				"sensitive_value": types.StringNull(),
+			})
+		}
+		m := unifiedpolicyresource.RuleResourceModel{
+			ID:                 types.StringUnknown(),
+			Name:               types.StringValue("rule"),
+			Description:        types.StringUnknown(),
+			IsCustom:           types.BoolUnknown(),
+			TemplateID:         types.StringValue("2001"),
+			Parameters:         types.ListValueMust(parameterType, params),
+			ParametersJSON:     types.StringUnknown(),
+			ParameterTypes:     types.MapNull(types.StringType),
+			TemplateParameters: templateParametersNull,
+		}
+		p := tfsdk.Plan{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+		if diags := p.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return p
+	}
+
+	tests := []struct {
+		name        string
+		names       []string
+		expectError bool
+	}{
+		{name: "sorted", names: []string{"enabled", "max_count", "severity"}},
+		{name: "not sorted", names: []string{"severity", "enabled"}, expectError: true},
+		{name: "empty", names: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := fwresource.ModifyPlanRequest{
+				Plan:  plan(tt.names...),
+				State: tfsdk.State{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("expected error %v, got diagnostics: %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestRuleModifyPlanNamePrefix(t *testing.T) {
+	ctx := context.Background()
+
+	r := &unifiedpolicyresource.RuleResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{NamePrefix: "teamA/"},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	ruleSchema := schemaResp.Schema
+	parameterType := ruleSchema.Attributes["parameters"].GetType().(types.ListType).ElemType
+
+	tests := []struct {
+		name        string
+		length      int
+		expectError bool
+	}{
+		{name: "fits with prefix", length: unifiedpolicy.MaxNameLength - len("teamA/")},
+		{name: "too long with prefix", length: unifiedpolicy.MaxNameLength - len("teamA/") + 1, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+			diags := plan.Set(ctx, &unifiedpolicyresource.RuleResourceModel{
+				ID:                 types.StringUnknown(),
+				Name:               types.StringValue(strings.Repeat("a", tt.length)),
+				Description:        types.StringUnknown(),
+				IsCustom:           types.BoolUnknown(),
+				TemplateID:         types.StringValue("2001"),
+				Parameters:         types.ListValueMust(parameterType, []attr.Value{}),
+				ParametersJSON:     types.StringUnknown(),
+				ParameterTypes:     types.MapNull(types.StringType),
+				TemplateParameters: templateParametersNull,
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			req := fwresource.ModifyPlanRequest{
+				Plan:  plan,
+				State: tfsdk.State{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("expected error %v, got diagnostics: %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestRuleModifyPlanChangeSummary(t *testing.T) {
+	ctx := context.Background()
+
+	r := &unifiedpolicyresource.RuleResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	ruleSchema := schemaResp.Schema
+	parameterType := ruleSchema.Attributes["parameters"].GetType().(types.ListType).ElemType.(types.ObjectType)
+
+	parameters := func(value string) types.List {
+		return types.ListValueMust(parameterType, []attr.Value{
+			types.ObjectValueMust(parameterType.AttrTypes, map[string]attr.Value{
+				"name":            types.StringValue("severity"),
+				"value":           types.StringValue(value),
+				"sensitive":       types.BoolValue(false),
+				"sensitive_value": types.StringNull(),
+			}),
+		})
+	}
+	// config returns the configured values; the plan and state fill in the computed ones
+	config := func(name, description, severity string) unifiedpolicyresource.RuleResourceModel {
+		return unifiedpolicyresource.RuleResourceModel{
+			Name:               types.StringValue(name),
+			Description:        types.StringValue(description),
+			TemplateID:         types.StringValue("2001"),
+			Parameters:         parameters(severity),
+			ParameterTypes:     types.MapNull(types.StringType),
+			TemplateParameters: templateParametersNull,
+		}
+	}
+	stateModel := config("rule", "old", "high")
+	stateModel.ID = types.StringValue("3001")
+	stateModel.IsCustom = types.BoolValue(true)
+	stateModel.ParametersJSON = types.StringValue(`[{"name":"severity","value":"high"}]`)
+
+	tests := []struct {
+		name          string
+		config        unifiedpolicyresource.RuleResourceModel
+		noState       bool
+		changed       bool
+		stateSummary  types.String
+		expectSummary types.String
+	}{
+		{name: "create", config: config("rule", "old", "high"), noState: true, expectSummary: types.StringValue("create")},
+		{name: "no change", config: config("rule", "old", "high"), stateSummary: types.StringNull(), expectSummary: types.StringNull()},
+		{name: "no change keeps prior summary", config: config("rule", "old", "high"), stateSummary: types.StringValue("create"), expectSummary: types.StringValue("create")},
+		{
+			name:          "changes",
+			config:        config("renamed", "new", "low"),
+			changed:       true,
+			stateSummary:  types.StringNull(),
+			expectSummary: types.StringValue("description old→new; name rule→renamed; parameters changed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configValue := tfsdk.State{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+			if diags := configValue.Set(ctx, &tt.config); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			state := tfsdk.State{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+			planModel := tt.config
+			if tt.noState {
+				planModel.ID = types.StringUnknown()
+				planModel.IsCustom = types.BoolUnknown()
+				planModel.ParametersJSON = types.StringUnknown()
+				planModel.ParameterTypes = types.MapUnknown(types.StringType)
+				planModel.ChangeSummary = types.StringUnknown()
+			} else {
+				stateModel.ChangeSummary = tt.stateSummary
+				if diags := state.Set(ctx, &stateModel); diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				planModel = stateModel
+				if tt.changed {
+					// The framework marks computed values unknown when anything changes
+					planModel = tt.config
+					planModel.ID = stateModel.ID
+					planModel.IsCustom = types.BoolUnknown()
+					planModel.ParametersJSON = types.StringUnknown()
+					planModel.ParameterTypes = types.MapUnknown(types.StringType)
+					planModel.ChangeSummary = types.StringUnknown()
+				}
+			}
+			plan := tfsdk.Plan{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+			if diags := plan.Set(ctx, &planModel); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: ruleSchema, Raw: configValue.Raw},
+				Plan:   plan,
+				State:  state,
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var summary types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("change_summary"), &summary)...)
+			if !summary.Equal(tt.expectSummary) {
+				t.Errorf("expected change_summary %v, got %v", tt.expectSummary, summary)
+			}
+		})
+	}
+}
+
+func TestRuleModifyPlanParametersInTemplateOrder(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/unifiedpolicy/api/v1/templates/2001" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"2001","parameters":[{"name":"severity","type":"string"},{"name":"max_count","type":"int"},{"name":"enabled","type":"bool"}]}`))
+	}))
+	defer server.Close()
+
+	r := &unifiedpolicyresource.RuleResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata:              util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+			RuleParametersInTemplateOrder: true,
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	ruleSchema := schemaResp.Schema
+	parameterType := ruleSchema.Attributes["parameters"].GetType().(types.ListType).ElemType.(types.ObjectType)
+
+	plan := func(names ...string) tfsdk.Plan {
+		params := make([]attr.Value, len(names))
+		for i, name := range names {
+			params[i] = types.ObjectValueMust(parameterType.AttrTypes, map[string]attr.Value{
+				"name":            types.StringValue(name),
+				"value":           types.StringValue("1"),
+				"sensitive":       types.BoolValue(false),
+				"sensitive_value": types.StringNull(),
+			})
+		}
+		m := unifiedpolicyresource.RuleResourceModel{
+			ID:                 types.StringUnknown(),
+			Name:               types.StringValue("rule"),
+			Description:        types.StringUnknown(),
+			IsCustom:           types.BoolUnknown(),
+			TemplateID:         types.StringValue("2001"),
+			Parameters:         types.ListValueMust(parameterType, params),
+			ParametersJSON:     types.StringUnknown(),
+			ParameterTypes:     types.MapNull(types.StringType),
+			TemplateParameters: templateParametersNull,
+		}
+		p := tfsdk.Plan{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+		if diags := p.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return p
+	}
+
+	tests := []struct {
+		name        string
+		names       []string
+		expectError bool
+	}{
+		{name: "template order", names: []string{"severity", "max_count", "enabled"}},
+		{name: "subset in template order", names: []string{"severity", "enabled"}},
+		// Undeclared parameters are not ordered, but rejected as unknown parameters
+		{name: "undeclared parameter last", names: []string{"max_count", "extra"}, expectError: true},
+		{name: "not in template order", names: []string{"enabled", "severity"}, expectError: true},
+		{name: "undeclared parameter first", names: []string{"extra", "severity"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := fwresource.ModifyPlanRequest{
+				Plan:  plan(tt.names...),
+				State: tfsdk.State{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("expected error %v, got diagnostics: %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestOrderRuleParametersByTemplate(t *testing.T) {
+	params := []unifiedpolicyresource.RuleParameterAPIModel{
+		{Name: "enabled", Value: "true"},
+		{Name: "extra", Value: "x"},
+		{Name: "severity", Value: "high"},
+		{Name: "other", Value: "y"},
+		{Name: "max_count", Value: "3"},
+	}
+	templateOrder := []string{"severity", "max_count", "enabled", "unused"}
+
+	got := unifiedpolicyresource.OrderRuleParametersByTemplate(params, templateOrder)
+	expected := []unifiedpolicyresource.RuleParameterAPIModel{
+		{Name: "severity", Value: "high"},
+		{Name: "max_count", Value: "3"},
+		{Name: "enabled", Value: "true"},
+		{Name: "extra", Value: "x"},
+		{Name: "other", Value: "y"},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+	if params[0].Name != "enabled" {
+		t.Error("expected the input parameters to be left unchanged")
+	}
+}
+
 func TestAccRule_withoutParameters(t *testing.T) {
 	acctest.SkipIfNotAcc(t)
 	acctest.PreCheck(t)
@@ -478,9 +1669,10 @@ func TestAccRule_import(t *testing.T) {
 				Config: config,
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"change_summary"}, // only describes the plan that created the resource
 			},
 		},
 	})
@@ -926,3 +2118,456 @@ func testAccCheckRuleDestroy(fqrn string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestRuleRead_notFoundStatusCodes(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusGone)
+		_, _ = w.Write([]byte(`{"errors":[{"code":"GONE","message":"rule was deleted"}]}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name          string
+		codes         []int
+		expectRemoved bool
+	}{
+		{name: "410 is an error by default", expectRemoved: false},
+		{name: "410 configured as not found", codes: []int{http.StatusNotFound, http.StatusGone}, expectRemoved: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &unifiedpolicyresource.RuleResource{
+				ProviderData: unifiedpolicy.ProviderMetadata{
+					ProviderMetadata:    util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+					NotFoundStatusCodes: tt.codes,
+				},
+			}
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			ruleSchema := schemaResp.Schema
+			parameterType := ruleSchema.Attributes["parameters"].GetType().(types.ListType).ElemType
+
+			state := tfsdk.State{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+			diags := state.Set(ctx, &unifiedpolicyresource.RuleResourceModel{
+				ID:                    types.StringValue("3001"),
+				Name:                  types.StringValue("rule"),
+				Description:           types.StringNull(),
+				IsCustom:              types.BoolValue(true),
+				TemplateID:            types.StringValue("2001"),
+				Parameters:            types.ListValueMust(parameterType, []attr.Value{}),
+				ParametersJSON:        types.StringValue("[]"),
+				IncludeParameterTypes: types.BoolValue(false),
+				ParameterTypes:        types.MapNull(types.StringType),
+				TemplateParameters:    templateParametersNull,
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+
+			if removed := resp.State.Raw.IsNull(); removed != tt.expectRemoved {
+				t.Errorf("expected removed %v, got %v (diagnostics: %v)", tt.expectRemoved, removed, resp.Diagnostics)
+			}
+			if resp.Diagnostics.HasError() == tt.expectRemoved {
+				t.Errorf("expected error %v, got diagnostics: %v", !tt.expectRemoved, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestNormalizeBooleanParameterValue(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+		ok       bool
+	}{
+		{value: "true", expected: "true", ok: true},
+		{value: "True", expected: "true", ok: true},
+		{value: "TRUE", expected: "true", ok: true},
+		{value: "tRuE", expected: "true", ok: true},
+		{value: "1", expected: "true", ok: true},
+		{value: "t", expected: "true", ok: true},
+		{value: " true ", expected: "true", ok: true},
+		{value: "false", expected: "false", ok: true},
+		{value: "False", expected: "false", ok: true},
+		{value: "0", expected: "false", ok: true},
+		{value: "F", expected: "false", ok: true},
+		{value: "yes", ok: false},
+		{value: "", ok: false},
+		{value: "2", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			normalized, ok := unifiedpolicyresource.NormalizeBooleanParameterValue(tt.value)
+			if ok != tt.ok {
+				t.Fatalf("expected ok %v, got %v", tt.ok, ok)
+			}
+			if ok && normalized != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, normalized)
+			}
+		})
+	}
+}
+
+func TestNormalizeBooleanParameters(t *testing.T) {
+	params := []unifiedpolicyresource.RuleParameterAPIModel{
+		{Name: "enabled", Value: "True"},
+		{Name: "strict", Value: "0"},
+		{Name: "label", Value: "False"},
+		{Name: "blocking", Value: "yes"},
+		{Name: "undeclared", Value: "TRUE"},
+	}
+	parameterTypes := map[string]string{"enabled": "bool", "strict": "bool", "label": "string", "blocking": "bool"}
+
+	normalized, invalid := unifiedpolicyresource.NormalizeBooleanParameters(params, parameterTypes)
+
+	expected := []unifiedpolicyresource.RuleParameterAPIModel{
+		{Name: "enabled", Value: "true"},
+		{Name: "strict", Value: "false"},
+		{Name: "label", Value: "False"},
+		{Name: "blocking", Value: "yes"},
+		{Name: "undeclared", Value: "TRUE"},
+	}
+	if !reflect.DeepEqual(normalized, expected) {
+		t.Errorf("expected %v, got %v", expected, normalized)
+	}
+	if !reflect.DeepEqual(invalid, []string{"blocking"}) {
+		t.Errorf("expected invalid [blocking], got %v", invalid)
+	}
+	if params[0].Value != "True" {
+		t.Errorf("expected the input to be left unchanged, got %v", params)
+	}
+	if normalized, _ := unifiedpolicyresource.NormalizeBooleanParameters(params, nil); !reflect.DeepEqual(normalized, params) {
+		t.Errorf("expected no change without parameter types, got %v", normalized)
+	}
+}
+
+func TestRuleParameterStateValue(t *testing.T) {
+	tests := []struct {
+		configured string
+		returned   string
+		expected   string
+	}{
+		{configured: "True", returned: "true", expected: "True"},
+		{configured: "1", returned: "true", expected: "1"},
+		{configured: "F", returned: "false", expected: "F"},
+		{configured: "true", returned: "true", expected: "true"},
+		{configured: "True", returned: "false", expected: "false"},
+		{configured: "high", returned: "critical", expected: "critical"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.configured+"_"+tt.returned, func(t *testing.T) {
+			if got := unifiedpolicyresource.RuleParameterStateValue(tt.configured, tt.returned); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestReadRuleParametersFile(t *testing.T) {
+	expected := []unifiedpolicyresource.RuleParameterAPIModel{
+		{Name: "enabled", Value: "true"},
+		{Name: "max_count", Value: "5"},
+		{Name: "severity", Value: "high"},
+	}
+
+	tests := []struct {
+		name        string
+		path        string
+		expected    []unifiedpolicyresource.RuleParameterAPIModel
+		expectError bool
+	}{
+		{name: "object", path: acctest.RegoFixturePath(t, "rule_parameters.json"), expected: expected},
+		{
+			name: "list",
+			path: acctest.RegoFixturePath(t, "rule_parameters_list.json"),
+			expected: []unifiedpolicyresource.RuleParameterAPIModel{
+				{Name: "severity", Value: "high"},
+				{Name: "max_count", Value: "5"},
+				{Name: "enabled", Value: "true"},
+			},
+		},
+		{name: "invalid JSON", path: acctest.RegoFixturePath(t, "rule_parameters_invalid.json"), expectError: true},
+		{name: "missing file", path: acctest.RegoFixturePath(t, "missing.json"), expectError: true},
+		{name: "relative path", path: "test-fixtures/rule_parameters.json", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := unifiedpolicyresource.ReadRuleParametersFile(tt.path)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("expected error %v, got %v", tt.expectError, err)
+			}
+			if !tt.expectError && !reflect.DeepEqual(params, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, params)
+			}
+		})
+	}
+}
+
+func TestCarryOverRuleParameters(t *testing.T) {
+	params := []unifiedpolicyresource.RuleParameterModel{
+		{Name: types.StringValue("severity"), Value: types.StringValue("high")},
+		{Name: types.StringValue("legacy"), Value: types.StringValue("x")},
+		{Name: types.StringValue("max_count"), Value: types.StringValue("5")},
+	}
+
+	tests := []struct {
+		name            string
+		templateParams  []unifiedpolicyresource.TemplateParameterAPIModel
+		expectedCarried []string
+		expectedDropped []string
+	}{
+		{
+			name: "partial overlap",
+			templateParams: []unifiedpolicyresource.TemplateParameterAPIModel{
+				{Name: "max_count", Type: "int"}, {Name: "severity", Type: "string"}, {Name: "new_param", Type: "bool"},
+			},
+			expectedCarried: []string{"severity", "max_count"},
+			expectedDropped: []string{"legacy"},
+		},
+		{
+			name: "full overlap",
+			templateParams: []unifiedpolicyresource.TemplateParameterAPIModel{
+				{Name: "severity", Type: "string"}, {Name: "legacy", Type: "string"}, {Name: "max_count", Type: "int"},
+			},
+			expectedCarried: []string{"severity", "legacy", "max_count"},
+		},
+		{
+			name:            "no overlap",
+			templateParams:  []unifiedpolicyresource.TemplateParameterAPIModel{{Name: "threshold", Type: "float"}},
+			expectedCarried: []string{},
+			expectedDropped: []string{"severity", "legacy", "max_count"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			carried, dropped := unifiedpolicyresource.CarryOverRuleParameters(params, tt.templateParams)
+			names := []string{}
+			for _, p := range carried {
+				names = append(names, p.Name.ValueString())
+			}
+			if !reflect.DeepEqual(names, tt.expectedCarried) {
+				t.Errorf("expected carried parameters %v, got %v", tt.expectedCarried, names)
+			}
+			if !reflect.DeepEqual(dropped, tt.expectedDropped) {
+				t.Errorf("expected dropped parameters %v, got %v", tt.expectedDropped, dropped)
+			}
+		})
+	}
+}
+
+func TestRuleModifyPlanCarryOverParameters(t *testing.T) {
+	ctx := context.Background()
+
+	templates := map[string]string{
+		"2001": `{"id":"2001","parameters":[{"name":"severity","type":"string"},{"name":"max_count","type":"int"}]}`,
+		"2002": `{"id":"2002","parameters":[{"name":"severity","type":"int"}]}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		template, ok := templates[r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]]
+		if !ok || !strings.Contains(r.URL.Path, "/templates/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(template))
+	}))
+	defer server.Close()
+
+	r := &unifiedpolicyresource.RuleResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	ruleSchema := schemaResp.Schema
+	parameterType := ruleSchema.Attributes["parameters"].GetType().(types.ListType).ElemType.(types.ObjectType)
+
+	parameter := func(name, value string) attr.Value {
+		return types.ObjectValueMust(parameterType.AttrTypes, map[string]attr.Value{
+			"name":            types.StringValue(name),
+			"value":           types.StringValue(value),
+			"sensitive":       types.BoolValue(false),
+			"sensitive_value": types.StringNull(),
+		})
+	}
+	state := unifiedpolicyresource.RuleResourceModel{
+		ID:          types.StringValue("1001"),
+		Name:        types.StringValue("rule"),
+		Description: types.StringValue(""),
+		IsCustom:    types.BoolValue(true),
+		TemplateID:  types.StringValue("1000"),
+		Parameters: types.ListValueMust(parameterType, []attr.Value{
+			parameter("severity", "high"), parameter("legacy", "x"), parameter("max_count", "5"),
+		}),
+		ParametersJSON:      types.StringValue(`{"legacy":"x","max_count":"5","severity":"high"}`),
+		ParameterTypes:      types.MapNull(types.StringType),
+		TemplateParameters:  templateParametersNull,
+		CarryOverParameters: types.BoolValue(true),
+	}
+	set := func(m unifiedpolicyresource.RuleResourceModel) tftypes.Value {
+		value := tfsdk.State{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+		if diags := value.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return value.Raw
+	}
+
+	tests := []struct {
+		name               string
+		templateID         string
+		expectedParameters string
+		expectedWarning    string
+		expectedError      string
+	}{
+		{
+			name:               "same template",
+			templateID:         "1000",
+			expectedParameters: `{"legacy":"x","max_count":"5","severity":"high"}`,
+		},
+		{
+			name:               "partial overlap",
+			templateID:         "2001",
+			expectedParameters: `{"max_count":"5","severity":"high"}`,
+			expectedWarning:    "Parameters legacy of template '1000' are not declared by template '2001' and are not carried over.",
+		},
+		{
+			name:          "carried value of another type",
+			templateID:    "2002",
+			expectedError: "Parameter 'severity' of template '2002' has type int; its value is not a valid int.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := state
+			config.ID, config.Description, config.IsCustom = types.StringNull(), types.StringNull(), types.BoolNull()
+			config.TemplateID = types.StringValue(tt.templateID)
+			config.Parameters, config.ParametersJSON = types.ListNull(parameterType), types.StringNull()
+			plan := state
+			plan.TemplateID = types.StringValue(tt.templateID)
+			plan.Parameters, plan.ParametersJSON = types.ListValueMust(parameterType, []attr.Value{}), types.StringValue("{}")
+
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: ruleSchema, Raw: set(config)},
+				Plan:   tfsdk.Plan{Schema: ruleSchema, Raw: set(plan)},
+				State:  tfsdk.State{Schema: ruleSchema, Raw: set(state)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if tt.expectedError != "" {
+				errors := resp.Diagnostics.Errors()
+				if len(errors) != 1 || errors[0].Detail() != tt.expectedError {
+					t.Errorf("expected error %q, got diagnostics: %v", tt.expectedError, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			warnings := resp.Diagnostics.Warnings()
+			switch {
+			case tt.expectedWarning == "" && len(warnings) > 0:
+				t.Errorf("unexpected warnings: %v", warnings)
+			case tt.expectedWarning != "" && (len(warnings) != 1 || warnings[0].Detail() != tt.expectedWarning):
+				t.Errorf("expected warning %q, got %v", tt.expectedWarning, warnings)
+			}
+
+			var planned unifiedpolicyresource.RuleResourceModel
+			if diags := resp.Plan.Get(ctx, &planned); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if planned.ParametersJSON.ValueString() != tt.expectedParameters {
+				t.Errorf("expected parameters_json %s, got %s", tt.expectedParameters, planned.ParametersJSON.ValueString())
+			}
+		})
+	}
+}
+
+func TestRuleModifyPlanParametersFile(t *testing.T) {
+	ctx := context.Background()
+
+	r := &unifiedpolicyresource.RuleResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	ruleSchema := schemaResp.Schema
+	parameterType := ruleSchema.Attributes["parameters"].GetType().(types.ListType).ElemType.(types.ObjectType)
+
+	plan := func(parametersFile string) tfsdk.Plan {
+		m := unifiedpolicyresource.RuleResourceModel{
+			ID:                 types.StringUnknown(),
+			Name:               types.StringValue("rule"),
+			Description:        types.StringUnknown(),
+			IsCustom:           types.BoolUnknown(),
+			TemplateID:         types.StringValue("2001"),
+			Parameters:         types.ListValueMust(parameterType, []attr.Value{}),
+			ParametersFile:     types.StringValue(parametersFile),
+			ParametersJSON:     types.StringUnknown(),
+			ParameterTypes:     types.MapNull(types.StringType),
+			TemplateParameters: templateParametersNull,
+		}
+		p := tfsdk.Plan{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)}
+		if diags := p.Set(ctx, &m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return p
+	}
+
+	t.Run("valid file", func(t *testing.T) {
+		req := fwresource.ModifyPlanRequest{
+			Plan:  plan(acctest.RegoFixturePath(t, "rule_parameters_list.json")),
+			State: tfsdk.State{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)},
+		}
+		resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+		r.ModifyPlan(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+
+		var planned unifiedpolicyresource.RuleResourceModel
+		if diags := resp.Plan.Get(ctx, &planned); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		var params []unifiedpolicyresource.RuleParameterModel
+		if diags := planned.Parameters.ElementsAs(ctx, &params, false); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		var names []string
+		for _, p := range params {
+			names = append(names, p.Name.ValueString()+"="+p.Value.ValueString())
+		}
+		if expected := []string{"severity=high", "max_count=5", "enabled=true"}; !reflect.DeepEqual(names, expected) {
+			t.Errorf("expected parameters %v, got %v", expected, names)
+		}
+		if expected := `{"enabled":"true","max_count":"5","severity":"high"}`; planned.ParametersJSON.ValueString() != expected {
+			t.Errorf("expected parameters_json %s, got %s", expected, planned.ParametersJSON.ValueString())
+		}
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		req := fwresource.ModifyPlanRequest{
+			Plan:  plan(acctest.RegoFixturePath(t, "rule_parameters_invalid.json")),
+			State: tfsdk.State{Schema: ruleSchema, Raw: tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)},
+		}
+		resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+		r.ModifyPlan(ctx, req, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Fatal("expected an error for an invalid parameters file")
+		}
+	})
+}
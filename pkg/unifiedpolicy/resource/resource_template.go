@@ -16,14 +16,24 @@ package resource
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"io/fs"
 	"net/http"
 	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -35,20 +45,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
 	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/format"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 const (
-	TemplatesEndpoint = "unifiedpolicy/api/v1/templates"
+	TemplatesEndpoint = unifiedpolicy.DefaultAPIPathPrefix + "/templates"
 	TemplateEndpoint  = TemplatesEndpoint + "/{templateId}"
 )
 
 var _ resource.Resource = &TemplateResource{}
+var _ resource.ResourceWithModifyPlan = &TemplateResource{}
+var _ resource.ResourceWithConfigValidators = &TemplateResource{}
 
 func NewTemplateResource() resource.Resource {
 	return &TemplateResource{
@@ -57,26 +72,41 @@ func NewTemplateResource() resource.Resource {
 }
 
 type TemplateResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData unifiedpolicy.ProviderMetadata
 	TypeName     string
 }
 
 type TemplateResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	Version        types.String `tfsdk:"version"`
-	Category       types.String `tfsdk:"category"`
-	DataSourceType types.String `tfsdk:"data_source_type"`
-	Parameters     types.List   `tfsdk:"parameters"`
-	Rego           types.String `tfsdk:"rego"` // Path to .rego file (or Rego code when reading from API)
-	Scanners       types.List   `tfsdk:"scanners"`
-	IsCustom       types.Bool   `tfsdk:"is_custom"`
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	Version                types.String `tfsdk:"version"`
+	Category               types.String `tfsdk:"category"`
+	DataSourceType         types.String `tfsdk:"data_source_type"`
+	Parameters             types.List   `tfsdk:"parameters"`
+	Rego                   types.String `tfsdk:"rego"` // Path to .rego file (or Rego code when reading from API)
+	RegoContent            types.String `tfsdk:"rego_content"`
+	Scanners               types.List   `tfsdk:"scanners"`
+	IsCustom               types.Bool   `tfsdk:"is_custom"`
+	StrictRego             types.Bool   `tfsdk:"strict_rego"`
+	RegoVersion            types.String `tfsdk:"rego_version"`
+	RegoValidationMode     types.String `tfsdk:"rego_validation_mode"`
+	ValidateParameterUsage types.Bool   `tfsdk:"validate_parameter_usage"`
+	IncludeRegoAST         types.Bool   `tfsdk:"include_rego_ast"`
+	RegoASTJSON            types.String `tfsdk:"rego_ast_json"`
+	RegoCanonicalHash      types.String `tfsdk:"rego_canonical_hash"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	CreatedBy              types.String `tfsdk:"created_by"`
+	UpdatedAt              types.String `tfsdk:"updated_at"`
+	UpdatedBy              types.String `tfsdk:"updated_by"`
+	ChangeSummary          types.String `tfsdk:"change_summary"`
 }
 
 type TemplateParameterModel struct {
-	Name types.String `tfsdk:"name"`
-	Type types.String `tfsdk:"type"`
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Schema  types.String `tfsdk:"schema"`
+	Default types.String `tfsdk:"default"`
 }
 
 // Template API models (used by this resource and template datasources)
@@ -95,11 +125,35 @@ type TemplateAPIModel struct {
 	CreatedBy      string                      `json:"created_by,omitempty"`
 	UpdatedAt      string                      `json:"updated_at,omitempty"`
 	UpdatedBy      string                      `json:"updated_by,omitempty"`
+
+	// ExtraFields holds response fields unknown to the provider so they survive read/update round-trips.
+	ExtraFields unifiedpolicy.ExtraFields `json:"-"`
+}
+
+// UnmarshalJSON keeps fields the provider does not know about in ExtraFields.
+func (m *TemplateAPIModel) UnmarshalJSON(data []byte) error {
+	type alias TemplateAPIModel
+	var a alias
+	extra, err := unifiedpolicy.UnmarshalWithExtra(data, &a)
+	if err != nil {
+		return err
+	}
+	*m = TemplateAPIModel(a)
+	m.ExtraFields = extra
+	return nil
+}
+
+// MarshalJSON sends ExtraFields back alongside the known fields.
+func (m TemplateAPIModel) MarshalJSON() ([]byte, error) {
+	type alias TemplateAPIModel
+	return unifiedpolicy.MarshalWithExtra(alias(m), m.ExtraFields)
 }
 
 type TemplateParameterAPIModel struct {
-	Name string `json:"name"`
-	Type string `json:"type"`
+	Name    string          `json:"name"`
+	Type    string          `json:"type"`
+	Schema  json.RawMessage `json:"schema,omitempty"`
+	Default *string         `json:"default,omitempty"`
 }
 
 type TemplatesListAPIModel struct {
@@ -109,27 +163,161 @@ type TemplatesListAPIModel struct {
 	PageSize int                `json:"page_size"`
 }
 
-// regoContentFromFile reads Rego code from a .rego file. The path must be an absolute (full) path
-// and must end with ".rego". Returns the file content or an error if the path is invalid or the file cannot be read.
-func regoContentFromFile(path string) (string, error) {
+// regoContentFromFile reads Rego code from a .rego file. The path must end with ".rego"; a relative path is resolved
+// against baseDir when set and the working directory otherwise, see ResolveRelativeRegoPath. When expand is true,
+// environment variables and a leading ~ are expanded first (provider attribute `expand_rego_path`) and the checks apply
+// to the expanded path. When baseDir is set (provider attribute `rego_base_dir`), the path must be within it, see CheckRegoBaseDir.
+// Returns the file content or an error if the path is invalid or the file cannot be read.
+func regoContentFromFile(path string, expand bool, baseDir string) (string, error) {
+	path, err := resolveRegoPath(path, expand, baseDir)
+	if err != nil {
+		return "", err
+	}
+	return readRegoFile(path, nil)
+}
+
+// RegoContentAndHashFromFile reads Rego code from a .rego file like regoContentFromFile and returns it together
+// with the hex encoded SHA-256 of the raw file content. The file is streamed once through the hash while it is
+// read, so large files are not held in memory twice.
+// This function is exported for testing purposes
+func RegoContentAndHashFromFile(path string, expand bool, baseDir string) (string, string, error) {
+	path, err := resolveRegoPath(path, expand, baseDir)
+	if err != nil {
+		return "", "", err
+	}
+	hash := sha256.New()
+	content, err := readRegoFile(path, hash)
+	if err != nil {
+		return "", "", err
+	}
+	return content, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// resolveRegoPath expands (when expand is true), resolves and checks a rego path: it must end with ".rego" and be within
+// baseDir when set. It returns the absolute path to read. The schema validator, ModifyPlan and toAPIModel all read
+// rego files through it, so plan-time validation and apply-time reads resolve a relative path to the same file.
+func resolveRegoPath(path string, expand bool, baseDir string) (string, error) {
 	path = strings.TrimSpace(path)
 	if path == "" {
 		return "", &regoPathError{path: path, reason: "path cannot be empty"}
 	}
-	if !filepath.IsAbs(path) {
-		return "", &regoPathError{path: path, reason: "path must be an absolute (full) path"}
+	if expand {
+		expanded, err := ExpandRegoPath(path)
+		if err != nil {
+			return "", &regoPathError{path: path, reason: "path could not be expanded (" + err.Error() + ")"}
+		}
+		path = expanded
 	}
 	if !strings.HasSuffix(path, ".rego") {
 		return "", &regoPathError{path: path, reason: "path must end with .rego"}
 	}
-	content, err := os.ReadFile(path)
+	if !filepath.IsAbs(path) {
+		if hasRegoPathTokens(path) {
+			return "", &regoPathError{path: path, reason: "path contains environment variables or ~ " +
+				"(set expand_rego_path = true in the provider configuration to expand them)"}
+		}
+		resolved, err := ResolveRelativeRegoPath(path, baseDir)
+		if err != nil {
+			return "", &regoPathError{path: path, reason: "relative path could not be resolved (" + err.Error() + ")"}
+		}
+		path = resolved
+	}
+	if err := CheckRegoBaseDir(path, baseDir); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// ResolveRelativeRegoPath returns the absolute path of a relative rego path: relative to baseDir, the provider attribute
+// rego_base_dir, when set and to the working directory of Terraform otherwise. Terraform runs the provider in the
+// directory of the root module, so a path built with path.module, e.g. "${path.module}/policies/rule.rego", resolves to
+// the file shipped with a reusable module.
+// This function is exported for testing purposes.
+func ResolveRelativeRegoPath(path, baseDir string) (string, error) {
+	dir := baseDir
+	if dir == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return "", err
+		}
+		dir = wd
+	}
+	return filepath.Join(dir, path), nil
+}
+
+// CheckRegoBaseDir returns an error when the absolute path, after cleaning and resolving symlinks, is not
+// within baseDir, the provider attribute rego_base_dir with its symlinks resolved. A path that does not exist (yet)
+// is resolved through its closest existing parent directory. An empty baseDir allows any path.
+// This function is exported for testing purposes.
+func CheckRegoBaseDir(path, baseDir string) error {
+	if baseDir == "" {
+		return nil
+	}
+	resolved := resolveSymlinks(filepath.Clean(path))
+	rel, err := filepath.Rel(baseDir, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return &regoBaseDirError{path: path, resolved: resolved, baseDir: baseDir}
+	}
+	return nil
+}
+
+// resolveSymlinks resolves the symlinks in a clean absolute path, or in its closest existing parent directory when
+// the path does not exist.
+func resolveSymlinks(path string) string {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	parent := filepath.Dir(path)
+	if parent == path {
+		return path
+	}
+	return filepath.Join(resolveSymlinks(parent), filepath.Base(path))
+}
+
+// readRegoFile streams the file into a single string buffer. When hash is not nil the content is also written to
+// it as it is read, so hashing does not need a second copy of the file.
+func readRegoFile(path string, hash io.Writer) (string, error) {
+	file, err := os.Open(path)
 	if err != nil {
 		return "", err
 	}
-	return string(content), nil
+	defer file.Close()
+
+	var content strings.Builder
+	if info, err := file.Stat(); err == nil {
+		content.Grow(int(info.Size()))
+	}
+	var reader io.Reader = file
+	if hash != nil {
+		reader = io.TeeReader(file, hash)
+	}
+	if _, err := io.Copy(&content, reader); err != nil {
+		return "", err
+	}
+	return content.String(), nil
+}
+
+// ExpandRegoPath expands $VAR / ${VAR} environment variable references and a leading ~ (home directory) in a rego path.
+// This function is exported for testing purposes
+func ExpandRegoPath(path string) (string, error) {
+	path = os.ExpandEnv(path)
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+	}
+	return path, nil
+}
+
+// hasRegoPathTokens reports whether a rego path contains environment variable references or a leading ~.
+func hasRegoPathTokens(path string) bool {
+	path = strings.TrimSpace(path)
+	return strings.Contains(path, "$") || strings.HasPrefix(path, "~")
 }
 
-// regoPathError is returned when the rego path is invalid (e.g. not absolute, wrong extension).
+// regoPathError is returned when the rego path is invalid (e.g. wrong extension, unexpanded environment variables).
 type regoPathError struct {
 	path   string
 	reason string
@@ -139,35 +327,104 @@ func (e *regoPathError) Error() string {
 	return e.reason + ": " + e.path
 }
 
-// regoContentValidator validates that the rego attribute is the full (absolute) path to a .rego file and that its content is valid.
-type regoContentValidator struct{}
+// regoBaseDirError is returned when a rego path is outside the provider rego_base_dir.
+type regoBaseDirError struct {
+	path string
+	// resolved is path with its symlinks resolved, the path that was compared with baseDir.
+	resolved string
+	baseDir  string
+}
+
+func (e *regoBaseDirError) Error() string {
+	message := "path must be within the provider rego_base_dir " + e.baseDir + ": " + e.path
+	if e.resolved != e.path {
+		message += " (resolves to " + e.resolved + ")"
+	}
+	return message
+}
+
+// regoContentValidator validates that the rego attribute is the path to a .rego file and that its content is valid,
+// or with inline set, that the rego_content attribute is valid Rego code.
+// The schema validator has no access to provider settings, so paths with environment variables or ~, relative paths
+// (resolved against rego_base_dir when set) and files that do not exist (yet) are skipped there and the length check (maxChars unset) is left out; TemplateResource.ModifyPlan
+// validates again with the provider settings. The allowed operations depend on the provider
+// extra_allowed_rego_operations, so they are only checked there too (deferOperations). With defer_rego_validation,
+// ModifyPlan also skips missing files, and toAPIModel validates them when they are read at apply time.
+type regoContentValidator struct {
+	inline               bool
+	deferExpandablePaths bool
+	deferRelativePaths   bool
+	deferMissingFiles    bool
+	deferOperations      bool
+	expandPath           bool
+	maxChars             int
+	baseDir              string
+	extraOperations      []string
+}
 
 // Description returns a plain text description of the validator.
 func (v regoContentValidator) Description(ctx context.Context) string {
-	return "Validates that rego is the full (absolute) path to a .rego file and that the Rego code is valid and uses only allowed operations"
+	if v.inline {
+		return "Validates that rego_content is valid Rego code that uses only allowed operations"
+	}
+	return "Validates that rego is the path to a .rego file and that the Rego code is valid and uses only allowed operations"
 }
 
 // MarkdownDescription returns a markdown formatted description of the validator.
 func (v regoContentValidator) MarkdownDescription(ctx context.Context) string {
-	return "Validates that rego is the full (absolute) path to a .rego file and that the Rego code is valid and uses only allowed operations"
+	return v.Description(ctx)
 }
 
 // ValidateString performs the validation.
 func (v regoContentValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	v.validateRegoFile(ctx, req, resp)
+}
+
+// validateRegoFile reads the rego file once, or takes the inline code, and validates the content. It returns the parsed
+// module and the SHA-256 of the raw content, or a nil module when the value was skipped or a check failed.
+func (v regoContentValidator) validateRegoFile(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) (*ast.Module, string) {
 	// If the value is unknown or null, skip validation
 	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
-		return
+		return nil, ""
 	}
 
-	regoPath := req.ConfigValue.ValueString()
-	regoCode, err := regoContentFromFile(regoPath)
-	if err != nil {
-		resp.Diagnostics.AddAttributeError(
-			req.Path,
-			"Rego Error",
-			"An error occurred while processing the rego file: "+err.Error(),
-		)
-		return
+	var regoCode, contentHash string
+	if v.inline {
+		regoCode = req.ConfigValue.ValueString()
+		sum := sha256.Sum256([]byte(regoCode))
+		contentHash = hex.EncodeToString(sum[:])
+	} else {
+		regoPath := req.ConfigValue.ValueString()
+		if v.deferExpandablePaths && hasRegoPathTokens(regoPath) {
+			return nil, ""
+		}
+		if v.deferRelativePaths && !filepath.IsAbs(strings.TrimSpace(regoPath)) {
+			return nil, ""
+		}
+
+		var err error
+		regoCode, contentHash, err = RegoContentAndHashFromFile(regoPath, v.expandPath, v.baseDir)
+		if err != nil {
+			if v.deferMissingFiles && errors.Is(err, fs.ErrNotExist) {
+				return nil, ""
+			}
+			var baseDirErr *regoBaseDirError
+			if errors.As(err, &baseDirErr) {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Rego Path Outside Base Directory",
+					"The rego file is not read, since the provider restricts rego paths to rego_base_dir: "+err.Error()+". "+
+						"Move the file into "+baseDirErr.baseDir+", or ask the operator of this provider configuration to allow its directory.",
+				)
+				return nil, ""
+			}
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Rego Error",
+				"An error occurred while processing the rego file: "+err.Error(),
+			)
+			return nil, ""
+		}
 	}
 
 	if regoCode == "" {
@@ -176,41 +433,43 @@ func (v regoContentValidator) ValidateString(ctx context.Context, req validator.
 			"Empty Rego",
 			"The rego path was provided but no content was found.",
 		)
-		return
+		return nil, ""
 	}
 
-	const maxRegoChars = 65536
-	if len(regoCode) > maxRegoChars {
+	if v.maxChars > 0 && len(regoCode) > v.maxChars {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Rego Code Too Long",
-			"The Rego code must be 1-65536 characters. Current length: "+strconv.Itoa(len(regoCode))+". Please shorten the policy or split into multiple modules.",
+			"The Rego code must be 1-"+strconv.Itoa(v.maxChars)+" characters. Current length: "+strconv.Itoa(len(regoCode))+". "+
+				"Please shorten the policy or split into multiple modules, or raise the provider attribute max_rego_chars if your Unified Policy version accepts larger policies.",
 		)
-		return
+		return nil, ""
 	}
 
-	// Validate Rego syntax
-	opts := ast.ParserOptions{
-		RegoVersion: ast.RegoV0,
-	}
-	module, err := ast.ParseModuleWithOpts("policy.rego", regoCode, opts)
+	// Validate Rego syntax, in the version set by the sibling rego_version attribute
+	var regoVersion types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rego_version"), &regoVersion)...)
+	module, err := parseRegoModule(regoCode, regoVersion.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid Rego Syntax",
 			"The Rego code contains syntax errors. "+err.Error()+"\n\n"+
+				regoVersionHint(regoVersion.ValueString())+"\n\n"+
 				"Please check your Rego code for:\n"+
 				"- Missing or mismatched brackets, braces, or parentheses\n"+
 				"- Incorrect package declarations\n"+
 				"- Invalid rule definitions\n"+
 				"- Syntax errors in expressions",
 		)
-		return
+		return nil, ""
 	}
 
-	// Validate that only allowed operations are used
-	allowedOps := GetAllowedRegoOperations()
-	disallowedOps := FindDisallowedOperations(module, allowedOps)
+	// Validate that only allowed operations are used; the sibling rego_validation_mode can make this a warning
+	var disallowedOps []string
+	if !v.deferOperations {
+		disallowedOps = FindDisallowedOperations(module, AllowedRegoOperations(v.extraOperations))
+	}
 	if len(disallowedOps) > 0 {
 		opsList := ""
 		for i, op := range disallowedOps {
@@ -219,15 +478,255 @@ func (v regoContentValidator) ValidateString(ctx context.Context, req validator.
 			}
 			opsList += op
 		}
-		resp.Diagnostics.AddAttributeError(
-			req.Path,
-			"Disallowed Rego Operations",
-			"The Rego code uses operations that are not allowed: "+opsList+"\n\n"+
-				"Only specific built-in OPA functions are allowed for policy evaluation.\n"+
-				"Please refer to the List of Valid Rego Operations documentation for allowed functions.",
-		)
-		return
+		var validationMode types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rego_validation_mode"), &validationMode)...)
+		if validationMode.ValueString() == RegoValidationModeWarn {
+			resp.Diagnostics.AddAttributeWarning(
+				req.Path,
+				"Disallowed Rego Operations",
+				"The Rego code uses operations that are not allowed: "+opsList+"\n\n"+
+					"The code is sent to the API anyway, since rego_validation_mode is \"warn\". "+
+					"The Unified Policy API may still reject it or fail to evaluate these operations.",
+			)
+		} else {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Disallowed Rego Operations",
+				"The Rego code uses operations that are not allowed: "+opsList+"\n\n"+
+					allowedOperationsHint(v.extraOperations),
+			)
+			return nil, ""
+		}
+	}
+
+	// Strict compilation is opt-in via the sibling strict_rego attribute
+	var strictRego types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("strict_rego"), &strictRego)...)
+	if strictRego.ValueBool() {
+		if strictErrors := CompileRegoStrict(module); len(strictErrors) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Rego Strict Mode Errors",
+				"The Rego code failed to compile in OPA strict mode:\n- "+strings.Join(strictErrors, "\n- ")+"\n\n"+
+					"Strict mode reports issues such as unused variables, unused or duplicate imports and deprecated built-ins. "+
+					"Fix the reported issues or set strict_rego to false.",
+			)
+			return nil, ""
+		}
+	}
+
+	return module, contentHash
+}
+
+// Values of the template attribute `rego_version`.
+const (
+	RegoVersionV0 = "v0"
+	RegoVersionV1 = "v1"
+)
+
+// parseRegoModule parses Rego code with the parser options used for template validation. version is a rego_version
+// value; empty (rego_version not set) means RegoVersionV0.
+func parseRegoModule(regoCode, version string) (*ast.Module, error) {
+	opts := ast.ParserOptions{
+		RegoVersion: ast.RegoV0,
+	}
+	if version == RegoVersionV1 {
+		opts.RegoVersion = ast.RegoV1
+	}
+	return ast.ParseModuleWithOpts("policy.rego", regoCode, opts)
+}
+
+// regoVersionName returns the rego_version value that version stands for, RegoVersionV0 when it is empty.
+func regoVersionName(version string) string {
+	if version == RegoVersionV1 {
+		return RegoVersionV1
+	}
+	return RegoVersionV0
+}
+
+// regoVersionHint explains which Rego version the code was parsed as, and how to switch, for syntax errors.
+func regoVersionHint(version string) string {
+	if regoVersionName(version) == RegoVersionV1 {
+		return "The code was parsed as Rego v1. Code written for Rego v0, e.g. rules without the if keyword, needs rego_version = \"v0\"."
+	}
+	return "The code was parsed as Rego v0. Code written for Rego v1, e.g. rules using the if and contains keywords " +
+		"without importing rego.v1, needs rego_version = \"v1\"."
+}
+
+// moduleRegoVersion returns the Rego version to compile and format a parsed module with.
+func moduleRegoVersion(module *ast.Module) ast.RegoVersion {
+	if module.RegoVersion() == ast.RegoV1 {
+		return ast.RegoV1
+	}
+	return ast.RegoV0
+}
+
+// CompileRegoStrict compiles a parsed Rego module with OPA strict mode enabled and returns the
+// compilation errors, if any. Strict mode catches issues the parser does not, such as unused
+// local variables, unused imports and deprecated built-ins.
+// This function is exported for testing purposes
+func CompileRegoStrict(module *ast.Module) []string {
+	compiler := ast.NewCompiler().
+		WithStrict(true).
+		WithDefaultRegoVersion(moduleRegoVersion(module))
+	compiler.Compile(map[string]*ast.Module{"policy.rego": module})
+	if !compiler.Failed() {
+		return nil
+	}
+
+	errs := make([]string, 0, len(compiler.Errors))
+	for _, err := range compiler.Errors {
+		errs = append(errs, err.Error())
+	}
+	return errs
+}
+
+// RegoValidationResult is the outcome of validating a single Rego module with ValidateRegoCode.
+type RegoValidationResult struct {
+	// Error is set when the code could not be read, is empty or too long, or does not parse.
+	Error string
+	// Package is the package path of the module (e.g. "curation.policies"), empty when it does not parse.
+	Package              string
+	DisallowedOperations []string
+	StrictErrors         []string
+}
+
+// Valid reports whether the module passed all checks.
+func (r RegoValidationResult) Valid() bool {
+	return r.Error == "" && len(r.DisallowedOperations) == 0 && len(r.StrictErrors) == 0
+}
+
+// Problems returns a message for each failed check, or nil when the module is valid.
+func (r RegoValidationResult) Problems() []string {
+	var problems []string
+	if r.Error != "" {
+		problems = append(problems, r.Error)
+	}
+	if len(r.DisallowedOperations) > 0 {
+		problems = append(problems, "disallowed operations: "+strings.Join(r.DisallowedOperations, ", "))
+	}
+	for _, strictError := range r.StrictErrors {
+		problems = append(problems, "strict mode: "+strictError)
+	}
+	return problems
+}
+
+// Values of the rego_validation_mode attribute, which decides whether disallowed operations fail validation.
+const (
+	RegoValidationModeError = "error"
+	RegoValidationModeWarn  = "warn"
+)
+
+// ValidateRegoFile reads a .rego file and validates its content with ValidateRegoCode. The path is expanded (when
+// expand is true), resolved and checked like the rego of a template, through the same regoContentFromFile.
+func ValidateRegoFile(path string, expand, strict bool, maxChars int, baseDir, version string, extraOps []string) RegoValidationResult {
+	regoCode, err := regoContentFromFile(path, expand, baseDir)
+	if err != nil {
+		return RegoValidationResult{Error: err.Error()}
+	}
+	return ValidateRegoCode(regoCode, strict, maxChars, version, extraOps)
+}
+
+// ValidateRegoCode runs the checks the template resource applies to its rego file (length, syntax,
+// allowed operations and, when strict is true, OPA strict mode) and collects the results instead of
+// stopping at the first problem. The length check is skipped when maxChars is not positive. The code is parsed as the
+// Rego version of version, a rego_version value. extraOps are allowed in addition to the built-in operations, see
+// AllowedRegoOperations.
+// This function is exported for testing purposes
+func ValidateRegoCode(regoCode string, strict bool, maxChars int, version string, extraOps []string) RegoValidationResult {
+	if regoCode == "" {
+		return RegoValidationResult{Error: "no content was found"}
+	}
+	if maxChars > 0 && len(regoCode) > maxChars {
+		return RegoValidationResult{Error: "the Rego code must be 1-" + strconv.Itoa(maxChars) + " characters, current length: " + strconv.Itoa(len(regoCode))}
+	}
+
+	module, err := parseRegoModule(regoCode, version)
+	if err != nil {
+		return RegoValidationResult{Error: "parsed as Rego " + regoVersionName(version) + ": " + err.Error()}
+	}
+
+	result := RegoValidationResult{
+		Package:              strings.TrimPrefix(module.Package.Path.String(), "data."),
+		DisallowedOperations: FindDisallowedOperations(module, AllowedRegoOperations(extraOps)),
+	}
+	if strict {
+		result.StrictErrors = CompileRegoStrict(module)
+	}
+	return result
+}
+
+// RegoModuleJSON returns the JSON serialization of a parsed Rego module (package, imports and rules).
+// ast.Module marshals to its source text, so the parts are serialized individually to keep the structure.
+// This function is exported for testing purposes
+func RegoModuleJSON(module *ast.Module) (string, error) {
+	out, err := json.Marshal(map[string]interface{}{
+		"package": module.Package,
+		"imports": module.Imports,
+		"rules":   module.Rules,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// CanonicalRegoHash returns the hex encoded SHA-256 of the module formatted by OPA with comments and source
+// locations removed, so it is the same for semantically identical code regardless of formatting and comments.
+// This function is exported for testing purposes
+func CanonicalRegoHash(module *ast.Module) (string, error) {
+	canonical := module.Copy()
+	canonical.Comments = nil
+	// The formatter keeps blank lines and line breaks found in the source locations
+	ast.WalkNodes(canonical, func(node ast.Node) bool {
+		node.SetLoc(nil)
+		return false
+	})
+
+	formatted, err := format.AstWithOpts(canonical, format.Opts{RegoVersion: moduleRegoVersion(module)})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(formatted)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// keepPlannedRegoHash restores rego_canonical_hash planned from the rego file after fromAPIModel, so the applied
+// state matches the plan even if the API stores the code in a different form; the next refresh reads it back.
+func keepPlannedRegoHash(m *TemplateResourceModel, planned types.String) {
+	if !planned.IsUnknown() && !planned.IsNull() {
+		m.RegoCanonicalHash = planned
+	}
+}
+
+// keepPlannedRegoContent sets rego_content of a template with a rego file path to the Rego code stored by the API,
+// unless ModifyPlan kept it from the state because rego_canonical_hash did not change; like rego_canonical_hash,
+// the next refresh reads it back.
+func keepPlannedRegoContent(m *TemplateResourceModel, storedRego string) {
+	if !m.Rego.IsNull() && m.RegoContent.IsUnknown() {
+		m.RegoContent = types.StringValue(storedRego)
+	}
+}
+
+// AllowedRegoOperations returns the built-in allowed Rego operations (GetAllowedRegoOperations) together with extra,
+// the provider extra_allowed_rego_operations. extra can only add operations.
+func AllowedRegoOperations(extra []string) map[string]bool {
+	allowed := GetAllowedRegoOperations()
+	for _, op := range extra {
+		allowed[op] = true
 	}
+	return allowed
+}
+
+// allowedOperationsHint explains which operations are allowed, for a disallowed operations error: the built-in ones,
+// and the ones added by the provider extra_allowed_rego_operations.
+func allowedOperationsHint(extra []string) string {
+	hint := "Only specific built-in OPA functions are allowed for policy evaluation.\n" +
+		"Please refer to the List of Valid Rego Operations documentation for allowed functions."
+	if len(extra) == 0 {
+		return hint + "\nOperations your backend supports beyond this list can be allowed with the provider extra_allowed_rego_operations."
+	}
+	return hint + "\nIn addition, the provider extra_allowed_rego_operations allows: " + strings.Join(extra, ", ") + "."
 }
 
 // GetAllowedRegoOperations returns the set of allowed Rego operations
@@ -378,6 +877,124 @@ func FindDisallowedOperations(module *ast.Module, allowedOps map[string]bool) []
 	return disallowed
 }
 
+// ReferencesInputParameters reports whether the module reads input.parameters (in dot or bracket notation)
+// This function is exported for testing purposes
+func ReferencesInputParameters(module *ast.Module) bool {
+	names, dynamic := InputParameterReferences(module)
+	return len(names) > 0 || dynamic
+}
+
+// InputParameterReferences returns the sorted parameter names the module reads as input.parameters.<name> (in dot or
+// bracket notation). dynamic is true when input.parameters is also read as a whole or with a non-constant key, in which
+// case the returned names may not be all the parameters the module uses.
+// This function is exported for testing purposes.
+func InputParameterReferences(module *ast.Module) (names []string, dynamic bool) {
+	seen := map[string]bool{}
+	visitor := ast.NewGenericVisitor(func(x interface{}) bool {
+		ref, ok := x.(ast.Ref)
+		if !ok || len(ref) < 2 || !ref[0].Equal(ast.InputRootDocument) || !ref[1].Equal(ast.StringTerm("parameters")) {
+			return false
+		}
+		if len(ref) == 2 {
+			dynamic = true
+			return false
+		}
+		name, ok := ref[2].Value.(ast.String)
+		if !ok {
+			dynamic = true
+			return false
+		}
+		if !seen[string(name)] {
+			seen[string(name)] = true
+			names = append(names, string(name))
+		}
+		return false
+	})
+	visitor.Walk(module)
+
+	sort.Strings(names)
+	return names, dynamic
+}
+
+// InputTopLevelKeys returns the sorted top-level keys the module reads from input, e.g. "evidence" for
+// input.evidence.severity. dynamic is true when input is also read as a whole or with a non-constant key.
+// This function is exported for testing purposes.
+func InputTopLevelKeys(module *ast.Module) (keys []string, dynamic bool) {
+	seen := map[string]bool{}
+	visitor := ast.NewGenericVisitor(func(x interface{}) bool {
+		ref, ok := x.(ast.Ref)
+		if !ok || !ref[0].Equal(ast.InputRootDocument) {
+			return false
+		}
+		if len(ref) == 1 {
+			dynamic = true
+			return false
+		}
+		key, ok := ref[1].Value.(ast.String)
+		if !ok {
+			dynamic = true
+			return false
+		}
+		if !seen[string(key)] {
+			seen[string(key)] = true
+			keys = append(keys, string(key))
+		}
+		return false
+	})
+	visitor.Walk(module)
+
+	sort.Strings(keys)
+	return keys, dynamic
+}
+
+// UnexpectedInputKeys returns the sorted top-level input keys the module reads that are not in expected. parameters,
+// the rule parameters of every template, is always expected.
+// This function is exported for testing purposes.
+func UnexpectedInputKeys(module *ast.Module, expected []string) []string {
+	keys, _ := InputTopLevelKeys(module)
+	var unexpected []string
+	for _, key := range keys {
+		if key != "parameters" && !slices.Contains(expected, key) {
+			unexpected = append(unexpected, key)
+		}
+	}
+	return unexpected
+}
+
+// ParameterUsageMismatches compares the declared parameter names with the ones the module reads. unused lists the
+// declared parameters the module never reads; it is always empty when the module reads input.parameters dynamically,
+// since any parameter may be used then. undeclared lists the parameters the module reads that are not declared.
+// Both are sorted.
+// This function is exported for testing purposes.
+func ParameterUsageMismatches(module *ast.Module, declared []string) (unused, undeclared []string) {
+	referenced, dynamic := InputParameterReferences(module)
+
+	declaredSet := make(map[string]bool, len(declared))
+	for _, name := range declared {
+		declaredSet[name] = true
+	}
+	for _, name := range referenced {
+		if !declaredSet[name] {
+			undeclared = append(undeclared, name)
+		}
+	}
+
+	if !dynamic {
+		referencedSet := make(map[string]bool, len(referenced))
+		for _, name := range referenced {
+			referencedSet[name] = true
+		}
+		for _, name := range declared {
+			if !referencedSet[name] {
+				unused = append(unused, name)
+			}
+		}
+		sort.Strings(unused)
+	}
+
+	return unused, undeclared
+}
+
 func (r *TemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = r.TypeName
 }
@@ -387,6 +1004,7 @@ func (r *TemplateResource) Schema(ctx context.Context, req resource.SchemaReques
 		MarkdownDescription: "Provides a Unified Policy template resource. This resource allows you to create, update, and delete templates. " +
 			"Templates define reusable logic (business rules) for policies using Rego policy language code from a .rego file.",
 		Attributes: map[string]schema.Attribute{
+			"change_summary": changeSummaryAttribute,
 			"id": schema.StringAttribute{
 				Description: "The ID of the template. This is computed and assigned by the API.",
 				Computed:    true,
@@ -395,24 +1013,29 @@ func (r *TemplateResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The template name. Must be unique. 1-255 characters.",
+				Description: "The template name. Must be unique. 1-" + strconv.Itoa(unifiedpolicy.MaxNameLength) + " characters.",
 				Required:    true,
 				Validators: []validator.String{
-					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.LengthBetween(1, unifiedpolicy.MaxNameLength),
 				},
 			},
 			"description": schema.StringAttribute{
-				Description: "A free-text description of the template. This field is optional. Up to 2048 characters.",
+				Description: "A free-text description of the template. This field is optional. Up to " + strconv.Itoa(unifiedpolicy.MaxDescriptionLength) + " characters.",
 				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					nullDescriptionPlanModifier{},
+				},
 				Validators: []validator.String{
-					stringvalidator.LengthAtMost(2048),
+					stringvalidator.LengthAtMost(unifiedpolicy.MaxDescriptionLength),
 				},
 			},
 			"version": schema.StringAttribute{
-				Description: "The template version. 1-100 characters.",
-				Required:    true,
+				Description: "The template version. 1-" + strconv.Itoa(unifiedpolicy.MaxVersionLength) + " characters. " +
+					"Must match the provider `version_format_regex` when it is set.",
+				Required: true,
 				Validators: []validator.String{
-					stringvalidator.LengthBetween(1, 100),
+					stringvalidator.LengthBetween(1, unifiedpolicy.MaxVersionLength),
 				},
 			},
 			"category": schema.StringAttribute{
@@ -433,17 +1056,20 @@ func (r *TemplateResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"parameters": schema.ListNestedAttribute{
-				Description: "List of configurable parameters for the template. Optional; defaults to an empty list. Maximum 20 parameters allowed.",
-				Optional:    true,
-				Computed:    true,
+				Description: "List of configurable parameters for the template. Optional; defaults to an empty list. " +
+					"Maximum " + strconv.Itoa(unifiedpolicy.MaxParameters) + " parameters allowed. " +
+					"A warning is reported when the rego code references `input.parameters` but no parameters are declared; " +
+					"see `validate_parameter_usage` for a full check.",
+				Optional: true,
+				Computed: true,
 				Default: listdefault.StaticValue(
 					types.ListValueMust(
-						types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "type": types.StringType}},
+						types.ObjectType{AttrTypes: templateParameterAttrTypes},
 						[]attr.Value{},
 					),
 				),
 				Validators: []validator.List{
-					listvalidator.SizeAtMost(20),
+					listvalidator.SizeAtMost(unifiedpolicy.MaxParameters),
 				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -451,7 +1077,7 @@ func (r *TemplateResource) Schema(ctx context.Context, req resource.SchemaReques
 							Description: "Parameter name. Must begin and end with an alphanumeric character and may consist only of dashes, underscores, dots and alphanumerics in between.",
 							Required:    true,
 							Validators: []validator.String{
-								stringvalidator.LengthBetween(1, 100),
+								stringvalidator.LengthBetween(1, unifiedpolicy.MaxParameterNameLength),
 								stringvalidator.RegexMatches(
 									regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._-]*[a-zA-Z0-9])?$`),
 									"Parameter name must begin and end with alphanumeric characters",
@@ -465,22 +1091,58 @@ func (r *TemplateResource) Schema(ctx context.Context, req resource.SchemaReques
 								stringvalidator.OneOf("string", "bool", "int", "float", "object"),
 							},
 						},
+						"schema": schema.StringAttribute{
+							Description: "JSON schema (e.g. from `jsonencode`) that values of an `object` parameter must match. " +
+								"`unifiedpolicy_rule` resources using the template validate their value against it at plan time. " +
+								"Only allowed when type is object; parameters without a schema accept any JSON object.",
+							Optional: true,
+							Validators: []validator.String{
+								parameterSchemaValidator{},
+							},
+						},
+						"default": schema.StringAttribute{
+							Description: "Value of the parameter for rules that do not set it, as a string like rule parameter values " +
+								"(e.g. `\"5\"` for an `int` parameter). Must be a valid value of the parameter `type` and match `schema`, if set. " +
+								"`unifiedpolicy_rule` resources using the template may then omit the parameter.",
+							Optional: true,
+							Validators: []validator.String{
+								parameterDefaultValidator{},
+							},
+						},
 					},
 				},
 			},
 			"rego": schema.StringAttribute{
-				Description: "Full (absolute) path to a .rego file (e.g. `rego = \"/path/to/policies/security_vulnerability.rego\"`). " +
+				Description: "Path to a .rego file (e.g. `rego = \"/path/to/policies/security_vulnerability.rego\"` or " +
+					"`rego = \"${path.module}/policies/security_vulnerability.rego\"`). " +
 					"The file is read, validated (syntax and allowed operations), and its content is sent to the API. " +
-					"Only absolute paths to .rego files are accepted; relative paths and inline content are not supported. " +
-					"The path is stored in state; the API stores and returns the Rego code content. Required for create and update.",
-				Required: true,
+					"A relative path is resolved against the provider attribute `rego_base_dir` when set, and against the Terraform working " +
+					"directory otherwise, so modules can ship their own .rego files and reference them with `path.module`. " +
+					"The path is stored in state; the API stores and returns the Rego code content. Exactly one of `rego` and `rego_content` must be set. " +
+					"Environment variables and a leading `~` are expanded when the provider attribute `expand_rego_path` is true. " +
+					"When the provider sets `rego_base_dir`, the file must be within that directory.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("rego_content")),
+					regoContentValidator{deferExpandablePaths: true, deferRelativePaths: true, deferMissingFiles: true, deferOperations: true},
+				},
+			},
+			"rego_content": schema.StringAttribute{
+				Description: "Rego code of the template, as an alternative to a `rego` file path, e.g. the `rego` of a system template read with " +
+					"the `unifiedpolicy_template` data source to base a custom template on it. The code is validated like the content of a rego file " +
+					"(syntax and allowed operations) at plan time, and again at apply time since it may only be known then. " +
+					"Exactly one of `rego` and `rego_content` must be set. When `rego` is set, this holds the Rego code stored by the API, " +
+					"so the policy itself can be used in outputs and other resources; it is known after apply when the Rego code changes.",
+				Optional: true,
+				Computed: true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
-					regoContentValidator{},
+					regoContentValidator{inline: true, deferOperations: true},
 				},
 			},
 			"scanners": schema.ListAttribute{
-				Description: "List of scanner types that this template supports. Optional. Defaults to empty list []. Allowed values: secrets, sca, exposures, contextual_analysis, malicious_package.",
+				Description: "List of scanner types that this template supports. Optional. Defaults to empty list []. Allowed values: secrets, sca, exposures, contextual_analysis, malicious_package. Must be empty when `data_source_type` is `noop`, unless the provider sets `allow_scanners_with_noop`. Must not be empty when `category` is `security` and `data_source_type` is `evidence`, if the provider sets `require_scanners_for_security`.",
 				ElementType: types.StringType,
 				Optional:    true,
 				Computed:    true,
@@ -497,46 +1159,576 @@ func (r *TemplateResource) Schema(ctx context.Context, req resource.SchemaReques
 				Description: "Indicates whether this is a custom template (created by user) or a system template.",
 				Computed:    true,
 			},
-		},
-	}
-}
-
-func (r *TemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
-		return
-	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
-}
-
-func (m *TemplateResourceModel) toAPIModel(ctx context.Context) (TemplateAPIModel, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	apiModel := TemplateAPIModel{
-		Name:           m.Name.ValueString(),
-		Version:        m.Version.ValueString(),
-		Category:       m.Category.ValueString(),
-		DataSourceType: m.DataSourceType.ValueString(),
-	}
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the template was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Description: "User who created the template.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the template was last updated.",
+				Computed:    true,
+			},
+			"updated_by": schema.StringAttribute{
+				Description: "User who last updated the template.",
+				Computed:    true,
+			},
+			"strict_rego": schema.BoolAttribute{
+				Description: "When true, the Rego code is also compiled with OPA strict mode during validation, and strict-mode errors " +
+					"(unused variables, unused or duplicate imports, deprecated built-ins, etc.) are reported at plan time. Optional; defaults to false.",
+				Optional: true,
+			},
+			"rego_version": schema.StringAttribute{
+				Description: "Rego language version the code is parsed and validated as: `v0`, or `v1` for the OPA 1.x syntax in which rules " +
+					"use the `if` and `contains` keywords. Syntax errors name the version used. Optional; defaults to `v0` for backward compatibility.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(RegoVersionV0, RegoVersionV1),
+				},
+			},
+			"rego_validation_mode": schema.StringAttribute{
+				Description: "How Rego code that uses operations outside the list of valid Rego operations is handled: `error` fails validation, " +
+					"`warn` reports a warning listing the disallowed operations and still sends the Rego code to the API, e.g. to pilot new built-ins " +
+					"before they are formally allowed. Other validation errors are not affected. Optional; defaults to `error`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(RegoValidationModeError, RegoValidationModeWarn),
+				},
+			},
+			"validate_parameter_usage": schema.BoolAttribute{
+				Description: "When true, the declared `parameters` are checked against the `input.parameters` references of the Rego code in both directions: " +
+					"parameters the Rego code reads but the template does not declare, and declared parameters the Rego code never reads, are reported at plan time. " +
+					"Unused parameters are not reported when the Rego code reads `input.parameters` as a whole or with a computed key. " +
+					"Findings are warnings unless the provider sets `strict_parameter_usage`. Optional; defaults to false.",
+				Optional: true,
+			},
+			"include_rego_ast": schema.BoolAttribute{
+				Description: "When true, `rego_ast_json` is populated with the parsed Rego module. Optional; defaults to false since the AST can be large.",
+				Optional:    true,
+			},
+			"rego_ast_json": schema.StringAttribute{
+				Description: "JSON serialization of the parsed Rego module (package, imports and rules) for use by external tooling such as linters and visualizers. " +
+					"Only set when `include_rego_ast` is true and the Rego code stored by the API parses successfully; otherwise null.",
+				Computed: true,
+			},
+			"rego_canonical_hash": schema.StringAttribute{
+				Description: "SHA-256 of the Rego code formatted by OPA without comments, so it only changes when the policy itself changes. " +
+					"Computed from the rego file at plan time and from the Rego code stored by the API on refresh: a difference plans an update, " +
+					"while reformatting the file or editing its comments does not. Null when the stored Rego code does not parse.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *TemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(unifiedpolicy.ProviderMetadata)
+}
+
+// ModifyPlan validates the rego file with the provider settings the schema validator cannot see: paths with
+// environment variables or ~ depend on expand_rego_path, and the length limit on max_rego_chars. The noop
+// scanners, security scanners and parameter usage checks are repeated for the same reason (allow_scanners_with_noop,
+// require_scanners_for_security and strict_parameter_usage). change_summary is set last, see planChangeSummary.
+func (r *TemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer planChangeSummary(ctx, req, resp)
+
+	// Nothing to check on destroy, or when the provider is not configured (e.g. terraform validate)
+	if req.Plan.Raw.IsNull() || r.ProviderData.Client == nil {
+		return
+	}
+
+	ignoreDescriptionOnlyChange(ctx, r.ProviderData, req, resp)
+	resp.Diagnostics.Append(checkPrefixedNameLength(ctx, req.Plan, r.ProviderData.NamePrefix)...)
+
+	if r.ProviderData.SortParametersByName {
+		resp.Diagnostics.Append(checkParametersSortedByName(ctx, req.Plan)...)
+	}
+
+	resp.Diagnostics.Append(checkNoopScanners(ctx, req.Config, r.ProviderData.AllowScannersWithNoop)...)
+
+	if r.ProviderData.RequireScannersForSecurity {
+		resp.Diagnostics.Append(checkSecurityScanners(ctx, req.Config)...)
+	}
+
+	if r.ProviderData.VersionFormatRegex != nil {
+		resp.Diagnostics.Append(checkVersionFormat(ctx, req.Config, r.ProviderData.VersionFormatRegex)...)
+	}
+
+	if r.ProviderData.StrictParameterUsage {
+		resp.Diagnostics.Append(checkParameterUsage(ctx, req.Config, r.ProviderData.ExpandRegoPath, r.ProviderData.RegoBaseDir, true)...)
+	}
+
+	regoAttr := path.Root("rego")
+	var regoValue types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, regoAttr, &regoValue)...)
+	if !resp.Diagnostics.HasError() && regoValue.IsNull() {
+		regoAttr = path.Root("rego_content")
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, regoAttr, &regoValue)...)
+	}
+	if resp.Diagnostics.HasError() || regoValue.IsUnknown() || regoValue.IsNull() {
+		return
+	}
+	inline := regoAttr.Equal(path.Root("rego_content"))
+
+	// The file is read once for both the validation and the planned hash
+	validateResp := &validator.StringResponse{}
+	module, contentHash := regoContentValidator{
+		inline:            inline,
+		deferMissingFiles: r.ProviderData.DeferRegoValidation,
+		expandPath:        r.ProviderData.ExpandRegoPath,
+		maxChars:          r.ProviderData.MaxRegoChars,
+		baseDir:           r.ProviderData.RegoBaseDir,
+		extraOperations:   r.ProviderData.ExtraAllowedRegoOperations,
+	}.validateRegoFile(ctx, validator.StringRequest{
+		Path:        regoAttr,
+		ConfigValue: regoValue,
+		Config:      req.Config,
+	}, validateResp)
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+	if resp.Diagnostics.HasError() || module == nil {
+		return
+	}
+	if !inline {
+		tflog.Debug(ctx, "Read rego file", map[string]interface{}{
+			"path":           regoValue.ValueString(),
+			"content_sha256": contentHash,
+		})
+	}
+
+	if len(r.ProviderData.ExpectedInputKeys) > 0 {
+		resp.Diagnostics.Append(checkInputKeys(ctx, req.Plan, regoAttr, module, r.ProviderData.ExpectedInputKeys)...)
+	}
+
+	if r.ProviderData.RequireDefaultDecision && !HasDefaultDecision(module, RegoDecisionRule) {
+		resp.Diagnostics.AddAttributeError(
+			regoAttr,
+			"Missing Default Decision",
+			fmt.Sprintf("The Rego code does not declare a default for the %[1]s decision, so the policy evaluates to an undefined "+
+				"decision when no %[1]s rule matches. Add e.g. 'default %[1]s = false' (required by the provider require_default_decision).", RegoDecisionRule),
+		)
+	}
+
+	// Plan the hash of the file so that only semantic changes of the rego code differ from the refreshed state
+	hash, err := CanonicalRegoHash(module)
+	if err != nil {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rego_canonical_hash"), types.StringValue(hash))...)
+
+	if !inline {
+		resp.Diagnostics.Append(planStoredRegoContent(ctx, req.State, &resp.Plan, hash)...)
+	}
+}
+
+// planStoredRegoContent plans rego_content of a template with a rego file path: the Rego code in state while the
+// planned rego_canonical_hash matches the state, and known after apply otherwise.
+func planStoredRegoContent(ctx context.Context, state tfsdk.State, plan *tfsdk.Plan, hash string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	content := types.StringUnknown()
+	if !state.Raw.IsNull() {
+		var stateHash, stateContent types.String
+		diags.Append(state.GetAttribute(ctx, path.Root("rego_canonical_hash"), &stateHash)...)
+		diags.Append(state.GetAttribute(ctx, path.Root("rego_content"), &stateContent)...)
+		if diags.HasError() {
+			return diags
+		}
+		if stateHash.ValueString() == hash && !stateContent.IsNull() {
+			content = stateContent
+		}
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("rego_content"), content)...)
+	return diags
+}
+
+// RegoDecisionRule is the rule holding the decision of a template's Rego code.
+const RegoDecisionRule = "allow"
+
+// HasDefaultDecision reports whether the module declares a default rule for the decision rule name,
+// e.g. "default allow = false".
+// This function is exported for testing purposes.
+func HasDefaultDecision(module *ast.Module, name string) bool {
+	for _, rule := range module.Rules {
+		if rule.Default && rule.Head.Ref().String() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// checkInputKeys warns when the rego code reads top-level input keys the provider expected_input_keys does not list for
+// the planned data_source_type.
+func checkInputKeys(ctx context.Context, plan tfsdk.Plan, regoAttr path.Path, module *ast.Module, expectedInputKeys map[string][]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var dataSourceType types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("data_source_type"), &dataSourceType)...)
+	if diags.HasError() || dataSourceType.IsNull() || dataSourceType.IsUnknown() {
+		return diags
+	}
+	expected, ok := expectedInputKeys[dataSourceType.ValueString()]
+	if !ok {
+		return diags
+	}
+
+	if unexpected := UnexpectedInputKeys(module, expected); len(unexpected) > 0 {
+		diags.AddAttributeWarning(
+			regoAttr,
+			"Unexpected Rego Input Keys",
+			fmt.Sprintf("The Rego code reads input.%s, but templates with data_source_type '%s' are expected to read only input.%s "+
+				"(provider expected_input_keys). The template may have been written for another data source; check the data_source_type "+
+				"and the input references of the Rego code.",
+				strings.Join(unexpected, ", input."), dataSourceType.ValueString(), strings.Join(append(slices.Clone(expected), "parameters"), ", input.")),
+		)
+	}
+	return diags
+}
+
+// ConfigValidators cross-checks the rego code against the declared parameters, and the scanners against the data source
+// type and category.
+func (r *TemplateResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		parameterUsageValidator{providerData: r.ProviderData},
+		noopScannersValidator{providerData: r.ProviderData},
+		securityScannersValidator{providerData: r.ProviderData},
+	}
+}
+
+// parameterUsageValidator cross-checks the declared parameters with the input.parameters references of the rego code.
+// By default it only warns when the rego code reads input.parameters but no parameters are declared: such a template
+// would only ever see an empty parameters object, which is almost always a mistake. With validate_parameter_usage
+// the check is bidirectional and also reports each undeclared parameter the rego code reads and each declared
+// parameter it never reads. The provider's strict_parameter_usage turns the warnings into errors; as the provider
+// settings are not available before the provider is configured, the check runs again in ModifyPlan in that case.
+type parameterUsageValidator struct {
+	providerData unifiedpolicy.ProviderMetadata
+}
+
+// Description returns a plain text description of the validator.
+func (v parameterUsageValidator) Description(ctx context.Context) string {
+	return "Checks that the declared parameters match the input.parameters references of the rego code"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator.
+func (v parameterUsageValidator) MarkdownDescription(ctx context.Context) string {
+	return "Checks that the declared `parameters` match the `input.parameters` references of the rego code"
+}
+
+// ValidateResource performs the validation.
+func (v parameterUsageValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	asError := v.providerData.Client != nil && v.providerData.StrictParameterUsage
+	resp.Diagnostics.Append(checkParameterUsage(ctx, req.Config, false, v.providerData.RegoBaseDir, asError)...)
+}
+
+func checkParameterUsage(ctx context.Context, config tfsdk.Config, expandRegoPath bool, regoBaseDir string, asError bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var regoValue, regoContent types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("rego"), &regoValue)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("rego_content"), &regoContent)...)
+	var parameters types.List
+	diags.Append(config.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	var validateUsage types.Bool
+	diags.Append(config.GetAttribute(ctx, path.Root("validate_parameter_usage"), &validateUsage)...)
+	var regoVersion types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("rego_version"), &regoVersion)...)
+	inline := regoValue.IsNull()
+	if inline {
+		regoValue = regoContent
+	}
+	if diags.HasError() || regoValue.IsNull() || regoValue.IsUnknown() || parameters.IsUnknown() || validateUsage.IsUnknown() {
+		return diags
+	}
+	if !validateUsage.ValueBool() && len(parameters.Elements()) > 0 {
+		return diags
+	}
+	if !inline && !expandRegoPath && hasRegoPathTokens(regoValue.ValueString()) {
+		return diags
+	}
+
+	declared := make([]string, 0, len(parameters.Elements()))
+	for _, param := range parameters.Elements() {
+		paramObj, ok := param.(types.Object)
+		if !ok {
+			continue
+		}
+		name, ok := paramObj.Attributes()["name"].(types.String)
+		if !ok || name.IsUnknown() {
+			return diags
+		}
+		declared = append(declared, name.ValueString())
+	}
+
+	// Unreadable or invalid rego is reported by the rego attribute validator
+	regoCode := regoValue.ValueString()
+	source := regoCode
+	if inline {
+		source = "rego_content"
+	} else {
+		var err error
+		if regoCode, err = regoContentFromFile(source, expandRegoPath, regoBaseDir); err != nil {
+			return diags
+		}
+	}
+	module, err := parseRegoModule(regoCode, regoVersion.ValueString())
+	if err != nil {
+		return diags
+	}
+
+	report := diags.AddAttributeWarning
+	if asError {
+		report = diags.AddAttributeError
+	}
+
+	if len(declared) == 0 && ReferencesInputParameters(module) {
+		report(
+			path.Root("parameters"),
+			"Rego References Undeclared Parameters",
+			fmt.Sprintf("The rego code in %s references input.parameters, but the template declares no parameters. "+
+				"Declare the parameters the rego code reads, or remove the references.", source),
+		)
+		return diags
+	}
+	if !validateUsage.ValueBool() {
+		return diags
+	}
+
+	unused, undeclared := ParameterUsageMismatches(module, declared)
+	if len(undeclared) > 0 {
+		report(
+			path.Root("parameters"),
+			"Rego References Undeclared Parameters",
+			fmt.Sprintf("The rego code in %s reads input.parameters.%s, which the template does not declare. "+
+				"Declare these parameters, or remove the references.", source, strings.Join(undeclared, ", input.parameters.")),
+		)
+	}
+	if len(unused) > 0 {
+		report(
+			path.Root("parameters"),
+			"Unused Template Parameters",
+			fmt.Sprintf("The template declares the parameters %s, which the rego code in %s never reads as input.parameters. "+
+				"Remove these parameters, or use them in the rego code.", strings.Join(unused, ", "), source),
+		)
+	}
+	return diags
+}
+
+// noopScannersValidator rejects scanners on templates with data_source_type noop, unless the provider sets
+// allow_scanners_with_noop. The provider settings are not available before the provider is configured (e.g.
+// terraform validate), so the check is skipped then and runs again in ModifyPlan.
+type noopScannersValidator struct {
+	providerData unifiedpolicy.ProviderMetadata
+}
+
+// Description returns a plain text description of the validator.
+func (v noopScannersValidator) Description(ctx context.Context) string {
+	return "Validates that scanners are empty when data_source_type is noop"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator.
+func (v noopScannersValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates that `scanners` are empty when `data_source_type` is `noop`"
+}
+
+// ValidateResource performs the validation.
+func (v noopScannersValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if v.providerData.Client == nil {
+		return
+	}
+	resp.Diagnostics.Append(checkNoopScanners(ctx, req.Config, v.providerData.AllowScannersWithNoop)...)
+}
+
+func checkNoopScanners(ctx context.Context, config tfsdk.Config, allowScannersWithNoop bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var dataSourceType types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("data_source_type"), &dataSourceType)...)
+	var scanners types.List
+	diags.Append(config.GetAttribute(ctx, path.Root("scanners"), &scanners)...)
+	if diags.HasError() || dataSourceType.IsUnknown() || scanners.IsNull() || scanners.IsUnknown() {
+		return diags
+	}
+
+	if message := ValidateNoopScanners(dataSourceType.ValueString(), len(scanners.Elements()), allowScannersWithNoop); message != "" {
+		diags.AddAttributeError(path.Root("scanners"), "Scanners Not Allowed", message)
+	}
+	return diags
+}
+
+// ValidateNoopScanners checks the number of scanners against the data source type. It returns an empty string when
+// the combination is valid.
+// This function is exported for testing purposes.
+func ValidateNoopScanners(dataSourceType string, scannerCount int, allowScannersWithNoop bool) string {
+	if dataSourceType != "noop" || scannerCount == 0 || allowScannersWithNoop {
+		return ""
+	}
+	return "scanners must be empty when data_source_type is 'noop', since the template receives no scanner data. " +
+		"Remove the scanners, or set allow_scanners_with_noop on the provider if your backend accepts them."
+}
+
+// securityScannersValidator requires at least one scanner on templates with category security and data_source_type
+// evidence when the provider sets require_scanners_for_security. Like noopScannersValidator, it is skipped before the
+// provider is configured and runs again in ModifyPlan.
+type securityScannersValidator struct {
+	providerData unifiedpolicy.ProviderMetadata
+}
+
+// Description returns a plain text description of the validator.
+func (v securityScannersValidator) Description(ctx context.Context) string {
+	return "Validates that security templates with data_source_type evidence list at least one scanner"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator.
+func (v securityScannersValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates that `security` templates with `data_source_type` `evidence` list at least one of `scanners`"
+}
+
+// ValidateResource performs the validation.
+func (v securityScannersValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if v.providerData.Client == nil || !v.providerData.RequireScannersForSecurity {
+		return
+	}
+	resp.Diagnostics.Append(checkSecurityScanners(ctx, req.Config)...)
+}
+
+func checkSecurityScanners(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var category, dataSourceType types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("category"), &category)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("data_source_type"), &dataSourceType)...)
+	var scanners types.List
+	diags.Append(config.GetAttribute(ctx, path.Root("scanners"), &scanners)...)
+	if diags.HasError() || category.IsUnknown() || dataSourceType.IsUnknown() || scanners.IsUnknown() {
+		return diags
+	}
+
+	if message := ValidateSecurityScanners(category.ValueString(), dataSourceType.ValueString(), len(scanners.Elements())); message != "" {
+		diags.AddAttributeError(path.Root("scanners"), "Scanners Required", message)
+	}
+	return diags
+}
+
+// ValidateSecurityScanners checks the number of scanners of a template against its category and data source type, for
+// the provider attribute require_scanners_for_security. It returns an empty string when the combination is valid.
+// This function is exported for testing purposes.
+func ValidateSecurityScanners(category, dataSourceType string, scannerCount int) string {
+	if category != "security" || dataSourceType != "evidence" || scannerCount > 0 {
+		return ""
+	}
+	return "Templates with category 'security' and data_source_type 'evidence' must list at least one scanner, " +
+		"since the provider sets require_scanners_for_security. Add the scanners whose data the template evaluates."
+}
+
+// checkVersionFormat enforces the provider version_format_regex on the configured version.
+func checkVersionFormat(ctx context.Context, config tfsdk.Config, pattern *regexp.Regexp) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var version types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("version"), &version)...)
+	if diags.HasError() || version.IsNull() || version.IsUnknown() {
+		return diags
+	}
+
+	if message := ValidateTemplateVersionFormat(version.ValueString(), pattern); message != "" {
+		diags.AddAttributeError(path.Root("version"), "Invalid Template Version Format", message)
+	}
+	return diags
+}
+
+// ValidateTemplateVersionFormat checks a template version against the provider version_format_regex. It returns an
+// empty string when the version matches.
+// This function is exported for testing purposes.
+func ValidateTemplateVersionFormat(version string, pattern *regexp.Regexp) string {
+	if pattern.MatchString(version) {
+		return ""
+	}
+	return fmt.Sprintf("Template version '%s' does not match the provider version_format_regex '%s'. "+
+		"Use the versioning scheme shared by the templates of this configuration.", version, pattern.String())
+}
+
+// regoProblems returns the problems of result that fail the template. With rego_validation_mode "warn", disallowed
+// operations are not among them; they were reported as a warning at plan time.
+func (m *TemplateResourceModel) regoProblems(result RegoValidationResult) []string {
+	if m.RegoValidationMode.ValueString() == RegoValidationModeWarn {
+		result.DisallowedOperations = nil
+	}
+	return result.Problems()
+}
+
+// toAPIModel converts the resource model to the API template, reading the Rego code from the rego file. With the
+// provider attribute defer_rego_validation, the code is validated here, since the plan may have skipped the file.
+func (m *TemplateResourceModel) toAPIModel(ctx context.Context, providerData unifiedpolicy.ProviderMetadata) (TemplateAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiModel := TemplateAPIModel{
+		Name:           unifiedpolicy.PrefixName(providerData.NamePrefix, m.Name.ValueString()),
+		Version:        m.Version.ValueString(),
+		Category:       m.Category.ValueString(),
+		DataSourceType: m.DataSourceType.ValueString(),
+	}
+
+	// Rego: inline content is validated again, since it may have been unknown at plan time. With a rego file path,
+	// rego_content is computed from the API and not sent.
+	if m.Rego.IsNull() && !m.RegoContent.IsNull() {
+		result := ValidateRegoCode(m.RegoContent.ValueString(), m.StrictRego.ValueBool(), providerData.MaxRegoChars, m.RegoVersion.ValueString(), providerData.ExtraAllowedRegoOperations)
+		if problems := m.regoProblems(result); len(problems) > 0 {
+			diags.AddAttributeError(
+				path.Root("rego_content"),
+				"Invalid Rego",
+				"The Rego code in rego_content is not valid:\n- "+strings.Join(problems, "\n- "),
+			)
+			return apiModel, diags
+		}
+		apiModel.Rego = m.RegoContent.ValueString()
+	}
 
 	// Rego: read content from .rego file path
 	if !m.Rego.IsNull() {
-		content, err := regoContentFromFile(m.Rego.ValueString())
+		content, err := regoContentFromFile(m.Rego.ValueString(), providerData.ExpandRegoPath, providerData.RegoBaseDir)
 		if err != nil {
 			var pathErr *regoPathError
-			if errors.As(err, &pathErr) {
-				diags.AddError("Invalid Rego Path", "The rego field must be the full (absolute) path to a .rego file. "+err.Error())
+			var baseDirErr *regoBaseDirError
+			if errors.As(err, &baseDirErr) {
+				diags.AddAttributeError(path.Root("rego"), "Rego Path Outside Base Directory",
+					"The rego file is not read, since the provider restricts rego paths to rego_base_dir: "+err.Error()+".")
+			} else if errors.As(err, &pathErr) {
+				diags.AddError("Invalid Rego Path", "The rego field must be the path to a .rego file. "+err.Error())
 			} else {
 				diags.AddError("Rego File Not Found", "Cannot read Rego file: "+m.Rego.ValueString()+". "+err.Error())
 			}
 			return apiModel, diags
 		}
+		if providerData.DeferRegoValidation {
+			result := ValidateRegoCode(content, m.StrictRego.ValueBool(), providerData.MaxRegoChars, m.RegoVersion.ValueString(), providerData.ExtraAllowedRegoOperations)
+			if problems := m.regoProblems(result); len(problems) > 0 {
+				diags.AddAttributeError(
+					path.Root("rego"),
+					"Invalid Rego",
+					"The Rego code in "+m.Rego.ValueString()+" is not valid:\n- "+strings.Join(problems, "\n- ")+"\n\n"+
+						"It was validated at apply time because the provider attribute defer_rego_validation is set.",
+				)
+				return apiModel, diags
+			}
+		}
 		apiModel.Rego = content
 	}
 
 	// Handle description: if provided (even as empty string), set it; if null, leave as nil
 	if !m.Description.IsNull() {
-		descValue := m.Description.ValueString()
+		descValue := unifiedpolicy.PrefixDescription(providerData.DescriptionPrefix, m.Description.ValueString())
 		apiModel.Description = &descValue
 	}
 	// If Description is null, apiModel.Description remains nil (not set), which will be omitted from JSON
@@ -552,6 +1744,15 @@ func (m *TemplateResourceModel) toAPIModel(ctx context.Context) (TemplateAPIMode
 					Name: param.Name.ValueString(),
 					Type: param.Type.ValueString(),
 				}
+				if !param.Schema.IsNull() {
+					apiParams[i].Schema = json.RawMessage(param.Schema.ValueString())
+				}
+				if !param.Default.IsNull() {
+					apiParams[i].Default = param.Default.ValueStringPointer()
+				}
+			}
+			if providerData.SortParametersByName {
+				apiParams = SortTemplateParametersByName(apiParams)
 			}
 			apiModel.Parameters = apiParams
 		}
@@ -579,10 +1780,10 @@ func (r *TemplateResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	if plan.Rego.IsNull() || plan.Rego.ValueString() == "" {
+	if plan.Rego.ValueString() == "" && plan.RegoContent.ValueString() == "" {
 		resp.Diagnostics.AddError(
 			"Missing Rego",
-			"The 'rego' field is required and must be the full (absolute) path to a .rego file.",
+			"Either the 'rego' field, the path to a .rego file, or the 'rego_content' field is required.",
 		)
 		return
 	}
@@ -591,7 +1792,7 @@ func (r *TemplateResource) Create(ctx context.Context, req resource.CreateReques
 		"name": plan.Name.ValueString(),
 	})
 
-	apiModel, diags := plan.toAPIModel(ctx)
+	apiModel, diags := plan.toAPIModel(ctx, r.ProviderData)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -602,40 +1803,58 @@ func (r *TemplateResource) Create(ctx context.Context, req resource.CreateReques
 		SetContext(ctx).
 		SetBody(apiModel).
 		SetResult(&result).
-		Post(TemplatesEndpoint)
+		Post(r.ProviderData.Endpoint(TemplatesEndpoint))
 
 	if err != nil {
 		utilfw.UnableToCreateResourceError(resp, err.Error())
 		return
 	}
 
-	if httpResponse.IsError() {
+	if httpResponse.StatusCode() == http.StatusConflict && adoptOnConflict(r.ProviderData) {
+		_, diags = ResolveCreateConflict(ctx, r.ProviderData, CreateConflict{
+			Kind:         "template",
+			Title:        "Template",
+			Name:         apiModel.Name,
+			ListEndpoint: TemplatesEndpoint,
+			ItemEndpoint: TemplateEndpoint,
+			PathParam:    "templateId",
+		}, apiModel, &result)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if httpResponse.IsError() {
 		errorDiags := unifiedpolicy.HandleAPIErrorWithType(httpResponse, "create", "template")
 		resp.Diagnostics.Append(errorDiags...)
 		return
 	}
 
-	regoPath := plan.Rego.ValueString()
-	diags = plan.fromAPIModel(ctx, result)
+	regoPath := plan.Rego
+	plannedHash := plan.RegoCanonicalHash
+	diags = plan.fromAPIModel(ctx, result, r.ProviderData.SortParametersByName, r.ProviderData.NamePrefix, r.ProviderData.DescriptionPrefix)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	plan.Rego = types.StringValue(regoPath)
+	plan.Rego = regoPath
+	keepPlannedRegoHash(&plan, plannedHash)
+	keepPlannedRegoContent(&plan, result.Rego)
 
 	tflog.Info(ctx, "Template created successfully", map[string]interface{}{
 		"id":   plan.ID.ValueString(),
 		"name": plan.Name.ValueString(),
 	})
 
+	resp.Diagnostics.Append(unifiedpolicy.SetPrivateExtraFields(ctx, resp.Private, result.ExtraFields)...)
+	plan.ChangeSummary = resolveChangeSummary(plan.ChangeSummary)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (m *TemplateResourceModel) fromAPIModel(ctx context.Context, apiModel TemplateAPIModel) diag.Diagnostics {
+func (m *TemplateResourceModel) fromAPIModel(ctx context.Context, apiModel TemplateAPIModel, sortParametersByName bool, namePrefix, descriptionPrefix string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	m.ID = types.StringValue(apiModel.ID)
-	m.Name = types.StringValue(apiModel.Name)
+	m.Name = types.StringValue(unifiedpolicy.StripNamePrefix(namePrefix, apiModel.Name))
 	m.Category = types.StringValue(apiModel.Category)
 	m.DataSourceType = types.StringValue(apiModel.DataSourceType)
 
@@ -649,21 +1868,50 @@ func (m *TemplateResourceModel) fromAPIModel(ctx context.Context, apiModel Templ
 
 	// Handle description: if pointer is nil, set to null; otherwise use the value (even if empty string)
 	if apiModel.Description != nil {
-		m.Description = types.StringValue(*apiModel.Description)
+		m.Description = types.StringValue(unifiedpolicy.StripDescriptionPrefix(descriptionPrefix, *apiModel.Description))
 	} else {
 		m.Description = types.StringNull()
 	}
 
-	paramAttrTypes := map[string]attr.Type{
-		"name": types.StringType,
-		"type": types.StringType,
+	// The backend may return parameters and scanners in a different order than configured.
+	// Keep the configured order so that order-only changes do not show up as drift, unless
+	// parameters are kept sorted by name (provider attribute sort_parameters_by_name).
+	if sortParametersByName {
+		apiModel.Parameters = SortTemplateParametersByName(apiModel.Parameters)
+	}
+	// Schemas are kept as configured when the backend returns them reformatted
+	configuredSchemas := map[string]string{}
+	if !m.Parameters.IsNull() && !m.Parameters.IsUnknown() {
+		var configuredParams []TemplateParameterModel
+		if d := m.Parameters.ElementsAs(ctx, &configuredParams, false); !d.HasError() {
+			configuredNames := make([]string, len(configuredParams))
+			for i, param := range configuredParams {
+				configuredNames[i] = param.Name.ValueString()
+				if !param.Schema.IsNull() && !param.Schema.IsUnknown() {
+					configuredSchemas[param.Name.ValueString()] = param.Schema.ValueString()
+				}
+			}
+			if !sortParametersByName {
+				apiModel.Parameters = ReconcileParameterOrder(configuredNames, apiModel.Parameters)
+			}
+		}
 	}
+	if !m.Scanners.IsNull() && !m.Scanners.IsUnknown() {
+		var configuredScanners []string
+		if d := m.Scanners.ElementsAs(ctx, &configuredScanners, false); !d.HasError() {
+			apiModel.Scanners = ReconcileScannerOrder(configuredScanners, apiModel.Scanners)
+		}
+	}
+
+	paramAttrTypes := templateParameterAttrTypes
 	if len(apiModel.Parameters) > 0 {
 		parameters := make([]types.Object, len(apiModel.Parameters))
 		for i, param := range apiModel.Parameters {
 			paramAttrs := map[string]attr.Value{
-				"name": types.StringValue(param.Name),
-				"type": types.StringValue(param.Type),
+				"name":    types.StringValue(param.Name),
+				"type":    types.StringValue(param.Type),
+				"schema":  parameterSchemaValue(param.Schema, configuredSchemas[param.Name]),
+				"default": types.StringPointerValue(param.Default),
 			}
 			paramObj, paramDiags := types.ObjectValue(paramAttrTypes, paramAttrs)
 			diags.Append(paramDiags...)
@@ -704,9 +1952,254 @@ func (m *TemplateResourceModel) fromAPIModel(ctx context.Context, apiModel Templ
 	// Set is_custom
 	m.IsCustom = types.BoolValue(apiModel.IsCustom)
 
+	// Timestamps
+	if apiModel.CreatedAt != "" {
+		m.CreatedAt = types.StringValue(apiModel.CreatedAt)
+	} else {
+		m.CreatedAt = types.StringNull()
+	}
+
+	if apiModel.CreatedBy != "" {
+		m.CreatedBy = types.StringValue(apiModel.CreatedBy)
+	} else {
+		m.CreatedBy = types.StringNull()
+	}
+
+	if apiModel.UpdatedAt != "" {
+		m.UpdatedAt = types.StringValue(apiModel.UpdatedAt)
+	} else {
+		m.UpdatedAt = types.StringNull()
+	}
+
+	if apiModel.UpdatedBy != "" {
+		m.UpdatedBy = types.StringValue(apiModel.UpdatedBy)
+	} else {
+		m.UpdatedBy = types.StringNull()
+	}
+
+	m.RegoCanonicalHash = types.StringNull()
+	if module, err := parseRegoModule(apiModel.Rego, m.RegoVersion.ValueString()); err == nil {
+		if hash, err := CanonicalRegoHash(module); err == nil {
+			m.RegoCanonicalHash = types.StringValue(hash)
+		}
+	}
+
+	// The AST is opt-in and only exposed when the stored Rego parses successfully
+	m.RegoASTJSON = types.StringNull()
+	if m.IncludeRegoAST.ValueBool() {
+		module, err := parseRegoModule(apiModel.Rego, m.RegoVersion.ValueString())
+		if err == nil {
+			astJSON, err := RegoModuleJSON(module)
+			if err == nil {
+				m.RegoASTJSON = types.StringValue(astJSON)
+			} else {
+				diags.AddWarning("Unable to Serialize Rego AST", "rego_ast_json is left empty: "+err.Error())
+			}
+		}
+	}
+
+	return diags
+}
+
+// ReconcileScannerOrder returns the scanners from the API response ordered as configured.
+// Configured scanners come first in their configured order, followed by any scanners
+// that only exist in the response, in the order the API returned them.
+// This function is exported for testing purposes.
+func ReconcileScannerOrder(configured, returned []string) []string {
+	return reorderToConfigured(configured, returned, func(scanner string) string { return scanner })
+}
+
+// templateParameterAttrTypes are the attribute types of the template parameters list elements.
+var templateParameterAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"type":    types.StringType,
+	"schema":  types.StringType,
+	"default": types.StringType,
+}
+
+// parameterSchemaValue returns the parameter schema returned by the API as a state value. The configured schema is
+// kept when it is the same JSON document, so formatting differences do not show up as drift.
+func parameterSchemaValue(returned json.RawMessage, configured string) types.String {
+	if len(returned) == 0 || string(returned) == "null" {
+		return types.StringNull()
+	}
+	if configured != "" {
+		var configuredDoc, returnedDoc interface{}
+		if json.Unmarshal([]byte(configured), &configuredDoc) == nil && json.Unmarshal(returned, &returnedDoc) == nil &&
+			reflect.DeepEqual(configuredDoc, returnedDoc) {
+			return types.StringValue(configured)
+		}
+	}
+	return types.StringValue(string(returned))
+}
+
+// parameterSchemaValidator validates that a template parameter schema is a valid JSON schema and is only set on
+// parameters of type object.
+type parameterSchemaValidator struct{}
+
+func (v parameterSchemaValidator) Description(ctx context.Context) string {
+	return "Validates that schema is a valid JSON schema and is only set on object parameters."
+}
+
+func (v parameterSchemaValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates that `schema` is a valid JSON schema and is only set on `object` parameters."
+}
+
+func (v parameterSchemaValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var paramType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("type"), &paramType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !paramType.IsUnknown() && paramType.ValueString() != "object" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Parameter Schema",
+			"schema can only be set on parameters of type object, got type '"+paramType.ValueString()+"'.",
+		)
+		return
+	}
+
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(req.ConfigValue.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Parameter Schema",
+			"schema must be a valid JSON schema: "+err.Error(),
+		)
+	}
+}
+
+// parameterDefaultValidator validates that the default of a template parameter is a valid value of the parameter type
+// and matches the parameter schema, like the values of rule parameters are checked against the template.
+type parameterDefaultValidator struct{}
+
+func (v parameterDefaultValidator) Description(ctx context.Context) string {
+	return "Validates that default is a valid value of the parameter type and matches the parameter schema."
+}
+
+func (v parameterDefaultValidator) MarkdownDescription(ctx context.Context) string {
+	return "Validates that `default` is a valid value of the parameter `type` and matches the parameter `schema`."
+}
+
+func (v parameterDefaultValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var paramType, paramSchema types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("type"), &paramType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("schema"), &paramSchema)...)
+	if resp.Diagnostics.HasError() || paramType.IsNull() || paramType.IsUnknown() {
+		return
+	}
+
+	if !parameterValueMatchesType(req.ConfigValue.ValueString(), paramType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Parameter Default",
+			fmt.Sprintf("default of a parameter of type %s must be a valid %s, got %q.", paramType.ValueString(), paramType.ValueString(), req.ConfigValue.ValueString()),
+		)
+		return
+	}
+
+	if paramType.ValueString() != "object" || paramSchema.IsNull() || paramSchema.IsUnknown() {
+		return
+	}
+	// An invalid schema is reported by parameterSchemaValidator
+	problems, err := ParameterSchemaErrors(paramSchema.ValueString(), req.ConfigValue.ValueString())
+	if err == nil && len(problems) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Parameter Default",
+			"default does not match the parameter schema:\n- "+strings.Join(problems, "\n- "),
+		)
+	}
+}
+
+// ReconcileParameterOrder returns the parameters from the API response ordered by the
+// configured parameter names. Parameters not present in the configuration are appended.
+// This function is exported for testing purposes.
+func ReconcileParameterOrder(configuredNames []string, returned []TemplateParameterAPIModel) []TemplateParameterAPIModel {
+	return reorderToConfigured(configuredNames, returned, func(param TemplateParameterAPIModel) string { return param.Name })
+}
+
+// SortTemplateParametersByName returns the parameters sorted by name. Parameters with the same name keep their order.
+// This function is exported for testing purposes.
+func SortTemplateParametersByName(params []TemplateParameterAPIModel) []TemplateParameterAPIModel {
+	return sortByName(params, func(param TemplateParameterAPIModel) string { return param.Name })
+}
+
+func sortByName[T any](items []T, name func(T) string) []T {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b T) int { return strings.Compare(name(a), name(b)) })
+	return sorted
+}
+
+// checkParametersSortedByName reports an error when the planned parameters of a template or rule are not sorted
+// by name. With sort_parameters_by_name the provider stores parameters sorted, so a configuration in another
+// order would never converge.
+func checkParametersSortedByName(ctx context.Context, plan tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var parameters types.List
+	diags.Append(plan.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	if diags.HasError() || parameters.IsNull() || parameters.IsUnknown() {
+		return diags
+	}
+
+	names := make([]string, 0, len(parameters.Elements()))
+	for _, element := range parameters.Elements() {
+		param, ok := element.(types.Object)
+		if !ok || param.IsUnknown() {
+			return diags
+		}
+		name, ok := param.Attributes()["name"].(types.String)
+		if !ok || name.IsUnknown() {
+			return diags
+		}
+		names = append(names, name.ValueString())
+	}
+
+	if !slices.IsSorted(names) {
+		diags.AddAttributeError(
+			path.Root("parameters"),
+			"Parameters Not Sorted By Name",
+			fmt.Sprintf("The provider attribute sort_parameters_by_name is set, so parameters must be listed in alphabetical order of name. "+
+				"Expected order: %s.", strings.Join(slices.Sorted(slices.Values(names)), ", ")),
+		)
+	}
 	return diags
 }
 
+func reorderToConfigured[T any](configured []string, returned []T, key func(T) string) []T {
+	if len(returned) == 0 {
+		return returned
+	}
+
+	used := make([]bool, len(returned))
+	result := make([]T, 0, len(returned))
+	for _, k := range configured {
+		for i, item := range returned {
+			if !used[i] && key(item) == k {
+				result = append(result, item)
+				used[i] = true
+				break
+			}
+		}
+	}
+	for i, item := range returned {
+		if !used[i] {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
 func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
@@ -725,14 +2218,14 @@ func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, r
 		SetContext(ctx).
 		SetPathParam("templateId", state.ID.ValueString()).
 		SetResult(&result).
-		Get(TemplateEndpoint)
+		Get(r.ProviderData.Endpoint(TemplateEndpoint))
 
 	if err != nil {
 		utilfw.UnableToRefreshResourceError(resp, err.Error())
 		return
 	}
 
-	if httpResponse.StatusCode() == http.StatusNotFound {
+	if r.ProviderData.IsNotFoundStatus(httpResponse.StatusCode()) {
 		tflog.Warn(ctx, "Template not found, removing from state", map[string]interface{}{
 			"id": state.ID.ValueString(),
 		})
@@ -746,14 +2239,36 @@ func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	regoPath := state.Rego.ValueString()
-	diags := state.fromAPIModel(ctx, result)
+	if !result.IsCustom {
+		summary := "System Template Cannot Be Managed"
+		detail := fmt.Sprintf("Template '%s' (ID '%s') is a system template (is_custom = false). "+
+			"System templates are built in and cannot be updated or deleted through the unifiedpolicy_template resource. "+
+			"Use the unifiedpolicy_template data source to reference it instead, and remove this resource from state with 'terraform state rm'.",
+			result.Name, result.ID)
+		if r.ProviderData.SystemTemplateHandling == unifiedpolicy.SystemTemplateHandlingWarn {
+			resp.Diagnostics.AddWarning(summary, detail)
+		} else {
+			resp.Diagnostics.AddError(summary, detail)
+			return
+		}
+	}
+
+	regoPath := state.Rego
+	diags := state.fromAPIModel(ctx, result, r.ProviderData.SortParametersByName, r.ProviderData.NamePrefix, r.ProviderData.DescriptionPrefix)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.Rego = types.StringValue(regoPath)
+	// The configured path is kept; changes of the stored code or the file show as a rego_canonical_hash difference
+	// between this refresh and the hash ModifyPlan computes from the file
+	state.Rego = regoPath
+	if !regoPath.IsNull() {
+		state.RegoContent = types.StringValue(result.Rego)
+	}
 
+	resp.Diagnostics.Append(unifiedpolicy.SetPrivateExtraFields(ctx, resp.Private, result.ExtraFields)...)
+	// change_summary only describes a plan, see planChangeSummary
+	state.ChangeSummary = types.StringNull()
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -766,10 +2281,10 @@ func (r *TemplateResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	if plan.Rego.IsNull() || plan.Rego.ValueString() == "" {
+	if plan.Rego.ValueString() == "" && plan.RegoContent.ValueString() == "" {
 		resp.Diagnostics.AddError(
 			"Missing Rego",
-			"The 'rego' field must be the full (absolute) path to a .rego file.",
+			"Either the 'rego' field, the path to a .rego file, or the 'rego_content' field is required.",
 		)
 		return
 	}
@@ -778,44 +2293,177 @@ func (r *TemplateResource) Update(ctx context.Context, req resource.UpdateReques
 		"id": plan.ID.ValueString(),
 	})
 
-	apiModel, diags := plan.toAPIModel(ctx)
+	result, patched := r.patchTemplateMetadata(ctx, req, plan, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !patched {
+		result = r.putTemplate(ctx, req, plan, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	regoPath := plan.Rego
+	plannedHash := plan.RegoCanonicalHash
+	diags := plan.fromAPIModel(ctx, result, r.ProviderData.SortParametersByName, r.ProviderData.NamePrefix, r.ProviderData.DescriptionPrefix)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.Rego = regoPath
+	keepPlannedRegoHash(&plan, plannedHash)
+	keepPlannedRegoContent(&plan, result.Rego)
+
+	tflog.Info(ctx, "Template updated successfully", map[string]interface{}{
+		"id": plan.ID.ValueString(),
+	})
 
+	resp.Diagnostics.Append(unifiedpolicy.SetPrivateExtraFields(ctx, resp.Private, result.ExtraFields)...)
+	plan.ChangeSummary = resolveChangeSummary(plan.ChangeSummary)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// putTemplate replaces the template with the planned one, including the Rego code read from the rego file.
+func (r *TemplateResource) putTemplate(ctx context.Context, req resource.UpdateRequest, plan TemplateResourceModel, resp *resource.UpdateResponse) TemplateAPIModel {
 	var result TemplateAPIModel
+
+	apiModel, diags := plan.toAPIModel(ctx, r.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return result
+	}
+
+	// Send back API fields from the last read that the provider does not model
+	extraFields, diags := unifiedpolicy.GetPrivateExtraFields(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return result
+	}
+	apiModel.ExtraFields = extraFields
+
 	httpResponse, err := r.ProviderData.Client.R().
 		SetContext(ctx).
 		SetPathParam("templateId", plan.ID.ValueString()).
 		SetBody(apiModel).
 		SetResult(&result).
-		Put(TemplateEndpoint)
+		Put(r.ProviderData.Endpoint(TemplateEndpoint))
 
 	if err != nil {
 		utilfw.UnableToUpdateResourceError(resp, err.Error())
-		return
+		return result
 	}
 
 	if httpResponse.IsError() {
-		errorDiags := unifiedpolicy.HandleAPIErrorWithType(httpResponse, "update", "template")
-		resp.Diagnostics.Append(errorDiags...)
-		return
+		resp.Diagnostics.Append(templateUpdateError(httpResponse, apiModel.Name)...)
 	}
+	return result
+}
 
-	regoPath := plan.Rego.ValueString()
-	diags = plan.fromAPIModel(ctx, result)
-	resp.Diagnostics.Append(diags...)
+// patchTemplateMetadata sends a change of metadata fields only as a PATCH without the Rego code, so the rego file
+// is not read again. When TemplateMetadataPatch rules it out or the backend does not support PATCH, it returns
+// false and the template must be updated with putTemplate.
+func (r *TemplateResource) patchTemplateMetadata(ctx context.Context, req resource.UpdateRequest, plan TemplateResourceModel, resp *resource.UpdateResponse) (TemplateAPIModel, bool) {
+	var result TemplateAPIModel
+
+	var state TemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
-		return
+		return result, false
 	}
-	plan.Rego = types.StringValue(regoPath)
 
-	tflog.Info(ctx, "Template updated successfully", map[string]interface{}{
-		"id": plan.ID.ValueString(),
+	body, ok := TemplateMetadataPatch(plan, state)
+	if !ok {
+		return result, false
+	}
+	if name, ok := body["name"].(string); ok {
+		body["name"] = unifiedpolicy.PrefixName(r.ProviderData.NamePrefix, name)
+	}
+	if description, ok := body["description"].(string); ok {
+		body["description"] = unifiedpolicy.PrefixDescription(r.ProviderData.DescriptionPrefix, description)
+	}
+
+	httpResponse, err := r.ProviderData.Client.R().
+		SetContext(ctx).
+		SetPathParam("templateId", plan.ID.ValueString()).
+		SetBody(body).
+		SetResult(&result).
+		Patch(r.ProviderData.Endpoint(TemplateEndpoint))
+
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return result, false
+	}
+
+	if httpResponse.IsError() {
+		switch httpResponse.StatusCode() {
+		case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+			tflog.Debug(ctx, "Template PATCH not available, falling back to full update", map[string]interface{}{
+				"id":     plan.ID.ValueString(),
+				"status": httpResponse.StatusCode(),
+			})
+			return TemplateAPIModel{}, false
+		}
+		resp.Diagnostics.Append(templateUpdateError(httpResponse, unifiedpolicy.PrefixName(r.ProviderData.NamePrefix, plan.Name.ValueString()))...)
+		return result, false
+	}
+
+	tflog.Debug(ctx, "Updated template metadata without Rego", map[string]interface{}{
+		"id":     plan.ID.ValueString(),
+		"fields": len(body),
 	})
+	return result, true
+}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+// templateUpdateError converts an error response of a template update to diagnostics. name is the name that was sent,
+// with the provider attribute name_prefix added.
+func templateUpdateError(httpResponse *resty.Response, name string) diag.Diagnostics {
+	if httpResponse.StatusCode() == http.StatusConflict {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Template Name Conflict",
+			fmt.Sprintf("A template with name '%s' already exists. Please use a different name.", name),
+		)
+		return diags
+	}
+	return unifiedpolicy.HandleAPIErrorWithType(httpResponse, "update", "template")
+}
+
+// TemplateMetadataPatch returns the body of a PATCH request with the metadata fields (name, description, version,
+// category) that differ between plan and state. ok is false when nothing else may be sent this way: when no
+// metadata field changed, the description is removed, or any other field sent to the API changed. The Rego code
+// counts as unchanged when the path or content and rego_canonical_hash are the same.
+// This function is exported for testing purposes.
+func TemplateMetadataPatch(plan, state TemplateResourceModel) (map[string]interface{}, bool) {
+	if plan.RegoCanonicalHash.IsUnknown() || plan.RegoCanonicalHash.IsNull() || !plan.RegoCanonicalHash.Equal(state.RegoCanonicalHash) {
+		return nil, false
+	}
+	if !plan.Rego.Equal(state.Rego) || !plan.RegoContent.Equal(state.RegoContent) || !plan.DataSourceType.Equal(state.DataSourceType) ||
+		!plan.Parameters.Equal(state.Parameters) || !plan.Scanners.Equal(state.Scanners) {
+		return nil, false
+	}
+	// A removed description is left out of a full update; a PATCH cannot express that
+	if plan.Description.IsNull() && !state.Description.IsNull() {
+		return nil, false
+	}
+
+	body := map[string]interface{}{}
+	if !plan.Name.Equal(state.Name) {
+		body["name"] = plan.Name.ValueString()
+	}
+	if !plan.Description.Equal(state.Description) {
+		body["description"] = plan.Description.ValueString()
+	}
+	if !plan.Version.Equal(state.Version) {
+		body["version"] = plan.Version.ValueString()
+	}
+	if !plan.Category.Equal(state.Category) {
+		body["category"] = plan.Category.ValueString()
+	}
+	if len(body) == 0 {
+		return nil, false
+	}
+	return body, true
 }
 
 func (r *TemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -834,31 +2482,31 @@ func (r *TemplateResource) Delete(ctx context.Context, req resource.DeleteReques
 	httpResponse, err := r.ProviderData.Client.R().
 		SetContext(ctx).
 		SetPathParam("templateId", state.ID.ValueString()).
-		Delete(TemplateEndpoint)
+		Delete(r.ProviderData.Endpoint(TemplateEndpoint))
 
 	if err != nil {
 		utilfw.UnableToDeleteResourceError(resp, err.Error())
 		return
 	}
 
-	if httpResponse.StatusCode() != http.StatusNotFound && httpResponse.StatusCode() != http.StatusNoContent {
-		errorDiags := unifiedpolicy.HandleAPIErrorWithType(httpResponse, "delete", "template")
-		resp.Diagnostics.Append(errorDiags...)
-		return
-	}
-
-	if httpResponse.StatusCode() == http.StatusNotFound {
-		tflog.Warn(ctx, "Template not found during deletion, assuming already deleted", map[string]interface{}{
+	if unifiedpolicy.IsDeleteSuccess(httpResponse) {
+		if httpResponse.StatusCode() == http.StatusNotFound {
+			tflog.Warn(ctx, "Template not found during deletion, assuming already deleted", map[string]interface{}{
+				"id": state.ID.ValueString(),
+			})
+			return
+		}
+		tflog.Info(ctx, "Template deleted successfully", map[string]interface{}{
 			"id": state.ID.ValueString(),
 		})
 		return
 	}
 
-	tflog.Info(ctx, "Template deleted successfully", map[string]interface{}{
-		"id": state.ID.ValueString(),
-	})
+	errorDiags := unifiedpolicy.HandleAPIErrorWithType(httpResponse, "delete", "template")
+	resp.Diagnostics.Append(errorDiags...)
 }
 
 func (r *TemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// System templates (is_custom = false) are detected by Read, which runs right after import
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -16,13 +16,31 @@ package resource_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
 	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/acctest"
 	unifiedpolicyresource "github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy/resource"
 	"github.com/open-policy-agent/opa/v1/ast"
@@ -61,10 +79,15 @@ func TestAccTemplate_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttr(resourceName, "description", "Test template for acceptance testing"),
 					resource.TestCheckResourceAttr(resourceName, "category", "security"),
+					resource.TestMatchResourceAttr(resourceName, "rego_canonical_hash", regexp.MustCompile(`^[0-9a-f]{64}$`)),
 					resource.TestCheckResourceAttr(resourceName, "data_source_type", "evidence"),
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
 					resource.TestCheckResourceAttrSet(resourceName, "rego"),
 					resource.TestCheckResourceAttr(resourceName, "is_custom", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "updated_by"),
 				),
 			},
 		},
@@ -249,7 +272,58 @@ func TestAccTemplate_import(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"rego"}, // API returns rego content (or empty), not the file path from config
+				ImportStateVerifyIgnore: []string{"rego", "change_summary"}, // API returns rego content (or empty), not the file path from config; change_summary only describes a plan
+			},
+		},
+	})
+}
+
+// TestAccTemplate_fromSystemTemplateRego tests creating a custom template from the Rego code of a system template
+func TestAccTemplate_fromSystemTemplateRego(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, fqrn, name := testutil.MkNames("test-template-from-system-", "unifiedpolicy_template")
+	resourceName := fmt.Sprintf("unifiedpolicy_template.%s", name)
+
+	config := fmt.Sprintf(`
+		data "unifiedpolicy_templates" "system" {
+			source = "system"
+			limit  = 1
+		}
+
+		data "unifiedpolicy_template" "system" {
+			id = data.unifiedpolicy_templates.system.templates[0].id
+		}
+
+		resource "unifiedpolicy_template" "%s" {
+			name             = "%s"
+			version          = "1.0.0"
+			description      = "Custom variant of a system template"
+			category         = data.unifiedpolicy_template.system.category
+			data_source_type = data.unifiedpolicy_template.system.data_source_type
+			rego_content     = data.unifiedpolicy_template.system.rego
+		}
+	`, name, name)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.TestAccCheckTemplateDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckNoResourceAttr(resourceName, "rego"),
+					resource.TestCheckResourceAttrPair(resourceName, "rego_content", "data.unifiedpolicy_template.system", "rego"),
+					resource.TestCheckResourceAttrSet(resourceName, "rego_canonical_hash"),
+					resource.TestCheckResourceAttr(resourceName, "is_custom", "true"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
 			},
 		},
 	})
@@ -546,6 +620,50 @@ func TestAccTemplate_createDuplicateName(t *testing.T) {
 }
 
 // TestAccTemplate_updateParametersAddThenRemove adds parameters then removes them.
+func TestAccTemplate_updateDuplicateName(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, fqrn, nameA := testutil.MkNames("test-template-dup-a-", "unifiedpolicy_template")
+	_, _, nameB := testutil.MkNames("test-template-dup-b-", "unifiedpolicy_template")
+	regoPath := acctest.RegoFixturePath(t, "params_policy.rego")
+
+	configTemplate := `
+		resource "unifiedpolicy_template" "a" {
+			name             = "%s"
+			version          = "1.0.0"
+			category         = "security"
+			data_source_type = "evidence"
+			rego             = %q
+			parameters       = []
+		}
+
+		resource "unifiedpolicy_template" "b" {
+			name             = "%s"
+			version          = "1.0.0"
+			category         = "security"
+			data_source_type = "evidence"
+			rego             = %q
+			parameters       = []
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.TestAccCheckTemplateDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configTemplate, nameA, regoPath, nameB, regoPath),
+			},
+			{
+				Config:      fmt.Sprintf(configTemplate, nameA, regoPath, nameA, regoPath),
+				ExpectError: regexp.MustCompile(`Template Name Conflict`),
+			},
+		},
+	})
+}
+
 func TestAccTemplate_updateParametersAddThenRemove(t *testing.T) {
 	acctest.SkipIfNotAcc(t)
 	acctest.PreCheck(t)
@@ -868,129 +986,1687 @@ allow {
 	}
 }
 
-// Acceptance tests for Rego validation during plan phase
+func TestReferencesInputParameters(t *testing.T) {
+	tests := []struct {
+		name     string
+		regoCode string
+		expected bool
+	}{
+		{
+			name: "dot notation",
+			regoCode: `package unifiedpolicy
+allow {
+    input.evidence.severity == input.parameters.severity
+}`,
+			expected: true,
+		},
+		{
+			name: "bracket notation",
+			regoCode: `package unifiedpolicy
+allow {
+    input["parameters"]["threshold"] > 7
+}`,
+			expected: true,
+		},
+		{
+			name: "parameters object",
+			regoCode: `package unifiedpolicy
+params := input.parameters
+allow {
+    params.enabled
+}`,
+			expected: true,
+		},
+		{
+			name: "no parameters",
+			regoCode: `package unifiedpolicy
+allow {
+    input.evidence.severity != "critical"
+}`,
+			expected: false,
+		},
+		{
+			name: "nested parameters key",
+			regoCode: `package unifiedpolicy
+allow {
+    input.evidence.parameters.enabled
+}`,
+			expected: false,
+		},
+	}
 
-func TestAccTemplate_invalidRegoSyntax(t *testing.T) {
-	acctest.SkipIfNotAcc(t)
-	acctest.PreCheck(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			module, err := ast.ParseModuleWithOpts("test.rego", tt.regoCode, ast.ParserOptions{RegoVersion: ast.RegoV0})
+			if err != nil {
+				t.Fatalf("Failed to parse rego: %v", err)
+			}
 
-	regoPath := acctest.RegoFixturePath(t, "invalid_syntax.rego")
-	config := fmt.Sprintf(`
-resource "unifiedpolicy_template" "invalid_syntax_test" {
-  name             = "Invalid Syntax Test"
-  version          = "1.0.0"
-  category         = "security"
-  data_source_type = "evidence"
-  rego             = %q
-  parameters       = []
+			if got := unifiedpolicyresource.ReferencesInputParameters(module); got != tt.expected {
+				t.Errorf("ReferencesInputParameters() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
 }
-`, regoPath)
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(t) },
-		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
-		Steps: []resource.TestStep{
-			{
-				Config:      config,
-				ExpectError: regexp.MustCompile(`Invalid Rego Syntax`),
+func TestTemplateMetadataPatch(t *testing.T) {
+	state := unifiedpolicyresource.TemplateResourceModel{
+		Name:              types.StringValue("template"),
+		Description:       types.StringValue("old"),
+		Version:           types.StringValue("1.0.0"),
+		Category:          types.StringValue("security"),
+		DataSourceType:    types.StringValue("evidence"),
+		Rego:              types.StringValue("/policies/policy.rego"),
+		Parameters:        types.ListValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "type": types.StringType}}, []attr.Value{}),
+		Scanners:          types.ListNull(types.StringType),
+		RegoCanonicalHash: types.StringValue("5f2c"),
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(m *unifiedpolicyresource.TemplateResourceModel)
+		wantBody map[string]interface{}
+	}{
+		{
+			name: "description and category",
+			modify: func(m *unifiedpolicyresource.TemplateResourceModel) {
+				m.Description = types.StringValue("new")
+				m.Category = types.StringValue("quality")
 			},
+			wantBody: map[string]interface{}{"description": "new", "category": "quality"},
 		},
-	})
-}
+		{
+			name: "name and version",
+			modify: func(m *unifiedpolicyresource.TemplateResourceModel) {
+				m.Name = types.StringValue("renamed")
+				m.Version = types.StringValue("1.1.0")
+			},
+			wantBody: map[string]interface{}{"name": "renamed", "version": "1.1.0"},
+		},
+		{
+			name: "rego path changed",
+			modify: func(m *unifiedpolicyresource.TemplateResourceModel) {
+				m.Description = types.StringValue("new")
+				m.Rego = types.StringValue("/policies/other.rego")
+			},
+		},
+		{
+			name: "rego code changed",
+			modify: func(m *unifiedpolicyresource.TemplateResourceModel) {
+				m.Description = types.StringValue("new")
+				m.RegoCanonicalHash = types.StringValue("7a41")
+			},
+		},
+		{
+			name: "rego hash unknown",
+			modify: func(m *unifiedpolicyresource.TemplateResourceModel) {
+				m.Description = types.StringValue("new")
+				m.RegoCanonicalHash = types.StringUnknown()
+			},
+		},
+		{
+			name: "scanners changed",
+			modify: func(m *unifiedpolicyresource.TemplateResourceModel) {
+				m.Scanners = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("sca")})
+			},
+		},
+		{
+			name:   "description removed",
+			modify: func(m *unifiedpolicyresource.TemplateResourceModel) { m.Description = types.StringNull() },
+		},
+		{
+			name:   "no metadata change",
+			modify: func(m *unifiedpolicyresource.TemplateResourceModel) { m.IncludeRegoAST = types.BoolValue(true) },
+		},
+	}
 
-func TestAccTemplate_invalidRegoOperations(t *testing.T) {
-	acctest.SkipIfNotAcc(t)
-	acctest.PreCheck(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := state
+			tt.modify(&plan)
 
-	regoPath := acctest.RegoFixturePath(t, "invalid_ops.rego")
-	config := fmt.Sprintf(`
-resource "unifiedpolicy_template" "invalid_ops_test" {
-  name             = "Invalid Operations Test"
-  version          = "1.0.0"
-  category         = "security"
-  data_source_type = "evidence"
-  rego             = %q
-  parameters       = []
+			body, ok := unifiedpolicyresource.TemplateMetadataPatch(plan, state)
+			if tt.wantBody == nil {
+				if ok {
+					t.Errorf("expected a full update, got PATCH body %v", body)
+				}
+				return
+			}
+			if !ok {
+				t.Fatal("expected a PATCH")
+			}
+			if !reflect.DeepEqual(body, tt.wantBody) {
+				t.Errorf("expected PATCH body %v, got %v", tt.wantBody, body)
+			}
+		})
+	}
 }
-`, regoPath)
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(t) },
-		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
-		Steps: []resource.TestStep{
-			{
-				Config:      config,
-				ExpectError: regexp.MustCompile(`Disallowed Rego Operations`),
-			},
-		},
-	})
-}
+func TestCanonicalRegoHash(t *testing.T) {
+	base := `package unifiedpolicy
 
-func TestAccTemplate_invalidRegoOperationHttpSend(t *testing.T) {
-	acctest.SkipIfNotAcc(t)
-	acctest.PreCheck(t)
+default allow = false
 
-	regoPath := acctest.RegoFixturePath(t, "invalid_http_send.rego")
-	config := fmt.Sprintf(`
-resource "unifiedpolicy_template" "invalid_http_send" {
-  name             = "Invalid HTTP Send Test"
-  version          = "1.0.0"
-  category         = "security"
-  data_source_type = "evidence"
-  rego             = %q
-  parameters       = []
+allow {
+	input.evidence.severity != "critical"
+	count(input.evidence.vulnerabilities) < 5
 }
-`, regoPath)
+`
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(t) },
-		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
-		Steps: []resource.TestStep{
-			{
-				Config:      config,
-				ExpectError: regexp.MustCompile(`Disallowed Rego Operations`),
-			},
+	tests := []struct {
+		name     string
+		regoCode string
+		same     bool
+	}{
+		{
+			name:     "identical",
+			regoCode: base,
+			same:     true,
 		},
-	})
-}
+		{
+			name: "comments added",
+			regoCode: `# Blocks critical findings
+package unifiedpolicy
 
-func TestAccTemplate_invalidRegoOperationIoJwtDecode(t *testing.T) {
-	acctest.SkipIfNotAcc(t)
-	acctest.PreCheck(t)
+# Deny by default
+default allow = false
 
-	regoPath := acctest.RegoFixturePath(t, "invalid_jwt_decode.rego")
-	config := fmt.Sprintf(`
-resource "unifiedpolicy_template" "invalid_jwt_decode" {
-  name             = "Invalid JWT Decode Test"
-  version          = "1.0.0"
-  category         = "security"
-  data_source_type = "evidence"
-  rego             = %q
-  parameters       = []
+allow {
+	input.evidence.severity != "critical" # never allow critical
+	count(input.evidence.vulnerabilities) < 5
 }
-`, regoPath)
+`,
+			same: true,
+		},
+		{
+			name: "whitespace and layout",
+			regoCode: `package   unifiedpolicy
+default allow = false
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(t) },
-		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
-		Steps: []resource.TestStep{
-			{
-				Config:      config,
-				ExpectError: regexp.MustCompile(`Disallowed Rego Operations`),
-			},
+
+allow {    input.evidence.severity != "critical"
+
+        count(input.evidence.vulnerabilities) < 5 }`,
+			same: true,
 		},
-	})
-}
+		{
+			name: "different threshold",
+			regoCode: `package unifiedpolicy
 
-func TestAccTemplate_invalidRegoOperationRandIntn(t *testing.T) {
-	acctest.SkipIfNotAcc(t)
-	acctest.PreCheck(t)
+default allow = false
 
-	regoPath := acctest.RegoFixturePath(t, "invalid_rand_intn.rego")
-	config := fmt.Sprintf(`
-resource "unifiedpolicy_template" "invalid_rand_intn" {
-  name             = "Invalid Rand Intn Test"
-  version          = "1.0.0"
+allow {
+	input.evidence.severity != "critical"
+	count(input.evidence.vulnerabilities) < 10
+}
+`,
+			same: false,
+		},
+		{
+			name: "condition removed",
+			regoCode: `package unifiedpolicy
+
+default allow = false
+
+allow {
+	input.evidence.severity != "critical"
+}
+`,
+			same: false,
+		},
+	}
+
+	hash := func(t *testing.T, regoCode string) string {
+		module, err := ast.ParseModuleWithOpts("test.rego", regoCode, ast.ParserOptions{RegoVersion: ast.RegoV0})
+		if err != nil {
+			t.Fatalf("Failed to parse rego: %v", err)
+		}
+		h, err := unifiedpolicyresource.CanonicalRegoHash(module)
+		if err != nil {
+			t.Fatalf("CanonicalRegoHash() error: %v", err)
+		}
+		return h
+	}
+
+	baseHash := hash(t, base)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hash(t, tt.regoCode); (got == baseHash) != tt.same {
+				t.Errorf("expected same hash %v, got %q for base %q", tt.same, got, baseHash)
+			}
+		})
+	}
+}
+
+func TestRegoModuleJSON(t *testing.T) {
+	regoCode := `package unifiedpolicy
+default allow = false
+allow {
+    input.evidence.severity != "critical"
+}`
+	module, err := ast.ParseModuleWithOpts("test.rego", regoCode, ast.ParserOptions{RegoVersion: ast.RegoV0})
+	if err != nil {
+		t.Fatalf("Failed to parse Rego code: %v", err)
+	}
+
+	astJSON, err := unifiedpolicyresource.RegoModuleJSON(module)
+	if err != nil {
+		t.Fatalf("Failed to serialize Rego AST: %v", err)
+	}
+
+	var decoded struct {
+		Package struct {
+			Path string `json:"path"`
+		} `json:"package"`
+		Rules []map[string]interface{} `json:"rules"`
+	}
+	if err := json.Unmarshal([]byte(astJSON), &decoded); err != nil {
+		t.Fatalf("rego_ast_json is not valid JSON: %v", err)
+	}
+	if decoded.Package.Path != "data.unifiedpolicy" {
+		t.Errorf("Expected package path data.unifiedpolicy, got %s", decoded.Package.Path)
+	}
+	if len(decoded.Rules) != 2 {
+		t.Errorf("Expected 2 rules in AST, got %d", len(decoded.Rules))
+	}
+}
+
+func TestReconcileScannerOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured []string
+		returned   []string
+		expected   []string
+	}{
+		{
+			name:       "reversed order",
+			configured: []string{"sca", "secrets", "exposures"},
+			returned:   []string{"exposures", "secrets", "sca"},
+			expected:   []string{"sca", "secrets", "exposures"},
+		},
+		{
+			name:       "extra scanners appended",
+			configured: []string{"secrets", "sca"},
+			returned:   []string{"malicious_package", "sca", "secrets"},
+			expected:   []string{"secrets", "sca", "malicious_package"},
+		},
+		{
+			name:       "configured scanner missing from response",
+			configured: []string{"secrets", "sca"},
+			returned:   []string{"sca"},
+			expected:   []string{"sca"},
+		},
+		{
+			name:       "nothing configured",
+			configured: nil,
+			returned:   []string{"sca", "secrets"},
+			expected:   []string{"sca", "secrets"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := unifiedpolicyresource.ReconcileScannerOrder(tt.configured, tt.returned)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestReconcileParameterOrder(t *testing.T) {
+	returned := []unifiedpolicyresource.TemplateParameterAPIModel{
+		{Name: "max_age", Type: "int"},
+		{Name: "enabled", Type: "bool"},
+		{Name: "severity", Type: "string"},
+	}
+	expected := []unifiedpolicyresource.TemplateParameterAPIModel{
+		{Name: "severity", Type: "string"},
+		{Name: "enabled", Type: "bool"},
+		{Name: "max_age", Type: "int"},
+	}
+
+	result := unifiedpolicyresource.ReconcileParameterOrder([]string{"severity", "enabled", "max_age"}, returned)
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestSortTemplateParametersByName(t *testing.T) {
+	returned := []unifiedpolicyresource.TemplateParameterAPIModel{
+		{Name: "severity", Type: "string"},
+		{Name: "enabled", Type: "bool"},
+		{Name: "max_age", Type: "int"},
+	}
+	expected := []unifiedpolicyresource.TemplateParameterAPIModel{
+		{Name: "enabled", Type: "bool"},
+		{Name: "max_age", Type: "int"},
+		{Name: "severity", Type: "string"},
+	}
+
+	result := unifiedpolicyresource.SortTemplateParametersByName(returned)
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	if returned[0].Name != "severity" {
+		t.Errorf("Expected input to be left unchanged, got %v", returned)
+	}
+}
+
+func TestCompileRegoStrict(t *testing.T) {
+	tests := []struct {
+		name        string
+		regoCode    string
+		expectError string
+	}{
+		{
+			name: "valid policy",
+			regoCode: `package unifiedpolicy
+default allow = false
+allow {
+    input.evidence.severity != "critical"
+}`,
+		},
+		{
+			name: "unused local variable",
+			regoCode: `package unifiedpolicy
+allow {
+    threshold := 7
+    input.evidence.severity != "critical"
+}`,
+			expectError: "assigned var threshold unused",
+		},
+		{
+			name: "unused import",
+			regoCode: `package unifiedpolicy
+import data.lib.helpers
+allow {
+    input.evidence.severity != "critical"
+}`,
+			expectError: "import data.lib.helpers unused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			module, err := ast.ParseModuleWithOpts("test.rego", tt.regoCode, ast.ParserOptions{RegoVersion: ast.RegoV0})
+			if err != nil {
+				t.Fatalf("Failed to parse Rego code: %v", err)
+			}
+
+			errs := unifiedpolicyresource.CompileRegoStrict(module)
+			if tt.expectError == "" {
+				if len(errs) > 0 {
+					t.Errorf("Expected no strict mode errors, got %v", errs)
+				}
+				return
+			}
+			found := false
+			for _, e := range errs {
+				if strings.Contains(e, tt.expectError) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Errorf("Expected strict mode error containing %q, got %v", tt.expectError, errs)
+			}
+		})
+	}
+}
+
+func TestValidateRegoCode(t *testing.T) {
+	tests := []struct {
+		name             string
+		regoCode         string
+		strict           bool
+		maxChars         int
+		regoVersion      string
+		expectValid      bool
+		expectError      bool
+		expectErrorText  string
+		expectDisallowed []string
+		expectStrict     bool
+	}{
+		{
+			name: "valid policy",
+			regoCode: `package unifiedpolicy
+default allow = false
+allow {
+    input.evidence.severity != "critical"
+}`,
+			expectValid: true,
+		},
+		{
+			name:        "empty code",
+			regoCode:    "",
+			expectError: true,
+		},
+		{
+			name:        "syntax error",
+			regoCode:    "package unifiedpolicy\nallow {",
+			expectError: true,
+		},
+		{
+			name:        "longer than configured limit",
+			regoCode:    "package unifiedpolicy\ndefault allow = false",
+			maxChars:    20,
+			expectError: true,
+		},
+		{
+			name: "disallowed operation",
+			regoCode: `package unifiedpolicy
+allow {
+    http.send({"method": "GET", "url": "https://example.com"})
+}`,
+			expectDisallowed: []string{"http.send"},
+		},
+		{
+			name: "strict mode error only reported when strict",
+			regoCode: `package unifiedpolicy
+allow {
+    threshold := 7
+    input.evidence.severity != "critical"
+}`,
+			strict:       true,
+			expectStrict: true,
+		},
+		{
+			name: "rego v1 syntax",
+			regoCode: `package unifiedpolicy
+default allow = false
+deny contains msg if {
+    input.evidence.severity == "critical"
+    msg = "critical"
+}`,
+			regoVersion: unifiedpolicyresource.RegoVersionV1,
+			expectValid: true,
+		},
+		{
+			name: "rego v1 syntax parsed as v0",
+			regoCode: `package unifiedpolicy
+deny contains msg if {
+    msg = "critical"
+}`,
+			expectError:     true,
+			expectErrorText: "parsed as Rego v0",
+		},
+		{
+			name:            "rego v0 syntax parsed as v1",
+			regoCode:        "package unifiedpolicy\nallow {\n    input.evidence.severity != \"critical\"\n}",
+			regoVersion:     unifiedpolicyresource.RegoVersionV1,
+			expectError:     true,
+			expectErrorText: "parsed as Rego v1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maxChars := tt.maxChars
+			if maxChars == 0 {
+				maxChars = unifiedpolicy.DefaultMaxRegoChars
+			}
+			result := unifiedpolicyresource.ValidateRegoCode(tt.regoCode, tt.strict, maxChars, tt.regoVersion, nil)
+			if result.Valid() != tt.expectValid {
+				t.Errorf("Expected valid=%v, got %+v", tt.expectValid, result)
+			}
+			if (result.Error != "") != tt.expectError {
+				t.Errorf("Expected error=%v, got %q", tt.expectError, result.Error)
+			}
+			if !strings.Contains(result.Error, tt.expectErrorText) {
+				t.Errorf("Expected error to contain %q, got %q", tt.expectErrorText, result.Error)
+			}
+			if len(tt.expectDisallowed) > 0 && !reflect.DeepEqual(result.DisallowedOperations, tt.expectDisallowed) {
+				t.Errorf("Expected disallowed operations %v, got %v", tt.expectDisallowed, result.DisallowedOperations)
+			}
+			if (len(result.StrictErrors) > 0) != tt.expectStrict {
+				t.Errorf("Expected strict errors=%v, got %v", tt.expectStrict, result.StrictErrors)
+			}
+			if (len(result.Problems()) == 0) != result.Valid() {
+				t.Errorf("Expected problems only for an invalid result, got %v", result.Problems())
+			}
+		})
+	}
+}
+
+func TestTemplateRegoSchemaValidator_missingFile(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	(&unifiedpolicyresource.TemplateResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	regoAttr := schemaResp.Schema.Attributes["rego"].(schema.StringAttribute)
+
+	tests := []struct {
+		name        string
+		path        string
+		expectError bool
+	}{
+		// Missing files are left to ModifyPlan, which knows whether defer_rego_validation is set
+		{name: "missing file", path: filepath.Join(t.TempDir(), "generated.rego"), expectError: false},
+		{name: "not a rego file", path: filepath.Join(t.TempDir(), "policy.txt"), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := templateConfig(ctx, schemaResp.Schema, map[string]tftypes.Value{
+				"rego": tftypes.NewValue(tftypes.String, tt.path),
+			})
+			var diags diag.Diagnostics
+			for _, v := range regoAttr.Validators {
+				resp := &validator.StringResponse{}
+				v.ValidateString(ctx, validator.StringRequest{Path: path.Root("rego"), ConfigValue: types.StringValue(tt.path), Config: config}, resp)
+				diags.Append(resp.Diagnostics...)
+			}
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error=%v, got %v", tt.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestTemplateRegoExactlyOneOf(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	(&unifiedpolicyresource.TemplateResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	regoAttr := schemaResp.Schema.Attributes["rego"].(schema.StringAttribute)
+
+	regoPath := filepath.Join(t.TempDir(), "policy.rego")
+	regoCode := "package unifiedpolicy\n\ndefault allow = false\n"
+	if err := os.WriteFile(regoPath, []byte(regoCode), 0o600); err != nil {
+		t.Fatalf("Failed to write rego file: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		rego        tftypes.Value
+		regoContent tftypes.Value
+		expectError string
+	}{
+		{name: "rego only", rego: tftypes.NewValue(tftypes.String, regoPath), regoContent: tftypes.NewValue(tftypes.String, nil)},
+		{name: "rego_content only", rego: tftypes.NewValue(tftypes.String, nil), regoContent: tftypes.NewValue(tftypes.String, regoCode)},
+		{
+			name:        "both",
+			rego:        tftypes.NewValue(tftypes.String, regoPath),
+			regoContent: tftypes.NewValue(tftypes.String, regoCode),
+			expectError: "2 attributes specified",
+		},
+		{
+			name:        "neither",
+			rego:        tftypes.NewValue(tftypes.String, nil),
+			regoContent: tftypes.NewValue(tftypes.String, nil),
+			expectError: "No attribute specified",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := templateConfig(ctx, schemaResp.Schema, map[string]tftypes.Value{
+				"rego":         tt.rego,
+				"rego_content": tt.regoContent,
+			})
+			var regoValue types.String
+			if diags := config.GetAttribute(ctx, path.Root("rego"), &regoValue); diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+			var diags diag.Diagnostics
+			for _, v := range regoAttr.Validators {
+				resp := &validator.StringResponse{}
+				v.ValidateString(ctx, validator.StringRequest{Path: path.Root("rego"), PathExpression: path.MatchRoot("rego"), ConfigValue: regoValue, Config: config}, resp)
+				diags.Append(resp.Diagnostics...)
+			}
+			if tt.expectError == "" {
+				if diags.HasError() {
+					t.Errorf("Unexpected error: %v", diags)
+				}
+				return
+			}
+			found := false
+			for _, d := range diags.Errors() {
+				found = found || strings.Contains(d.Detail(), tt.expectError)
+			}
+			if !found {
+				t.Errorf("Expected an error containing %q, got %v", tt.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestTemplateRegoContentSchemaValidator(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	(&unifiedpolicyresource.TemplateResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	regoContentAttr := schemaResp.Schema.Attributes["rego_content"].(schema.StringAttribute)
+
+	tests := []struct {
+		name        string
+		regoCode    string
+		expectError bool
+	}{
+		{
+			name: "valid policy",
+			regoCode: `package unifiedpolicy
+default allow = false
+allow {
+    input.evidence.severity != "critical"
+}`,
+			expectError: false,
+		},
+		{
+			// The allowed operations depend on the provider extra_allowed_rego_operations, so ModifyPlan checks them
+			name: "disallowed operation deferred to plan",
+			regoCode: `package unifiedpolicy
+allow {
+    http.send({"method": "GET", "url": "https://example.com"})
+}`,
+			expectError: false,
+		},
+		{name: "syntax error", regoCode: "package unifiedpolicy\nallow {", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := templateConfig(ctx, schemaResp.Schema, map[string]tftypes.Value{
+				"rego_content": tftypes.NewValue(tftypes.String, tt.regoCode),
+			})
+			var diags diag.Diagnostics
+			for _, v := range regoContentAttr.Validators {
+				resp := &validator.StringResponse{}
+				v.ValidateString(ctx, validator.StringRequest{Path: path.Root("rego_content"), ConfigValue: types.StringValue(tt.regoCode), Config: config}, resp)
+				diags.Append(resp.Diagnostics...)
+			}
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error=%v, got %v", tt.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestTemplateParameterDefaultValidator(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	(&unifiedpolicyresource.TemplateResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	defaultAttr := schemaResp.Schema.Attributes["parameters"].(schema.ListNestedAttribute).NestedObject.Attributes["default"].(schema.StringAttribute)
+	parametersType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["parameters"].(tftypes.List)
+	parameterType := parametersType.ElementType.(tftypes.Object)
+
+	tests := []struct {
+		name         string
+		paramType    string
+		schema       interface{}
+		defaultValue string
+		expectError  bool
+	}{
+		{name: "int", paramType: "int", defaultValue: "5"},
+		{name: "non-numeric int", paramType: "int", defaultValue: "five", expectError: true},
+		{name: "float", paramType: "float", defaultValue: "0.5"},
+		{name: "bool", paramType: "bool", defaultValue: "True"},
+		{name: "invalid bool", paramType: "bool", defaultValue: "yes", expectError: true},
+		{name: "string", paramType: "string", defaultValue: "high"},
+		{name: "object", paramType: "object", defaultValue: `{"max": 3}`},
+		{name: "invalid object", paramType: "object", defaultValue: "[1]", expectError: true},
+		{
+			name:         "object matching schema",
+			paramType:    "object",
+			schema:       `{"type": "object", "properties": {"max": {"type": "integer"}}}`,
+			defaultValue: `{"max": 3}`,
+		},
+		{
+			name:         "object not matching schema",
+			paramType:    "object",
+			schema:       `{"type": "object", "properties": {"max": {"type": "integer"}}}`,
+			defaultValue: `{"max": "three"}`,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parameter := tftypes.NewValue(parameterType, map[string]tftypes.Value{
+				"name":    tftypes.NewValue(tftypes.String, "param"),
+				"type":    tftypes.NewValue(tftypes.String, tt.paramType),
+				"schema":  tftypes.NewValue(tftypes.String, tt.schema),
+				"default": tftypes.NewValue(tftypes.String, tt.defaultValue),
+			})
+			config := templateConfig(ctx, schemaResp.Schema, map[string]tftypes.Value{
+				"parameters": tftypes.NewValue(parametersType, []tftypes.Value{parameter}),
+			})
+			var diags diag.Diagnostics
+			for _, v := range defaultAttr.Validators {
+				resp := &validator.StringResponse{}
+				v.ValidateString(ctx, validator.StringRequest{
+					Path:        path.Root("parameters").AtListIndex(0).AtName("default"),
+					ConfigValue: types.StringValue(tt.defaultValue),
+					Config:      config,
+				}, resp)
+				diags.Append(resp.Diagnostics...)
+			}
+			if diags.HasError() != tt.expectError {
+				t.Errorf("Expected error=%v, got %v", tt.expectError, diags)
+			}
+		})
+	}
+}
+
+// templateConfig returns a template configuration with the given attribute values and all other attributes null.
+func templateConfig(ctx context.Context, s schema.Schema, values map[string]tftypes.Value) tfsdk.Config {
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		} else {
+			attrs[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+	return tfsdk.Config{Schema: s, Raw: tftypes.NewValue(objectType, attrs)}
+}
+
+func TestExpandRegoPath(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skipf("home directory not available: %v", err)
+	}
+	t.Setenv("UNIFIEDPOLICY_TEST_POLICY_DIR", "/opt/policies")
+
+	tests := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{
+			name:     "absolute path unchanged",
+			path:     "/opt/policies/basic.rego",
+			expected: "/opt/policies/basic.rego",
+		},
+		{
+			name:     "environment variable",
+			path:     "$UNIFIEDPOLICY_TEST_POLICY_DIR/basic.rego",
+			expected: "/opt/policies/basic.rego",
+		},
+		{
+			name:     "braced environment variable",
+			path:     "${UNIFIEDPOLICY_TEST_POLICY_DIR}/basic.rego",
+			expected: "/opt/policies/basic.rego",
+		},
+		{
+			name:     "home directory",
+			path:     "~/policies/basic.rego",
+			expected: filepath.Join(home, "policies/basic.rego"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := unifiedpolicyresource.ExpandRegoPath(tt.path)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestValidateRegoFile_expandPath(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "policy.rego"), []byte("package unifiedpolicy\n\ndefault allow = false\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write rego file: %v", err)
+	}
+	t.Setenv("UNIFIEDPOLICY_TEST_POLICY_DIR", dir)
+	regoPath := "$UNIFIEDPOLICY_TEST_POLICY_DIR/policy.rego"
+
+	result := unifiedpolicyresource.ValidateRegoFile(regoPath, true, false, 0, "", unifiedpolicyresource.RegoVersionV0, nil)
+	if problems := result.Problems(); len(problems) > 0 {
+		t.Errorf("Expected the expanded path to be valid, got %v", problems)
+	}
+
+	result = unifiedpolicyresource.ValidateRegoFile(regoPath, false, false, 0, "", unifiedpolicyresource.RegoVersionV0, nil)
+	if !strings.Contains(result.Error, "expand_rego_path") {
+		t.Errorf("Expected an error suggesting expand_rego_path, got %q", result.Error)
+	}
+}
+
+func TestRegoContentAndHashFromFile(t *testing.T) {
+	regoCode := "package unifiedpolicy\n\ndefault allow = false\n"
+	regoPath := filepath.Join(t.TempDir(), "policy.rego")
+	if err := os.WriteFile(regoPath, []byte(regoCode), 0o600); err != nil {
+		t.Fatalf("Failed to write rego file: %v", err)
+	}
+
+	content, hash, err := unifiedpolicyresource.RegoContentAndHashFromFile(regoPath, false, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if content != regoCode {
+		t.Errorf("Expected content %q, got %q", regoCode, content)
+	}
+	sum := sha256.Sum256([]byte(regoCode))
+	if expected := hex.EncodeToString(sum[:]); hash != expected {
+		t.Errorf("Expected hash %s, got %s", expected, hash)
+	}
+
+	// Relative paths are resolved against the base directory, and must stay within it
+	baseDir, err := filepath.EvalSymlinks(filepath.Dir(regoPath))
+	if err != nil {
+		t.Fatalf("Failed to resolve temp dir: %v", err)
+	}
+	if relContent, relHash, err := unifiedpolicyresource.RegoContentAndHashFromFile("./policy.rego", false, baseDir); err != nil {
+		t.Errorf("Unexpected error for a relative path: %v", err)
+	} else if relContent != content || relHash != hash {
+		t.Errorf("Expected the relative path to read the same file")
+	}
+	if _, _, err := unifiedpolicyresource.RegoContentAndHashFromFile("../policy.rego", false, baseDir); err == nil {
+		t.Error("Expected an error for a relative path outside the base directory")
+	}
+	if _, _, err := unifiedpolicyresource.RegoContentAndHashFromFile("policy.txt", false, baseDir); err == nil {
+		t.Error("Expected an error for a relative path without the .rego suffix")
+	}
+	if _, _, err := unifiedpolicyresource.RegoContentAndHashFromFile(filepath.Join(t.TempDir(), "missing.rego"), false, ""); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
+
+func TestResolveRelativeRegoPath(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get working directory: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		baseDir  string
+		expected string
+	}{
+		{name: "working directory", path: "modules/policies/basic.rego", expected: filepath.Join(wd, "modules/policies/basic.rego")},
+		{name: "module path", path: "./modules/policies/../policies/basic.rego", expected: filepath.Join(wd, "modules/policies/basic.rego")},
+		{name: "base directory", path: "policies/basic.rego", baseDir: "/opt/checkout", expected: "/opt/checkout/policies/basic.rego"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := unifiedpolicyresource.ResolveRelativeRegoPath(tt.path, tt.baseDir)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCheckRegoBaseDir(t *testing.T) {
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to resolve temp dir: %v", err)
+	}
+	baseDir := filepath.Join(root, "policies")
+	outsideDir := filepath.Join(root, "policies-other")
+	for _, dir := range []string{baseDir, outsideDir} {
+		if err := os.Mkdir(dir, 0o700); err != nil {
+			t.Fatalf("Failed to create %s: %v", dir, err)
+		}
+	}
+	insidePath := filepath.Join(baseDir, "policy.rego")
+	outsidePath := filepath.Join(outsideDir, "policy.rego")
+	for _, p := range []string{insidePath, outsidePath} {
+		if err := os.WriteFile(p, []byte("package unifiedpolicy\n"), 0o600); err != nil {
+			t.Fatalf("Failed to write %s: %v", p, err)
+		}
+	}
+	linkPath := filepath.Join(baseDir, "link.rego")
+	if err := os.Symlink(outsidePath, linkPath); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		path        string
+		baseDir     string
+		expectError bool
+	}{
+		{name: "inside", path: insidePath, baseDir: baseDir},
+		{name: "missing file inside", path: filepath.Join(baseDir, "generated", "policy.rego"), baseDir: baseDir},
+		{name: "outside", path: outsidePath, baseDir: baseDir, expectError: true},
+		{name: "outside through ..", path: baseDir + "/../policies-other/policy.rego", baseDir: baseDir, expectError: true},
+		{name: "symlink to outside", path: linkPath, baseDir: baseDir, expectError: true},
+		{name: "no base dir", path: outsidePath, baseDir: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := unifiedpolicyresource.CheckRegoBaseDir(tt.path, tt.baseDir)
+			if (err != nil) != tt.expectError {
+				t.Errorf("Expected error=%v, got %v", tt.expectError, err)
+			}
+		})
+	}
+
+	if _, _, err := unifiedpolicyresource.RegoContentAndHashFromFile(outsidePath, false, baseDir); err == nil || !strings.Contains(err.Error(), "rego_base_dir") {
+		t.Errorf("Expected a rego_base_dir error for a file outside the base directory, got %v", err)
+	}
+	if _, _, err := unifiedpolicyresource.RegoContentAndHashFromFile(insidePath, false, baseDir); err != nil {
+		t.Errorf("Unexpected error for a file inside the base directory: %v", err)
+	}
+}
+
+func TestTemplateModifyPlanRegoVersion(t *testing.T) {
+	ctx := context.Background()
+
+	r := &unifiedpolicyresource.TemplateResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata: util.ProviderMetadata{Client: resty.New()},
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	regoV1 := "package unifiedpolicy\n\ndeny contains msg if {\n    input.evidence.severity == \"critical\"\n    msg = \"critical\"\n}\n"
+
+	tests := []struct {
+		name          string
+		regoVersion   tftypes.Value
+		expectVersion string
+	}{
+		{name: "default v0", regoVersion: tftypes.NewValue(tftypes.String, nil), expectVersion: "Rego v0"},
+		{name: "v1", regoVersion: tftypes.NewValue(tftypes.String, unifiedpolicyresource.RegoVersionV1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := templateConfig(ctx, schemaResp.Schema, map[string]tftypes.Value{
+				"name":             tftypes.NewValue(tftypes.String, "template"),
+				"version":          tftypes.NewValue(tftypes.String, "1.0.0"),
+				"category":         tftypes.NewValue(tftypes.String, "security"),
+				"data_source_type": tftypes.NewValue(tftypes.String, "xray"),
+				"rego_content":     tftypes.NewValue(tftypes.String, regoV1),
+				"rego_version":     tt.regoVersion,
+			})
+			req := fwresource.ModifyPlanRequest{
+				Config: config,
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: config.Raw},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+
+			if tt.expectVersion == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				var hash types.String
+				resp.Plan.GetAttribute(ctx, path.Root("rego_canonical_hash"), &hash)
+				if hash.IsNull() || hash.IsUnknown() {
+					t.Errorf("Expected a planned rego_canonical_hash, got %s", hash)
+				}
+				return
+			}
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != 1 || errs[0].Summary() != "Invalid Rego Syntax" || !strings.Contains(errs[0].Detail(), tt.expectVersion) {
+				t.Errorf("Expected a syntax error naming %s, got %v", tt.expectVersion, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestTemplateModifyPlanRegoValidationMode(t *testing.T) {
+	ctx := context.Background()
+
+	r := &unifiedpolicyresource.TemplateResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata: util.ProviderMetadata{Client: resty.New()},
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	regoHTTPSend := "package unifiedpolicy\n\ndefault allow = false\n\nallow {\n    http.send({\"method\": \"GET\", \"url\": \"https://example.com\"})\n}\n"
+
+	tests := []struct {
+		name           string
+		validationMode tftypes.Value
+		expectError    bool
+	}{
+		{name: "default error", validationMode: tftypes.NewValue(tftypes.String, nil), expectError: true},
+		{name: "error", validationMode: tftypes.NewValue(tftypes.String, unifiedpolicyresource.RegoValidationModeError), expectError: true},
+		{name: "warn", validationMode: tftypes.NewValue(tftypes.String, unifiedpolicyresource.RegoValidationModeWarn)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := templateConfig(ctx, schemaResp.Schema, map[string]tftypes.Value{
+				"name":                 tftypes.NewValue(tftypes.String, "template"),
+				"version":              tftypes.NewValue(tftypes.String, "1.0.0"),
+				"category":             tftypes.NewValue(tftypes.String, "security"),
+				"data_source_type":     tftypes.NewValue(tftypes.String, "xray"),
+				"rego_content":         tftypes.NewValue(tftypes.String, regoHTTPSend),
+				"rego_validation_mode": tt.validationMode,
+			})
+			req := fwresource.ModifyPlanRequest{
+				Config: config,
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: config.Raw},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+
+			diags := resp.Diagnostics.Warnings()
+			if tt.expectError {
+				diags = resp.Diagnostics.Errors()
+			} else if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if len(diags) != 1 || diags[0].Summary() != "Disallowed Rego Operations" || !strings.Contains(diags[0].Detail(), "http.send") {
+				t.Fatalf("Expected one disallowed operations diagnostic naming http.send, got %v", resp.Diagnostics)
+			}
+
+			if !tt.expectError {
+				var hash types.String
+				resp.Plan.GetAttribute(ctx, path.Root("rego_canonical_hash"), &hash)
+				if hash.IsNull() || hash.IsUnknown() {
+					t.Errorf("Expected a planned rego_canonical_hash, got %s", hash)
+				}
+			}
+		})
+	}
+}
+
+func TestTemplateModifyPlanExtraAllowedRegoOperations(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	(&unifiedpolicyresource.TemplateResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	regoHTTPSend := "package unifiedpolicy\n\ndefault allow = false\n\nallow {\n    http.send({\"method\": \"GET\", \"url\": \"https://example.com\"})\n}\n"
+
+	tests := []struct {
+		name        string
+		extraOps    []string
+		expectError string
+	}{
+		{name: "not configured", expectError: "can be allowed with the provider extra_allowed_rego_operations"},
+		{name: "other operation allowed", extraOps: []string{"io.jwt.decode"}, expectError: "extra_allowed_rego_operations allows: io.jwt.decode"},
+		{name: "operation allowed", extraOps: []string{"http.send"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &unifiedpolicyresource.TemplateResource{
+				ProviderData: unifiedpolicy.ProviderMetadata{
+					ProviderMetadata:           util.ProviderMetadata{Client: resty.New()},
+					ExtraAllowedRegoOperations: tt.extraOps,
+				},
+			}
+			config := templateConfig(ctx, schemaResp.Schema, map[string]tftypes.Value{
+				"name":             tftypes.NewValue(tftypes.String, "template"),
+				"version":          tftypes.NewValue(tftypes.String, "1.0.0"),
+				"category":         tftypes.NewValue(tftypes.String, "security"),
+				"data_source_type": tftypes.NewValue(tftypes.String, "xray"),
+				"rego_content":     tftypes.NewValue(tftypes.String, regoHTTPSend),
+			})
+			req := fwresource.ModifyPlanRequest{
+				Config: config,
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: config.Raw},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+
+			if tt.expectError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != 1 || errs[0].Summary() != "Disallowed Rego Operations" || !strings.Contains(errs[0].Detail(), tt.expectError) {
+				t.Errorf("Expected a disallowed operations error containing %q, got %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestAllowedRegoOperations(t *testing.T) {
+	builtIn := unifiedpolicyresource.GetAllowedRegoOperations()
+	allowed := unifiedpolicyresource.AllowedRegoOperations([]string{"http.send", "eq"})
+
+	if !allowed["http.send"] {
+		t.Error("Expected the extra operation http.send to be allowed")
+	}
+	for op := range builtIn {
+		if !allowed[op] {
+			t.Errorf("Expected built-in operation %s to stay allowed", op)
+		}
+	}
+	if len(allowed) != len(builtIn)+1 {
+		t.Errorf("Expected %d allowed operations, got %d", len(builtIn)+1, len(allowed))
+	}
+	if unifiedpolicyresource.GetAllowedRegoOperations()["http.send"] {
+		t.Error("Expected the built-in operations not to be modified")
+	}
+}
+
+func TestTemplateModifyPlanExpectedInputKeys(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	(&unifiedpolicyresource.TemplateResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	regoXray := "package unifiedpolicy\n\nallow {\n    input.xray.severity == \"critical\"\n}\n"
+
+	tests := []struct {
+		name              string
+		expectedInputKeys map[string][]string
+		dataSourceType    string
+		expectWarning     bool
+	}{
+		{name: "not configured", dataSourceType: "evidence"},
+		{name: "unexpected key", expectedInputKeys: map[string][]string{"evidence": {"evidence"}}, dataSourceType: "evidence", expectWarning: true},
+		{name: "expected key", expectedInputKeys: map[string][]string{"xray": {"xray"}}, dataSourceType: "xray"},
+		{name: "data source type not listed", expectedInputKeys: map[string][]string{"xray": {"xray"}}, dataSourceType: "evidence"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &unifiedpolicyresource.TemplateResource{
+				ProviderData: unifiedpolicy.ProviderMetadata{
+					ProviderMetadata:  util.ProviderMetadata{Client: resty.New()},
+					ExpectedInputKeys: tt.expectedInputKeys,
+				},
+			}
+			config := templateConfig(ctx, schemaResp.Schema, map[string]tftypes.Value{
+				"name":             tftypes.NewValue(tftypes.String, "template"),
+				"version":          tftypes.NewValue(tftypes.String, "1.0.0"),
+				"category":         tftypes.NewValue(tftypes.String, "security"),
+				"data_source_type": tftypes.NewValue(tftypes.String, tt.dataSourceType),
+				"rego_content":     tftypes.NewValue(tftypes.String, regoXray),
+			})
+			req := fwresource.ModifyPlanRequest{
+				Config: config,
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: config.Raw},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			warnings := resp.Diagnostics.Warnings()
+			if !tt.expectWarning {
+				if len(warnings) > 0 {
+					t.Fatalf("Unexpected warnings: %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || warnings[0].Summary() != "Unexpected Rego Input Keys" || !strings.Contains(warnings[0].Detail(), "input.xray") {
+				t.Errorf("Expected one warning naming input.xray, got %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestTemplateModifyPlanRequireDefaultDecision(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	(&unifiedpolicyresource.TemplateResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name        string
+		require     bool
+		fixture     string
+		expectError bool
+	}{
+		{name: "default declared", require: true, fixture: "basic_policy.rego"},
+		{name: "default missing", require: true, fixture: "no_default_decision.rego", expectError: true},
+		{name: "not required", fixture: "no_default_decision.rego"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &unifiedpolicyresource.TemplateResource{
+				ProviderData: unifiedpolicy.ProviderMetadata{
+					ProviderMetadata:       util.ProviderMetadata{Client: resty.New()},
+					RequireDefaultDecision: tt.require,
+				},
+			}
+			config := templateConfig(ctx, schemaResp.Schema, map[string]tftypes.Value{
+				"name":             tftypes.NewValue(tftypes.String, "template"),
+				"version":          tftypes.NewValue(tftypes.String, "1.0.0"),
+				"category":         tftypes.NewValue(tftypes.String, "security"),
+				"data_source_type": tftypes.NewValue(tftypes.String, "xray"),
+				"rego":             tftypes.NewValue(tftypes.String, acctest.RegoFixturePath(t, tt.fixture)),
+			})
+			req := fwresource.ModifyPlanRequest{
+				Config: config,
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: config.Raw},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+
+			hasError := false
+			for _, d := range resp.Diagnostics.Errors() {
+				hasError = hasError || d.Summary() == "Missing Default Decision"
+			}
+			if hasError != tt.expectError || resp.Diagnostics.ErrorsCount() > 1 {
+				t.Errorf("Expected error=%v, got %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestTemplateModifyPlanRegoBaseDir(t *testing.T) {
+	ctx := context.Background()
+
+	baseDir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to resolve temp dir: %v", err)
+	}
+	insidePath := filepath.Join(baseDir, "policy.rego")
+	outsidePath := filepath.Join(t.TempDir(), "policy.rego")
+	for _, p := range []string{insidePath, outsidePath} {
+		if err := os.WriteFile(p, []byte("package unifiedpolicy\n\ndefault allow = false\n"), 0o600); err != nil {
+			t.Fatalf("Failed to write %s: %v", p, err)
+		}
+	}
+
+	r := &unifiedpolicyresource.TemplateResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata: util.ProviderMetadata{Client: resty.New()},
+			RegoBaseDir:      baseDir,
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name        string
+		regoPath    string
+		expectError bool
+	}{
+		{name: "inside base directory", regoPath: insidePath},
+		{name: "outside base directory", regoPath: outsidePath, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := templateConfig(ctx, schemaResp.Schema, map[string]tftypes.Value{
+				"name":             tftypes.NewValue(tftypes.String, "template"),
+				"version":          tftypes.NewValue(tftypes.String, "1.0.0"),
+				"category":         tftypes.NewValue(tftypes.String, "security"),
+				"data_source_type": tftypes.NewValue(tftypes.String, "xray"),
+				"rego":             tftypes.NewValue(tftypes.String, tt.regoPath),
+			})
+			req := fwresource.ModifyPlanRequest{
+				Config: config,
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: config.Raw},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+
+			hasError := false
+			for _, d := range resp.Diagnostics.Errors() {
+				hasError = hasError || d.Summary() == "Rego Path Outside Base Directory"
+			}
+			if hasError != tt.expectError {
+				t.Errorf("Expected error=%v, got %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestTemplateModifyPlanRegoFileDrift(t *testing.T) {
+	ctx := context.Background()
+
+	regoPath := filepath.Join(t.TempDir(), "policy.rego")
+	original := "package unifiedpolicy\n\ndefault allow = false\n\nallow {\n  input.evidence.severity != \"critical\"\n}\n"
+
+	schemaResp := &fwresource.SchemaResponse{}
+	(&unifiedpolicyresource.TemplateResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	plannedHash := func(deferValidation bool) (types.String, diag.Diagnostics) {
+		r := &unifiedpolicyresource.TemplateResource{
+			ProviderData: unifiedpolicy.ProviderMetadata{
+				ProviderMetadata:    util.ProviderMetadata{Client: resty.New()},
+				DeferRegoValidation: deferValidation,
+			},
+		}
+		config := templateConfig(ctx, schemaResp.Schema, map[string]tftypes.Value{
+			"name":             tftypes.NewValue(tftypes.String, "template"),
+			"version":          tftypes.NewValue(tftypes.String, "1.0.0"),
+			"category":         tftypes.NewValue(tftypes.String, "security"),
+			"data_source_type": tftypes.NewValue(tftypes.String, "xray"),
+			"rego":             tftypes.NewValue(tftypes.String, regoPath),
+		})
+		req := fwresource.ModifyPlanRequest{
+			Config: config,
+			Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: config.Raw},
+			State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+		}
+		resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+		r.ModifyPlan(ctx, req, resp)
+
+		var hash types.String
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("rego_canonical_hash"), &hash)...)
+		return hash, resp.Diagnostics
+	}
+
+	// The refreshed state holds the hash of the code stored by the API, the original file content
+	module, err := ast.ParseModuleWithOpts("policy.rego", original, ast.ParserOptions{RegoVersion: ast.RegoV0})
+	if err != nil {
+		t.Fatalf("Failed to parse rego: %v", err)
+	}
+	storedHash, err := unifiedpolicyresource.CanonicalRegoHash(module)
+	if err != nil {
+		t.Fatalf("Failed to hash rego: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		content     string
+		expectDrift bool
+	}{
+		{name: "unchanged file", content: original},
+		{name: "comment added", content: "# Blocks critical findings\n" + original},
+		{name: "policy changed", content: strings.Replace(original, "critical", "high", 1), expectDrift: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := os.WriteFile(regoPath, []byte(tt.content), 0o600); err != nil {
+				t.Fatalf("Failed to write rego file: %v", err)
+			}
+			hash, diags := plannedHash(false)
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+			if drift := hash.ValueString() != storedHash; drift != tt.expectDrift {
+				t.Errorf("Expected drift=%v, planned hash %s, stored hash %s", tt.expectDrift, hash, storedHash)
+			}
+		})
+	}
+
+	t.Run("file removed", func(t *testing.T) {
+		if err := os.Remove(regoPath); err != nil {
+			t.Fatalf("Failed to remove rego file: %v", err)
+		}
+		if _, diags := plannedHash(false); !diags.HasError() {
+			t.Error("Expected an error for a removed rego file")
+		}
+		if _, diags := plannedHash(true); diags.HasError() {
+			t.Errorf("Expected no error with defer_rego_validation, got %v", diags)
+		}
+	})
+}
+
+func TestTemplateModifyPlanStoredRegoContent(t *testing.T) {
+	ctx := context.Background()
+
+	regoPath := filepath.Join(t.TempDir(), "policy.rego")
+	regoCode := "package unifiedpolicy\n\ndefault allow = false\n"
+	if err := os.WriteFile(regoPath, []byte(regoCode), 0o600); err != nil {
+		t.Fatalf("Failed to write rego file: %v", err)
+	}
+	module, err := ast.ParseModuleWithOpts("policy.rego", regoCode, ast.ParserOptions{RegoVersion: ast.RegoV0})
+	if err != nil {
+		t.Fatalf("Failed to parse rego: %v", err)
+	}
+	hash, err := unifiedpolicyresource.CanonicalRegoHash(module)
+	if err != nil {
+		t.Fatalf("Failed to hash rego: %v", err)
+	}
+
+	r := &unifiedpolicyresource.TemplateResource{
+		ProviderData: unifiedpolicy.ProviderMetadata{
+			ProviderMetadata: util.ProviderMetadata{Client: resty.New()},
+		},
+	}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	values := map[string]tftypes.Value{
+		"name":             tftypes.NewValue(tftypes.String, "template"),
+		"version":          tftypes.NewValue(tftypes.String, "1.0.0"),
+		"category":         tftypes.NewValue(tftypes.String, "security"),
+		"data_source_type": tftypes.NewValue(tftypes.String, "xray"),
+		"rego":             tftypes.NewValue(tftypes.String, regoPath),
+	}
+	state := func(stateHash string) tfsdk.State {
+		stateValues := map[string]tftypes.Value{
+			"id":                  tftypes.NewValue(tftypes.String, "1001"),
+			"rego_content":        tftypes.NewValue(tftypes.String, regoCode),
+			"rego_canonical_hash": tftypes.NewValue(tftypes.String, stateHash),
+		}
+		for name, v := range values {
+			stateValues[name] = v
+		}
+		return tfsdk.State{Schema: schemaResp.Schema, Raw: templateConfig(ctx, schemaResp.Schema, stateValues).Raw}
+	}
+
+	tests := []struct {
+		name     string
+		state    tfsdk.State
+		expected types.String
+	}{
+		{
+			name:     "create",
+			state:    tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			expected: types.StringUnknown(),
+		},
+		{name: "rego unchanged", state: state(hash), expected: types.StringValue(regoCode)},
+		{name: "rego changed", state: state("stale"), expected: types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := templateConfig(ctx, schemaResp.Schema, values)
+			req := fwresource.ModifyPlanRequest{
+				Config: config,
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: config.Raw},
+				State:  tt.state,
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var content types.String
+			resp.Plan.GetAttribute(ctx, path.Root("rego_content"), &content)
+			if !content.Equal(tt.expected) {
+				t.Errorf("Expected planned rego_content %s, got %s", tt.expected, content)
+			}
+		})
+	}
+}
+
+func BenchmarkRegoContentAndHashFromFile(b *testing.B) {
+	var regoCode strings.Builder
+	regoCode.WriteString("package unifiedpolicy\n\ndefault allow = false\n")
+	for i := 0; regoCode.Len() < unifiedpolicy.DefaultMaxRegoChars; i++ {
+		fmt.Fprintf(&regoCode, "\nallow {\n    input.evidence.severity != \"critical-%d\"\n}\n", i)
+	}
+	regoPath := filepath.Join(b.TempDir(), "large.rego")
+	if err := os.WriteFile(regoPath, []byte(regoCode.String()), 0o600); err != nil {
+		b.Fatalf("Failed to write rego file: %v", err)
+	}
+
+	b.SetBytes(int64(regoCode.Len()))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := unifiedpolicyresource.RegoContentAndHashFromFile(regoPath, false, ""); err != nil {
+			b.Fatalf("Unexpected error: %v", err)
+		}
+	}
+}
+
+func TestAccTemplate_withRegoAST(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	_, fqrn, name := testutil.MkNames("test-template-ast-", "unifiedpolicy_template")
+	resourceName := fmt.Sprintf("unifiedpolicy_template.%s", name)
+	regoPath := acctest.RegoFixturePath(t, "basic_policy.rego")
+
+	config := fmt.Sprintf(`
+		resource "unifiedpolicy_template" "%s" {
+			name             = "%s"
+			version          = "1.0.0"
+			category         = "security"
+			data_source_type = "evidence"
+			rego             = %q
+			include_rego_ast = true
+		}
+	`, name, name, regoPath)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.TestAccCheckTemplateDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "include_rego_ast", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "rego_ast_json"),
+				),
+			},
+		},
+	})
+}
+
+// Acceptance tests for Rego validation during plan phase
+
+func TestAccTemplate_invalidRegoSyntax(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	regoPath := acctest.RegoFixturePath(t, "invalid_syntax.rego")
+	config := fmt.Sprintf(`
+resource "unifiedpolicy_template" "invalid_syntax_test" {
+  name             = "Invalid Syntax Test"
+  version          = "1.0.0"
+  category         = "security"
+  data_source_type = "evidence"
+  rego             = %q
+  parameters       = []
+}
+`, regoPath)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Invalid Rego Syntax`),
+			},
+		},
+	})
+}
+
+func TestAccTemplate_invalidRegoOperations(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	regoPath := acctest.RegoFixturePath(t, "invalid_ops.rego")
+	config := fmt.Sprintf(`
+resource "unifiedpolicy_template" "invalid_ops_test" {
+  name             = "Invalid Operations Test"
+  version          = "1.0.0"
+  category         = "security"
+  data_source_type = "evidence"
+  rego             = %q
+  parameters       = []
+}
+`, regoPath)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Disallowed Rego Operations`),
+			},
+		},
+	})
+}
+
+func TestAccTemplate_invalidRegoOperationHttpSend(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	regoPath := acctest.RegoFixturePath(t, "invalid_http_send.rego")
+	config := fmt.Sprintf(`
+resource "unifiedpolicy_template" "invalid_http_send" {
+  name             = "Invalid HTTP Send Test"
+  version          = "1.0.0"
+  category         = "security"
+  data_source_type = "evidence"
+  rego             = %q
+  parameters       = []
+}
+`, regoPath)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Disallowed Rego Operations`),
+			},
+		},
+	})
+}
+
+func TestAccTemplate_invalidRegoOperationIoJwtDecode(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	regoPath := acctest.RegoFixturePath(t, "invalid_jwt_decode.rego")
+	config := fmt.Sprintf(`
+resource "unifiedpolicy_template" "invalid_jwt_decode" {
+  name             = "Invalid JWT Decode Test"
+  version          = "1.0.0"
+  category         = "security"
+  data_source_type = "evidence"
+  rego             = %q
+  parameters       = []
+}
+`, regoPath)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Disallowed Rego Operations`),
+			},
+		},
+	})
+}
+
+func TestAccTemplate_invalidRegoOperationRandIntn(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	regoPath := acctest.RegoFixturePath(t, "invalid_rand_intn.rego")
+	config := fmt.Sprintf(`
+resource "unifiedpolicy_template" "invalid_rand_intn" {
+  name             = "Invalid Rand Intn Test"
+  version          = "1.0.0"
   category         = "security"
   data_source_type = "evidence"
   rego             = %q
@@ -1052,6 +2728,35 @@ func TestAccTemplate_invalidRegoOperationOsGetenv(t *testing.T) {
 // - TestPolicyConfigToRego
 // - TestJSONToPolicyConfig
 
+func TestAccTemplate_strictRegoUnusedVariable(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	regoPath := acctest.RegoFixturePath(t, "strict_unused_var.rego")
+	config := fmt.Sprintf(`
+resource "unifiedpolicy_template" "strict_rego_test" {
+  name             = "Strict Rego Test"
+  version          = "1.0.0"
+  category         = "security"
+  data_source_type = "evidence"
+  rego             = %q
+  strict_rego      = true
+  parameters       = []
+}
+`, regoPath)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Rego Strict Mode Errors`),
+			},
+		},
+	})
+}
+
 func TestAccTemplate_missingRegoFile(t *testing.T) {
 	acctest.SkipIfNotAcc(t)
 	acctest.PreCheck(t)
@@ -1181,3 +2886,300 @@ func valueEqual(a, b interface{}) bool {
 		return a == b
 	}
 }
+
+func TestAccTemplate_noopWithScanners(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	regoPath := filepath.Join(t.TempDir(), "noop.rego")
+	if err := os.WriteFile(regoPath, []byte("package curation.policies\n\nallow := true\n"), 0o600); err != nil {
+		t.Fatalf("unable to write rego file: %v", err)
+	}
+
+	config := fmt.Sprintf(`
+		resource "unifiedpolicy_template" "noop_scanners_test" {
+			name             = "Noop Scanners Test"
+			version          = "1.0.0"
+			category         = "security"
+			data_source_type = "noop"
+			rego             = "%s"
+			scanners         = ["sca"]
+			parameters       = []
+		}
+	`, regoPath)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Scanners Not Allowed`),
+			},
+		},
+	})
+}
+
+func TestValidateNoopScanners(t *testing.T) {
+	tests := []struct {
+		name                  string
+		dataSourceType        string
+		scannerCount          int
+		allowScannersWithNoop bool
+		wantError             bool
+	}{
+		{"noop without scanners", "noop", 0, false, false},
+		{"noop with scanners", "noop", 2, false, true},
+		{"noop with scanners allowed", "noop", 2, true, false},
+		{"xray with scanners", "xray", 1, false, false},
+		{"evidence with scanners", "evidence", 1, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := unifiedpolicyresource.ValidateNoopScanners(tt.dataSourceType, tt.scannerCount, tt.allowScannersWithNoop)
+			if (got != "") != tt.wantError {
+				t.Errorf("expected error %v, got %q", tt.wantError, got)
+			}
+		})
+	}
+}
+
+func TestValidateSecurityScanners(t *testing.T) {
+	tests := []struct {
+		name           string
+		category       string
+		dataSourceType string
+		scannerCount   int
+		wantError      bool
+	}{
+		{"security evidence without scanners", "security", "evidence", 0, true},
+		{"security evidence with scanners", "security", "evidence", 1, false},
+		{"security xray without scanners", "security", "xray", 0, false},
+		{"legal evidence without scanners", "legal", "evidence", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := unifiedpolicyresource.ValidateSecurityScanners(tt.category, tt.dataSourceType, tt.scannerCount)
+			if (got != "") != tt.wantError {
+				t.Errorf("expected error %v, got %q", tt.wantError, got)
+			}
+		})
+	}
+}
+
+func TestTemplateConfigValidators_requireScannersForSecurity(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	(&unifiedpolicyresource.TemplateResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	withoutScanners := templateConfig(ctx, schemaResp.Schema, map[string]tftypes.Value{
+		"category":         tftypes.NewValue(tftypes.String, "security"),
+		"data_source_type": tftypes.NewValue(tftypes.String, "evidence"),
+	})
+	withScanners := templateConfig(ctx, schemaResp.Schema, map[string]tftypes.Value{
+		"category":         tftypes.NewValue(tftypes.String, "security"),
+		"data_source_type": tftypes.NewValue(tftypes.String, "evidence"),
+		"scanners":         tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "sca")}),
+	})
+
+	tests := []struct {
+		name        string
+		enforced    bool
+		config      tfsdk.Config
+		expectError bool
+	}{
+		{name: "enforced without scanners", enforced: true, config: withoutScanners, expectError: true},
+		{name: "enforced with scanners", enforced: true, config: withScanners, expectError: false},
+		{name: "not enforced without scanners", enforced: false, config: withoutScanners, expectError: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &unifiedpolicyresource.TemplateResource{
+				ProviderData: unifiedpolicy.ProviderMetadata{
+					ProviderMetadata:           util.ProviderMetadata{Client: resty.New()},
+					RequireScannersForSecurity: tt.enforced,
+				},
+			}
+			resp := &fwresource.ValidateConfigResponse{}
+			for _, v := range r.ConfigValidators(ctx) {
+				v.ValidateResource(ctx, fwresource.ValidateConfigRequest{Config: tt.config}, resp)
+			}
+			hasError := false
+			for _, d := range resp.Diagnostics.Errors() {
+				hasError = hasError || d.Summary() == "Scanners Required"
+			}
+			if hasError != tt.expectError {
+				t.Errorf("Expected error=%v, got %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidateTemplateVersionFormat(t *testing.T) {
+	semver := regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+
+	tests := []struct {
+		name      string
+		version   string
+		wantError bool
+	}{
+		{"semantic version", "1.0.0", false},
+		{"multi-digit semantic version", "10.2.33", false},
+		{"prefixed version", "v1", true},
+		{"two-part version", "1.0", true},
+		{"trailing label", "1.0.0-beta", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := unifiedpolicyresource.ValidateTemplateVersionFormat(tt.version, semver)
+			if (got != "") != tt.wantError {
+				t.Errorf("expected error %v, got %q", tt.wantError, got)
+			}
+			if tt.wantError && !strings.Contains(got, "'"+tt.version+"'") {
+				t.Errorf("expected the error to name the version, got %q", got)
+			}
+		})
+	}
+}
+
+func TestParameterUsageMismatches(t *testing.T) {
+	tests := []struct {
+		name           string
+		regoCode       string
+		declared       []string
+		wantUnused     []string
+		wantUndeclared []string
+	}{
+		{
+			name: "in sync",
+			regoCode: `package unifiedpolicy
+allow {
+    input.evidence.severity == input.parameters.severity
+    input["parameters"]["threshold"] > 7
+}`,
+			declared: []string{"threshold", "severity"},
+		},
+		{
+			name: "unused and undeclared",
+			regoCode: `package unifiedpolicy
+allow {
+    input.evidence.severity == input.parameters.severity
+    input.parameters.max_age > 30
+}`,
+			declared:       []string{"severity", "threshold", "enabled"},
+			wantUnused:     []string{"enabled", "threshold"},
+			wantUndeclared: []string{"max_age"},
+		},
+		{
+			name: "parameters object",
+			regoCode: `package unifiedpolicy
+params := input.parameters
+allow {
+    params.enabled
+    input.parameters.max_age > 30
+}`,
+			declared:       []string{"enabled"},
+			wantUndeclared: []string{"max_age"},
+		},
+		{
+			name: "computed key",
+			regoCode: `package unifiedpolicy
+allow {
+    some name
+    input.parameters[name] == true
+}`,
+			declared: []string{"enabled"},
+		},
+		{
+			name: "no references",
+			regoCode: `package unifiedpolicy
+allow {
+    input.evidence.parameters.enabled
+}`,
+			declared:   []string{"enabled"},
+			wantUnused: []string{"enabled"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			module, err := ast.ParseModuleWithOpts("test.rego", tt.regoCode, ast.ParserOptions{RegoVersion: ast.RegoV0})
+			if err != nil {
+				t.Fatalf("Failed to parse rego: %v", err)
+			}
+
+			unused, undeclared := unifiedpolicyresource.ParameterUsageMismatches(module, tt.declared)
+			if !reflect.DeepEqual(unused, tt.wantUnused) {
+				t.Errorf("unused = %v, want %v", unused, tt.wantUnused)
+			}
+			if !reflect.DeepEqual(undeclared, tt.wantUndeclared) {
+				t.Errorf("undeclared = %v, want %v", undeclared, tt.wantUndeclared)
+			}
+		})
+	}
+}
+
+func TestInputTopLevelKeys(t *testing.T) {
+	tests := []struct {
+		name           string
+		regoCode       string
+		expected       []string
+		wantKeys       []string
+		wantDynamic    bool
+		wantUnexpected []string
+	}{
+		{
+			name: "expected keys",
+			regoCode: `package unifiedpolicy
+allow {
+    input.evidence.severity == input.parameters.severity
+    input["evidence"].count > 0
+}`,
+			expected: []string{"evidence"},
+			wantKeys: []string{"evidence", "parameters"},
+		},
+		{
+			name: "other data source",
+			regoCode: `package unifiedpolicy
+allow {
+    input.evidence.severity == "critical"
+    input.xray.violations[_].type == "security"
+}`,
+			expected:       []string{"evidence"},
+			wantKeys:       []string{"evidence", "xray"},
+			wantUnexpected: []string{"xray"},
+		},
+		{
+			name: "computed key",
+			regoCode: `package unifiedpolicy
+allow {
+    some key
+    input[key].severity == "critical"
+}`,
+			expected:    []string{"evidence"},
+			wantDynamic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			module, err := ast.ParseModuleWithOpts("test.rego", tt.regoCode, ast.ParserOptions{RegoVersion: ast.RegoV0})
+			if err != nil {
+				t.Fatalf("Failed to parse rego: %v", err)
+			}
+
+			keys, dynamic := unifiedpolicyresource.InputTopLevelKeys(module)
+			if !reflect.DeepEqual(keys, tt.wantKeys) || dynamic != tt.wantDynamic {
+				t.Errorf("keys, dynamic = %v, %v, want %v, %v", keys, dynamic, tt.wantKeys, tt.wantDynamic)
+			}
+			if unexpected := unifiedpolicyresource.UnexpectedInputKeys(module, tt.expected); !reflect.DeepEqual(unexpected, tt.wantUnexpected) {
+				t.Errorf("unexpected = %v, want %v", unexpected, tt.wantUnexpected)
+			}
+		})
+	}
+}
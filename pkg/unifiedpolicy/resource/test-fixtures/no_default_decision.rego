@@ -0,0 +1,5 @@
+package unifiedpolicy
+
+allow {
+  input.evidence.severity != "critical"
+}
@@ -0,0 +1,8 @@
+package unifiedpolicy
+
+default allow = false
+
+allow {
+    threshold := 7
+    input.evidence.severity != "critical"
+}
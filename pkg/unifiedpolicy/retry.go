@@ -0,0 +1,46 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// DefaultRetryJitter randomizes the whole backoff interval (full jitter).
+const DefaultRetryJitter = 1.0
+
+// RetryBackoff returns the wait before the given retry (1 for the first retry): exponential backoff of
+// minWait*2^attempt capped at maxWait. jitter (0 to 1) is the fraction of the interval above minWait that is
+// randomized with random (0 to 1): 0 always waits the full exponential delay, 1 picks uniformly between minWait
+// and it, starting with the first retry.
+// This function is exported for testing purposes.
+func RetryBackoff(attempt int, minWait, maxWait time.Duration, jitter, random float64) time.Duration {
+	ceiling := math.Min(float64(maxWait), float64(minWait)*math.Exp2(float64(attempt)))
+	spread := (ceiling - float64(minWait)) * jitter * random
+	return time.Duration(ceiling - spread)
+}
+
+// RetryAfterWithJitter returns a resty RetryAfterFunc applying RetryBackoff with the client's retry wait times,
+// so concurrent requests that failed together do not retry in lockstep.
+func RetryAfterWithJitter(jitter float64) resty.RetryAfterFunc {
+	return func(client *resty.Client, resp *resty.Response) (time.Duration, error) {
+		// Request.Attempt counts the attempt that just failed, starting at 1, which is also the retry about to start
+		return RetryBackoff(resp.Request.Attempt, client.RetryWaitTime, client.RetryMaxWaitTime, jitter, rand.Float64()), nil
+	}
+}
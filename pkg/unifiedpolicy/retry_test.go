@@ -0,0 +1,113 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy_test
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	minWait := 100 * time.Millisecond
+	maxWait := 2 * time.Second
+
+	tests := []struct {
+		name    string
+		attempt int
+		jitter  float64
+		lower   time.Duration
+		upper   time.Duration
+	}{
+		{name: "first retry, full jitter", attempt: 1, jitter: 1, lower: minWait, upper: 200 * time.Millisecond},
+		{name: "first retry, half jitter", attempt: 1, jitter: 0.5, lower: 150 * time.Millisecond, upper: 200 * time.Millisecond},
+		{name: "first retry, no jitter", attempt: 1, jitter: 0, lower: 200 * time.Millisecond, upper: 200 * time.Millisecond},
+		{name: "third retry, full jitter", attempt: 3, jitter: 1, lower: minWait, upper: 800 * time.Millisecond},
+		{name: "third retry, half jitter", attempt: 3, jitter: 0.5, lower: 450 * time.Millisecond, upper: 800 * time.Millisecond},
+		{name: "third retry, no jitter", attempt: 3, jitter: 0, lower: 800 * time.Millisecond, upper: 800 * time.Millisecond},
+		{name: "capped, full jitter", attempt: 10, jitter: 1, lower: minWait, upper: maxWait},
+		{name: "capped, no jitter", attempt: 10, jitter: 0, lower: maxWait, upper: maxWait},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The extremes of the random value must map to the bounds
+			if got := unifiedpolicy.RetryBackoff(tt.attempt, minWait, maxWait, tt.jitter, 0); got != tt.upper {
+				t.Errorf("RetryBackoff() with random 0 = %v, want %v", got, tt.upper)
+			}
+			if got := unifiedpolicy.RetryBackoff(tt.attempt, minWait, maxWait, tt.jitter, 1); got != tt.lower {
+				t.Errorf("RetryBackoff() with random 1 = %v, want %v", got, tt.lower)
+			}
+
+			for i := 0; i < 1000; i++ {
+				got := unifiedpolicy.RetryBackoff(tt.attempt, minWait, maxWait, tt.jitter, rand.Float64())
+				if got < tt.lower || got > tt.upper {
+					t.Fatalf("RetryBackoff() = %v, want between %v and %v", got, tt.lower, tt.upper)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryAfterWithJitter(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var waits []time.Duration
+	client := resty.New().
+		SetRetryCount(3).
+		SetRetryWaitTime(time.Millisecond).
+		SetRetryMaxWaitTime(10 * time.Millisecond).
+		AddRetryCondition(func(resp *resty.Response, err error) bool {
+			return resp.StatusCode() == http.StatusServiceUnavailable
+		})
+	retryAfter := unifiedpolicy.RetryAfterWithJitter(0)
+	client.SetRetryAfter(func(c *resty.Client, resp *resty.Response) (time.Duration, error) {
+		wait, err := retryAfter(c, resp)
+		waits = append(waits, wait)
+		return wait, err
+	})
+
+	resp, err := client.R().Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode())
+	}
+
+	expected := []time.Duration{2 * time.Millisecond, 4 * time.Millisecond}
+	if len(waits) != len(expected) {
+		t.Fatalf("expected %d retries, got %d", len(expected), len(waits))
+	}
+	for i, want := range expected {
+		if waits[i] != want {
+			t.Errorf("retry %d waited %v, want %v", i+1, waits[i], want)
+		}
+	}
+}
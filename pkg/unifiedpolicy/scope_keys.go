@@ -0,0 +1,125 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// AvailableProjectsEndpoint lists the platform projects (JFrog Access API). It is not part of the Unified Policy API,
+// so api_path_prefix does not apply to it.
+const AvailableProjectsEndpoint = "access/api/v1/projects"
+
+// AvailableApplicationsEndpoint lists the platform applications (JFrog AppTrust API). It is not part of the Unified
+// Policy API, so api_path_prefix does not apply to it. Not available on platforms without AppTrust.
+const AvailableApplicationsEndpoint = "apptrust/api/v1/applications"
+
+// projectAPIModel is an element of the response of GET access/api/v1/projects.
+type projectAPIModel struct {
+	ProjectKey string `json:"project_key"`
+}
+
+// applicationsAPIModel is the response shape for GET apptrust/api/v1/applications.
+type applicationsAPIModel struct {
+	Applications []struct {
+		ApplicationKey string `json:"application_key"`
+	} `json:"applications"`
+}
+
+// ReadAvailableProjectKeys returns the sorted keys of the projects on the platform.
+func ReadAvailableProjectKeys(ctx context.Context, m ProviderMetadata) ([]string, diag.Diagnostics) {
+	var result []projectAPIModel
+	diags := readScopeKeys(ctx, m, AvailableProjectsEndpoint, "Projects", &result)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	keys := make([]string, 0, len(result))
+	for _, project := range result {
+		keys = append(keys, project.ProjectKey)
+	}
+	sort.Strings(keys)
+	return keys, diags
+}
+
+// ReadAvailableApplicationKeys returns the sorted keys of the applications on the platform.
+func ReadAvailableApplicationKeys(ctx context.Context, m ProviderMetadata) ([]string, diag.Diagnostics) {
+	var result applicationsAPIModel
+	diags := readScopeKeys(ctx, m, AvailableApplicationsEndpoint, "Applications", &result)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	keys := make([]string, 0, len(result.Applications))
+	for _, application := range result.Applications {
+		keys = append(keys, application.ApplicationKey)
+	}
+	sort.Strings(keys)
+	return keys, diags
+}
+
+// readScopeKeys fetches endpoint into result. kind names the listed objects in diagnostics.
+func readScopeKeys(ctx context.Context, m ProviderMetadata, endpoint, kind string, result interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	response, err := m.Client.R().
+		SetContext(ctx).
+		SetResult(result).
+		Get(endpoint)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Unable to Read Available %s", kind),
+			fmt.Sprintf("An unexpected error occurred while fetching %s from %s.\n\nError: %s", strings.ToLower(kind), endpoint, err.Error()),
+		)
+		return diags
+	}
+
+	if response.IsError() {
+		switch response.StatusCode() {
+		case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+			diags.AddError(
+				fmt.Sprintf("%s List Not Available", kind),
+				fmt.Sprintf("The platform did not list its %s (HTTP %d from %s). "+
+					"The endpoint is not available on this platform.", strings.ToLower(kind), response.StatusCode(), endpoint),
+			)
+			return diags
+		}
+		diags.Append(HandleAPIError(response, "read")...)
+	}
+	return diags
+}
+
+// ValidateScopeKeys returns an error message naming the keys that are not in available, and an empty string when all
+// keys are. kind is "project" or "application".
+func ValidateScopeKeys(kind string, available, keys []string) string {
+	var unknown []string
+	for _, key := range keys {
+		if !slices.Contains(available, key) {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Unknown %s key(s) '%s'. The platform has no %s with these keys; check them for typos. Available keys: '%s'.",
+		kind, strings.Join(unknown, "', '"), kind, strings.Join(available, "', '"))
+}
@@ -0,0 +1,91 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+)
+
+func TestReadAvailableScopeKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/" + unifiedpolicy.AvailableProjectsEndpoint:
+			_, _ = w.Write([]byte(`[{"project_key":"bb","display_name":"B"},{"project_key":"aa","display_name":"A"}]`))
+		case "/" + unifiedpolicy.AvailableApplicationsEndpoint:
+			_, _ = w.Write([]byte(`{"applications":[{"application_key":"app-2"},{"application_key":"app-1"}]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	// api_path_prefix only applies to Unified Policy endpoints
+	m := unifiedpolicy.ProviderMetadata{
+		ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+		APIPathPrefix:    "gateway/unifiedpolicy/api/v1",
+	}
+
+	projects, diags := unifiedpolicy.ReadAvailableProjectKeys(context.Background(), m)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if want := []string{"aa", "bb"}; !reflect.DeepEqual(projects, want) {
+		t.Errorf("expected projects %v, got %v", want, projects)
+	}
+
+	applications, diags := unifiedpolicy.ReadAvailableApplicationKeys(context.Background(), m)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if want := []string{"app-1", "app-2"}; !reflect.DeepEqual(applications, want) {
+		t.Errorf("expected applications %v, got %v", want, applications)
+	}
+}
+
+func TestReadAvailableScopeKeys_notAvailable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	m := unifiedpolicy.ProviderMetadata{
+		ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+	}
+	_, diags := unifiedpolicy.ReadAvailableApplicationKeys(context.Background(), m)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Applications List Not Available" {
+		t.Errorf("expected an endpoint not available error, got %v", diags)
+	}
+}
+
+func TestValidateScopeKeys(t *testing.T) {
+	available := []string{"aa", "bb"}
+
+	if message := unifiedpolicy.ValidateScopeKeys("project", available, []string{"bb", "aa"}); message != "" {
+		t.Errorf("expected known keys to pass, got %q", message)
+	}
+
+	message := unifiedpolicy.ValidateScopeKeys("project", available, []string{"aa", "ab", "cc"})
+	if !strings.Contains(message, "'ab', 'cc'") || strings.Contains(message, "key(s) 'aa") {
+		t.Errorf("expected the message to name only the unknown keys, got %q", message)
+	}
+}
@@ -56,6 +56,17 @@ func (r UnifiedPolicyErrorsResponse) String() string {
 	return errs
 }
 
+// IsDeleteSuccess reports whether a delete response means the object is gone: 200 or 204, or 404 when it
+// was already deleted. A delete retried after a transport error whose first attempt reached the server
+// sees 404 on the retry, so 404 must count as success for deletes to stay idempotent.
+func IsDeleteSuccess(response *resty.Response) bool {
+	switch response.StatusCode() {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return true
+	}
+	return false
+}
+
 // HandleAPIError processes API errors and returns appropriate diagnostics.
 // It provides user-friendly error messages based on HTTP status codes.
 // It sanitizes error messages to avoid exposing internal implementation details.
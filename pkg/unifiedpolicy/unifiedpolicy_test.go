@@ -0,0 +1,162 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unifiedpolicy_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/jfrog/terraform-provider-unifiedpolicy/pkg/unifiedpolicy"
+)
+
+// TestIsDeleteSuccess_retryAfterSuccess simulates a delete whose first attempt is applied by the server but
+// whose response is lost, so the client retries and gets 404.
+func TestIsDeleteSuccess_retryAfterSuccess(t *testing.T) {
+	attempts := 0
+	deleted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if deleted {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		deleted = true
+		// Drop the connection without a response, as if it was reset after the server processed the delete
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Fatalf("unable to hijack connection: %v", err)
+		}
+		conn.Close()
+	}))
+	defer server.Close()
+
+	client := resty.New().
+		SetBaseURL(server.URL).
+		SetRetryCount(3).
+		SetRetryWaitTime(time.Millisecond).
+		SetRetryMaxWaitTime(10 * time.Millisecond)
+
+	response, err := client.R().Delete("/unifiedpolicy/api/v1/rules/1001")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+	if response.StatusCode() != http.StatusNotFound {
+		t.Errorf("expected final status 404, got %d", response.StatusCode())
+	}
+	if !unifiedpolicy.IsDeleteSuccess(response) {
+		t.Error("expected 404 after a retried delete to be treated as success")
+	}
+}
+
+func TestIsDeleteSuccess(t *testing.T) {
+	tests := []struct {
+		status int
+		want   bool
+	}{
+		{http.StatusOK, true},
+		{http.StatusNoContent, true},
+		{http.StatusNotFound, true},
+		{http.StatusConflict, false},
+		{http.StatusInternalServerError, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			response, err := resty.New().R().Delete(server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := unifiedpolicy.IsDeleteSuccess(response); got != tt.want {
+				t.Errorf("status %d: expected %v, got %v", tt.status, tt.want, got)
+			}
+		})
+	}
+}
+
+// privateState is an in-memory stand-in for resource private state.
+type privateState map[string][]byte
+
+func (p privateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p privateState) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+		return nil
+	}
+	if !json.Valid(value) {
+		var diags diag.Diagnostics
+		diags.AddError("Invalid Private State", "value is not valid JSON: "+string(value))
+		return diags
+	}
+	p[key] = value
+	return nil
+}
+
+func TestPrivateETag(t *testing.T) {
+	ctx := context.Background()
+	etag := `W/"5f2c-1"`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if etag != "" {
+			w.Header().Set("ETag", etag)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	private := privateState{}
+	response, err := resty.New().R().Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diags := unifiedpolicy.SetPrivateETag(ctx, private, response); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	got, diags := unifiedpolicy.GetPrivateETag(ctx, private)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got != etag {
+		t.Errorf("expected ETag %q, got %q", etag, got)
+	}
+
+	// A response without an ETag clears the stored one
+	etag = ""
+	response, err = resty.New().R().Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diags := unifiedpolicy.SetPrivateETag(ctx, private, response); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got, _ := unifiedpolicy.GetPrivateETag(ctx, private); got != "" {
+		t.Errorf("expected ETag to be cleared, got %q", got)
+	}
+}